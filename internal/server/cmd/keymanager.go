@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tacokumo/portal-api/pkg/platform"
+)
+
+func newKeyManagerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keymanager",
+		Short: "Start the secret key manager server",
+		Long: `Start the secret key manager server.
+
+The key manager owns the master key and the secret_keys table, and serves
+GenerateDataKey, Encrypt, Decrypt and Rotate over gRPC and Connect.
+Set KEY_MANAGER_URL and KEY_MANAGER_TOKEN on portal-api to call it instead of
+loading the master key in process.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return platform.NewKeyManagerServer(newLogger()).Start(cmd.Context())
+		},
+		SilenceUsage: true,
+	}
+
+	return cmd
+}
@@ -14,20 +14,7 @@ func New() *cobra.Command {
 		Use:   "server",
 		Short: "portal-api server",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			var logLevel slog.Level
-			switch strings.ToLower(os.Getenv("LOG_LEVEL")) {
-			case "debug":
-				logLevel = slog.LevelDebug
-			case "info":
-				logLevel = slog.LevelInfo
-			case "warn":
-				logLevel = slog.LevelWarn
-			case "error":
-				logLevel = slog.LevelError
-			default:
-				logLevel = slog.LevelInfo
-			}
-			logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: logLevel}))
+			logger := newLogger()
 			srv := platform.NewServer(logger)
 			if err := srv.Start(cmd.Context()); err != nil {
 				return err
@@ -40,6 +27,26 @@ func New() *cobra.Command {
 
 	// サブコマンドを追加
 	cmd.AddCommand(newConfigCommand())
+	cmd.AddCommand(newSecretsCommand())
+	cmd.AddCommand(newKeyManagerCommand())
 
 	return cmd
 }
+
+// newLogger はLOG_LEVELのレベルでJSON形式のログを標準出力に出力するLoggerを生成する
+func newLogger() *slog.Logger {
+	var logLevel slog.Level
+	switch strings.ToLower(os.Getenv("LOG_LEVEL")) {
+	case "debug":
+		logLevel = slog.LevelDebug
+	case "info":
+		logLevel = slog.LevelInfo
+	case "warn":
+		logLevel = slog.LevelWarn
+	case "error":
+		logLevel = slog.LevelError
+	default:
+		logLevel = slog.LevelInfo
+	}
+	return slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: logLevel}))
+}
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/keymanager"
+	"github.com/tacokumo/portal-api/pkg/secret"
+)
+
+func newSecretsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secrets",
+		Short: "Application secret management commands",
+	}
+
+	cmd.AddCommand(newSecretsKeysCommand())
+
+	return cmd
+}
+
+func newSecretsKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Secret encryption key management commands",
+	}
+
+	cmd.AddCommand(
+		newSecretsKeysListCommand(),
+		newSecretsKeysRotateCommand(),
+	)
+
+	return cmd
+}
+
+func newSecretsKeysListCommand() *cobra.Command {
+	var configPath string
+	var maxAge time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List secret encryption keys and flag keys older than the max age",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig(configPath)
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed("max-age") {
+				maxAge = cfg.Secret.KeyMaxAge
+			}
+
+			vault, closeFn, err := openSecretVault(cmd.Context(), cfg)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+
+			keys, err := vault.Keys(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			now := time.Now()
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tCREATED_AT\tSECRETS\tCURRENT\tSTALE")
+			for _, key := range keys {
+				fmt.Fprintf(w, "%d\t%s\t%d\t%t\t%t\n",
+					key.ID,
+					key.CreatedAt.Format(time.RFC3339),
+					key.Secrets,
+					key.Current,
+					key.Stale(now, maxAge),
+				)
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().StringVarP(&configPath, "config", "c", "", "configuration file path")
+	cmd.Flags().DurationVar(&maxAge, "max-age", 0, "flag keys created before this duration as stale (default: secret.key_max_age)")
+	return cmd
+}
+
+func newSecretsKeysRotateCommand() *cobra.Command {
+	var configPath string
+	var batchSize int
+	var resume bool
+
+	cmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Create a new secret encryption key and re-encrypt all secrets with it",
+		Long: `Create a new secret encryption key and re-encrypt all secrets with it.
+
+Re-encryption runs in batches and can be interrupted at any time.
+Run again with --resume to continue re-encrypting with the current key without creating another one.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig(configPath)
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed("batch-size") {
+				batchSize = cfg.Secret.RotationBatchSize
+			}
+
+			vault, closeFn, err := openSecretVault(cmd.Context(), cfg)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+
+			if !resume {
+				key, err := vault.Rotate(cmd.Context())
+				if err != nil {
+					return err
+				}
+				fmt.Printf("Created secret encryption key: %d\n", key.ID)
+			}
+
+			return vault.ReencryptAll(cmd.Context(), batchSize, func(p secret.Progress) {
+				fmt.Printf("Re-encrypted %d/%d secrets with key %d\n", p.Total-p.Remaining, p.Total, p.KeyID)
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&configPath, "config", "c", "", "configuration file path")
+	cmd.Flags().IntVar(&batchSize, "batch-size", 0, "number of secrets to re-encrypt per batch (default: secret.rotation_batch_size)")
+	cmd.Flags().BoolVar(&resume, "resume", false, "resume re-encryption with the current key instead of creating a new key")
+	return cmd
+}
+
+// loadConfig はconfigPathの設定を読み込む
+// config.Loadはos.Argsから--configを読み込むため､サブコマンドのフラグを渡さないように置き換える
+func loadConfig(configPath string) (*config.Config, error) {
+	originalArgs := os.Args
+	os.Args = []string{originalArgs[0]}
+	if configPath != "" {
+		os.Args = append(os.Args, "--config", configPath)
+	}
+	defer func() { os.Args = originalArgs }()
+
+	return config.Load()
+}
+
+func openSecretVault(ctx context.Context, cfg *config.Config) (*secret.Vault, func(), error) {
+	if cfg.Database.URL == "" {
+		return nil, nil, errors.New("DATABASE_URL is not set")
+	}
+	store, closeFn, err := secret.OpenPostgres(ctx, cfg.Database)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys, err := keymanager.New(cfg.Secret, store)
+	if err != nil {
+		closeFn()
+		return nil, nil, err
+	}
+	return secret.NewVault(store, keys), closeFn, nil
+}
@@ -0,0 +1,550 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: keymanager/v1/keymanager.proto
+
+package keymanagerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DataKey はsecret_keysのデータ鍵｡鍵の値は含まない
+type DataKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataKey) Reset() {
+	*x = DataKey{}
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataKey) ProtoMessage() {}
+
+func (x *DataKey) ProtoReflect() protoreflect.Message {
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataKey.ProtoReflect.Descriptor instead.
+func (*DataKey) Descriptor() ([]byte, []int) {
+	return file_keymanager_v1_keymanager_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DataKey) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DataKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GenerateDataKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateDataKeyRequest) Reset() {
+	*x = GenerateDataKeyRequest{}
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateDataKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateDataKeyRequest) ProtoMessage() {}
+
+func (x *GenerateDataKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateDataKeyRequest.ProtoReflect.Descriptor instead.
+func (*GenerateDataKeyRequest) Descriptor() ([]byte, []int) {
+	return file_keymanager_v1_keymanager_proto_rawDescGZIP(), []int{1}
+}
+
+type GenerateDataKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *DataKey               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateDataKeyResponse) Reset() {
+	*x = GenerateDataKeyResponse{}
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateDataKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateDataKeyResponse) ProtoMessage() {}
+
+func (x *GenerateDataKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateDataKeyResponse.ProtoReflect.Descriptor instead.
+func (*GenerateDataKeyResponse) Descriptor() ([]byte, []int) {
+	return file_keymanager_v1_keymanager_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenerateDataKeyResponse) GetKey() *DataKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type EncryptRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	KeyId     int64                  `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Plaintext []byte                 `protobuf:"bytes,2,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	// additional_data はAES-GCMの追加データであり､復号時にも同じ値を指定する
+	AdditionalData []byte `protobuf:"bytes,3,opt,name=additional_data,json=additionalData,proto3" json:"additional_data,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EncryptRequest) Reset() {
+	*x = EncryptRequest{}
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptRequest) ProtoMessage() {}
+
+func (x *EncryptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptRequest.ProtoReflect.Descriptor instead.
+func (*EncryptRequest) Descriptor() ([]byte, []int) {
+	return file_keymanager_v1_keymanager_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EncryptRequest) GetKeyId() int64 {
+	if x != nil {
+		return x.KeyId
+	}
+	return 0
+}
+
+func (x *EncryptRequest) GetPlaintext() []byte {
+	if x != nil {
+		return x.Plaintext
+	}
+	return nil
+}
+
+func (x *EncryptRequest) GetAdditionalData() []byte {
+	if x != nil {
+		return x.AdditionalData
+	}
+	return nil
+}
+
+type EncryptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ciphertext    []byte                 `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncryptResponse) Reset() {
+	*x = EncryptResponse{}
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptResponse) ProtoMessage() {}
+
+func (x *EncryptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptResponse.ProtoReflect.Descriptor instead.
+func (*EncryptResponse) Descriptor() ([]byte, []int) {
+	return file_keymanager_v1_keymanager_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EncryptResponse) GetCiphertext() []byte {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return nil
+}
+
+type DecryptRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	KeyId          int64                  `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Ciphertext     []byte                 `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	AdditionalData []byte                 `protobuf:"bytes,3,opt,name=additional_data,json=additionalData,proto3" json:"additional_data,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DecryptRequest) Reset() {
+	*x = DecryptRequest{}
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecryptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecryptRequest) ProtoMessage() {}
+
+func (x *DecryptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecryptRequest.ProtoReflect.Descriptor instead.
+func (*DecryptRequest) Descriptor() ([]byte, []int) {
+	return file_keymanager_v1_keymanager_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DecryptRequest) GetKeyId() int64 {
+	if x != nil {
+		return x.KeyId
+	}
+	return 0
+}
+
+func (x *DecryptRequest) GetCiphertext() []byte {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return nil
+}
+
+func (x *DecryptRequest) GetAdditionalData() []byte {
+	if x != nil {
+		return x.AdditionalData
+	}
+	return nil
+}
+
+type DecryptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plaintext     []byte                 `protobuf:"bytes,1,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecryptResponse) Reset() {
+	*x = DecryptResponse{}
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecryptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecryptResponse) ProtoMessage() {}
+
+func (x *DecryptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecryptResponse.ProtoReflect.Descriptor instead.
+func (*DecryptResponse) Descriptor() ([]byte, []int) {
+	return file_keymanager_v1_keymanager_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DecryptResponse) GetPlaintext() []byte {
+	if x != nil {
+		return x.Plaintext
+	}
+	return nil
+}
+
+type RotateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateRequest) Reset() {
+	*x = RotateRequest{}
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateRequest) ProtoMessage() {}
+
+func (x *RotateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateRequest.ProtoReflect.Descriptor instead.
+func (*RotateRequest) Descriptor() ([]byte, []int) {
+	return file_keymanager_v1_keymanager_proto_rawDescGZIP(), []int{7}
+}
+
+type RotateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *DataKey               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateResponse) Reset() {
+	*x = RotateResponse{}
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateResponse) ProtoMessage() {}
+
+func (x *RotateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keymanager_v1_keymanager_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateResponse.ProtoReflect.Descriptor instead.
+func (*RotateResponse) Descriptor() ([]byte, []int) {
+	return file_keymanager_v1_keymanager_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RotateResponse) GetKey() *DataKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+var File_keymanager_v1_keymanager_proto protoreflect.FileDescriptor
+
+const file_keymanager_v1_keymanager_proto_rawDesc = "" +
+	"\n" +
+	"\x1ekeymanager/v1/keymanager.proto\x12\rkeymanager.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"T\n" +
+	"\aDataKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x18\n" +
+	"\x16GenerateDataKeyRequest\"C\n" +
+	"\x17GenerateDataKeyResponse\x12(\n" +
+	"\x03key\x18\x01 \x01(\v2\x16.keymanager.v1.DataKeyR\x03key\"n\n" +
+	"\x0eEncryptRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\x03R\x05keyId\x12\x1c\n" +
+	"\tplaintext\x18\x02 \x01(\fR\tplaintext\x12'\n" +
+	"\x0fadditional_data\x18\x03 \x01(\fR\x0eadditionalData\"1\n" +
+	"\x0fEncryptResponse\x12\x1e\n" +
+	"\n" +
+	"ciphertext\x18\x01 \x01(\fR\n" +
+	"ciphertext\"p\n" +
+	"\x0eDecryptRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\x03R\x05keyId\x12\x1e\n" +
+	"\n" +
+	"ciphertext\x18\x02 \x01(\fR\n" +
+	"ciphertext\x12'\n" +
+	"\x0fadditional_data\x18\x03 \x01(\fR\x0eadditionalData\"/\n" +
+	"\x0fDecryptResponse\x12\x1c\n" +
+	"\tplaintext\x18\x01 \x01(\fR\tplaintext\"\x0f\n" +
+	"\rRotateRequest\":\n" +
+	"\x0eRotateResponse\x12(\n" +
+	"\x03key\x18\x01 \x01(\v2\x16.keymanager.v1.DataKeyR\x03key2\xd0\x02\n" +
+	"\x11KeyManagerService\x12`\n" +
+	"\x0fGenerateDataKey\x12%.keymanager.v1.GenerateDataKeyRequest\x1a&.keymanager.v1.GenerateDataKeyResponse\x12H\n" +
+	"\aEncrypt\x12\x1d.keymanager.v1.EncryptRequest\x1a\x1e.keymanager.v1.EncryptResponse\x12H\n" +
+	"\aDecrypt\x12\x1d.keymanager.v1.DecryptRequest\x1a\x1e.keymanager.v1.DecryptResponse\x12E\n" +
+	"\x06Rotate\x12\x1c.keymanager.v1.RotateRequest\x1a\x1d.keymanager.v1.RotateResponseBDZBgithub.com/tacokumo/portal-api/pkg/apis/keymanager/v1;keymanagerv1b\x06proto3"
+
+var (
+	file_keymanager_v1_keymanager_proto_rawDescOnce sync.Once
+	file_keymanager_v1_keymanager_proto_rawDescData []byte
+)
+
+func file_keymanager_v1_keymanager_proto_rawDescGZIP() []byte {
+	file_keymanager_v1_keymanager_proto_rawDescOnce.Do(func() {
+		file_keymanager_v1_keymanager_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_keymanager_v1_keymanager_proto_rawDesc), len(file_keymanager_v1_keymanager_proto_rawDesc)))
+	})
+	return file_keymanager_v1_keymanager_proto_rawDescData
+}
+
+var file_keymanager_v1_keymanager_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_keymanager_v1_keymanager_proto_goTypes = []any{
+	(*DataKey)(nil),                 // 0: keymanager.v1.DataKey
+	(*GenerateDataKeyRequest)(nil),  // 1: keymanager.v1.GenerateDataKeyRequest
+	(*GenerateDataKeyResponse)(nil), // 2: keymanager.v1.GenerateDataKeyResponse
+	(*EncryptRequest)(nil),          // 3: keymanager.v1.EncryptRequest
+	(*EncryptResponse)(nil),         // 4: keymanager.v1.EncryptResponse
+	(*DecryptRequest)(nil),          // 5: keymanager.v1.DecryptRequest
+	(*DecryptResponse)(nil),         // 6: keymanager.v1.DecryptResponse
+	(*RotateRequest)(nil),           // 7: keymanager.v1.RotateRequest
+	(*RotateResponse)(nil),          // 8: keymanager.v1.RotateResponse
+	(*timestamppb.Timestamp)(nil),   // 9: google.protobuf.Timestamp
+}
+var file_keymanager_v1_keymanager_proto_depIdxs = []int32{
+	9, // 0: keymanager.v1.DataKey.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: keymanager.v1.GenerateDataKeyResponse.key:type_name -> keymanager.v1.DataKey
+	0, // 2: keymanager.v1.RotateResponse.key:type_name -> keymanager.v1.DataKey
+	1, // 3: keymanager.v1.KeyManagerService.GenerateDataKey:input_type -> keymanager.v1.GenerateDataKeyRequest
+	3, // 4: keymanager.v1.KeyManagerService.Encrypt:input_type -> keymanager.v1.EncryptRequest
+	5, // 5: keymanager.v1.KeyManagerService.Decrypt:input_type -> keymanager.v1.DecryptRequest
+	7, // 6: keymanager.v1.KeyManagerService.Rotate:input_type -> keymanager.v1.RotateRequest
+	2, // 7: keymanager.v1.KeyManagerService.GenerateDataKey:output_type -> keymanager.v1.GenerateDataKeyResponse
+	4, // 8: keymanager.v1.KeyManagerService.Encrypt:output_type -> keymanager.v1.EncryptResponse
+	6, // 9: keymanager.v1.KeyManagerService.Decrypt:output_type -> keymanager.v1.DecryptResponse
+	8, // 10: keymanager.v1.KeyManagerService.Rotate:output_type -> keymanager.v1.RotateResponse
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_keymanager_v1_keymanager_proto_init() }
+func file_keymanager_v1_keymanager_proto_init() {
+	if File_keymanager_v1_keymanager_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_keymanager_v1_keymanager_proto_rawDesc), len(file_keymanager_v1_keymanager_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_keymanager_v1_keymanager_proto_goTypes,
+		DependencyIndexes: file_keymanager_v1_keymanager_proto_depIdxs,
+		MessageInfos:      file_keymanager_v1_keymanager_proto_msgTypes,
+	}.Build()
+	File_keymanager_v1_keymanager_proto = out.File
+	file_keymanager_v1_keymanager_proto_goTypes = nil
+	file_keymanager_v1_keymanager_proto_depIdxs = nil
+}
@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: keymanager/v1/keymanager.proto
+
+package keymanagerv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/tacokumo/portal-api/pkg/apis/keymanager/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// KeyManagerServiceName is the fully-qualified name of the KeyManagerService service.
+	KeyManagerServiceName = "keymanager.v1.KeyManagerService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// KeyManagerServiceGenerateDataKeyProcedure is the fully-qualified name of the KeyManagerService's
+	// GenerateDataKey RPC.
+	KeyManagerServiceGenerateDataKeyProcedure = "/keymanager.v1.KeyManagerService/GenerateDataKey"
+	// KeyManagerServiceEncryptProcedure is the fully-qualified name of the KeyManagerService's Encrypt
+	// RPC.
+	KeyManagerServiceEncryptProcedure = "/keymanager.v1.KeyManagerService/Encrypt"
+	// KeyManagerServiceDecryptProcedure is the fully-qualified name of the KeyManagerService's Decrypt
+	// RPC.
+	KeyManagerServiceDecryptProcedure = "/keymanager.v1.KeyManagerService/Decrypt"
+	// KeyManagerServiceRotateProcedure is the fully-qualified name of the KeyManagerService's Rotate
+	// RPC.
+	KeyManagerServiceRotateProcedure = "/keymanager.v1.KeyManagerService/Rotate"
+)
+
+// KeyManagerServiceClient is a client for the keymanager.v1.KeyManagerService service.
+type KeyManagerServiceClient interface {
+	// GenerateDataKey は新しい暗号化に使う最新のデータ鍵を返す｡データ鍵が1つも存在しない場合は生成する
+	GenerateDataKey(context.Context, *connect.Request[v1.GenerateDataKeyRequest]) (*connect.Response[v1.GenerateDataKeyResponse], error)
+	// Encrypt は指定したデータ鍵で平文を暗号化する
+	Encrypt(context.Context, *connect.Request[v1.EncryptRequest]) (*connect.Response[v1.EncryptResponse], error)
+	// Decrypt は指定したデータ鍵で暗号文を復号する
+	Decrypt(context.Context, *connect.Request[v1.DecryptRequest]) (*connect.Response[v1.DecryptResponse], error)
+	// Rotate は新しいデータ鍵を生成し､以降のGenerateDataKeyで返す
+	Rotate(context.Context, *connect.Request[v1.RotateRequest]) (*connect.Response[v1.RotateResponse], error)
+}
+
+// NewKeyManagerServiceClient constructs a client for the keymanager.v1.KeyManagerService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewKeyManagerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) KeyManagerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	keyManagerServiceMethods := v1.File_keymanager_v1_keymanager_proto.Services().ByName("KeyManagerService").Methods()
+	return &keyManagerServiceClient{
+		generateDataKey: connect.NewClient[v1.GenerateDataKeyRequest, v1.GenerateDataKeyResponse](
+			httpClient,
+			baseURL+KeyManagerServiceGenerateDataKeyProcedure,
+			connect.WithSchema(keyManagerServiceMethods.ByName("GenerateDataKey")),
+			connect.WithClientOptions(opts...),
+		),
+		encrypt: connect.NewClient[v1.EncryptRequest, v1.EncryptResponse](
+			httpClient,
+			baseURL+KeyManagerServiceEncryptProcedure,
+			connect.WithSchema(keyManagerServiceMethods.ByName("Encrypt")),
+			connect.WithClientOptions(opts...),
+		),
+		decrypt: connect.NewClient[v1.DecryptRequest, v1.DecryptResponse](
+			httpClient,
+			baseURL+KeyManagerServiceDecryptProcedure,
+			connect.WithSchema(keyManagerServiceMethods.ByName("Decrypt")),
+			connect.WithClientOptions(opts...),
+		),
+		rotate: connect.NewClient[v1.RotateRequest, v1.RotateResponse](
+			httpClient,
+			baseURL+KeyManagerServiceRotateProcedure,
+			connect.WithSchema(keyManagerServiceMethods.ByName("Rotate")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// keyManagerServiceClient implements KeyManagerServiceClient.
+type keyManagerServiceClient struct {
+	generateDataKey *connect.Client[v1.GenerateDataKeyRequest, v1.GenerateDataKeyResponse]
+	encrypt         *connect.Client[v1.EncryptRequest, v1.EncryptResponse]
+	decrypt         *connect.Client[v1.DecryptRequest, v1.DecryptResponse]
+	rotate          *connect.Client[v1.RotateRequest, v1.RotateResponse]
+}
+
+// GenerateDataKey calls keymanager.v1.KeyManagerService.GenerateDataKey.
+func (c *keyManagerServiceClient) GenerateDataKey(ctx context.Context, req *connect.Request[v1.GenerateDataKeyRequest]) (*connect.Response[v1.GenerateDataKeyResponse], error) {
+	return c.generateDataKey.CallUnary(ctx, req)
+}
+
+// Encrypt calls keymanager.v1.KeyManagerService.Encrypt.
+func (c *keyManagerServiceClient) Encrypt(ctx context.Context, req *connect.Request[v1.EncryptRequest]) (*connect.Response[v1.EncryptResponse], error) {
+	return c.encrypt.CallUnary(ctx, req)
+}
+
+// Decrypt calls keymanager.v1.KeyManagerService.Decrypt.
+func (c *keyManagerServiceClient) Decrypt(ctx context.Context, req *connect.Request[v1.DecryptRequest]) (*connect.Response[v1.DecryptResponse], error) {
+	return c.decrypt.CallUnary(ctx, req)
+}
+
+// Rotate calls keymanager.v1.KeyManagerService.Rotate.
+func (c *keyManagerServiceClient) Rotate(ctx context.Context, req *connect.Request[v1.RotateRequest]) (*connect.Response[v1.RotateResponse], error) {
+	return c.rotate.CallUnary(ctx, req)
+}
+
+// KeyManagerServiceHandler is an implementation of the keymanager.v1.KeyManagerService service.
+type KeyManagerServiceHandler interface {
+	// GenerateDataKey は新しい暗号化に使う最新のデータ鍵を返す｡データ鍵が1つも存在しない場合は生成する
+	GenerateDataKey(context.Context, *connect.Request[v1.GenerateDataKeyRequest]) (*connect.Response[v1.GenerateDataKeyResponse], error)
+	// Encrypt は指定したデータ鍵で平文を暗号化する
+	Encrypt(context.Context, *connect.Request[v1.EncryptRequest]) (*connect.Response[v1.EncryptResponse], error)
+	// Decrypt は指定したデータ鍵で暗号文を復号する
+	Decrypt(context.Context, *connect.Request[v1.DecryptRequest]) (*connect.Response[v1.DecryptResponse], error)
+	// Rotate は新しいデータ鍵を生成し､以降のGenerateDataKeyで返す
+	Rotate(context.Context, *connect.Request[v1.RotateRequest]) (*connect.Response[v1.RotateResponse], error)
+}
+
+// NewKeyManagerServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewKeyManagerServiceHandler(svc KeyManagerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	keyManagerServiceMethods := v1.File_keymanager_v1_keymanager_proto.Services().ByName("KeyManagerService").Methods()
+	keyManagerServiceGenerateDataKeyHandler := connect.NewUnaryHandler(
+		KeyManagerServiceGenerateDataKeyProcedure,
+		svc.GenerateDataKey,
+		connect.WithSchema(keyManagerServiceMethods.ByName("GenerateDataKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagerServiceEncryptHandler := connect.NewUnaryHandler(
+		KeyManagerServiceEncryptProcedure,
+		svc.Encrypt,
+		connect.WithSchema(keyManagerServiceMethods.ByName("Encrypt")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagerServiceDecryptHandler := connect.NewUnaryHandler(
+		KeyManagerServiceDecryptProcedure,
+		svc.Decrypt,
+		connect.WithSchema(keyManagerServiceMethods.ByName("Decrypt")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagerServiceRotateHandler := connect.NewUnaryHandler(
+		KeyManagerServiceRotateProcedure,
+		svc.Rotate,
+		connect.WithSchema(keyManagerServiceMethods.ByName("Rotate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/keymanager.v1.KeyManagerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case KeyManagerServiceGenerateDataKeyProcedure:
+			keyManagerServiceGenerateDataKeyHandler.ServeHTTP(w, r)
+		case KeyManagerServiceEncryptProcedure:
+			keyManagerServiceEncryptHandler.ServeHTTP(w, r)
+		case KeyManagerServiceDecryptProcedure:
+			keyManagerServiceDecryptHandler.ServeHTTP(w, r)
+		case KeyManagerServiceRotateProcedure:
+			keyManagerServiceRotateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedKeyManagerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedKeyManagerServiceHandler struct{}
+
+func (UnimplementedKeyManagerServiceHandler) GenerateDataKey(context.Context, *connect.Request[v1.GenerateDataKeyRequest]) (*connect.Response[v1.GenerateDataKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keymanager.v1.KeyManagerService.GenerateDataKey is not implemented"))
+}
+
+func (UnimplementedKeyManagerServiceHandler) Encrypt(context.Context, *connect.Request[v1.EncryptRequest]) (*connect.Response[v1.EncryptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keymanager.v1.KeyManagerService.Encrypt is not implemented"))
+}
+
+func (UnimplementedKeyManagerServiceHandler) Decrypt(context.Context, *connect.Request[v1.DecryptRequest]) (*connect.Response[v1.DecryptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keymanager.v1.KeyManagerService.Decrypt is not implemented"))
+}
+
+func (UnimplementedKeyManagerServiceHandler) Rotate(context.Context, *connect.Request[v1.RotateRequest]) (*connect.Response[v1.RotateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("keymanager.v1.KeyManagerService.Rotate is not implemented"))
+}
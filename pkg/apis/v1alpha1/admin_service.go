@@ -0,0 +1,199 @@
+package v1alpha1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/audit"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/authz"
+	"github.com/tacokumo/portal-api/pkg/session"
+)
+
+type AdminService struct {
+	roles       *authz.RoleResolver
+	tokens      *auth.TokenService
+	sessions    *session.Manager
+	revocations auth.RevocationList
+	audits      *audit.Logger
+	now         func() time.Time
+}
+
+// NewAdminService はAdminServiceを生成する
+// 引数がnilの場合は対応する機能が無効であり､その機能を使うAPIは503を返す
+func NewAdminService(
+	roles *authz.RoleResolver,
+	tokens *auth.TokenService,
+	sessions *session.Manager,
+	revocations auth.RevocationList,
+	audits *audit.Logger,
+) *AdminService {
+	return &AdminService{
+		roles:       roles,
+		tokens:      tokens,
+		sessions:    sessions,
+		revocations: revocations,
+		audits:      audits,
+		now:         time.Now,
+	}
+}
+
+var (
+	errPermissionCacheNotConfigured = &ErrorWithCode{
+		Code:    http.StatusServiceUnavailable,
+		Message: "permission cache is not configured",
+	}
+	errSessionsNotConfigured = &ErrorWithCode{
+		Code:    http.StatusServiceUnavailable,
+		Message: "session management is not configured",
+	}
+	errRevocationNotConfigured = &ErrorWithCode{
+		Code:    http.StatusServiceUnavailable,
+		Message: "token revocation is not configured",
+	}
+	errRevocationInFuture = &ErrorWithCode{
+		Code:    http.StatusBadRequest,
+		Message: "issued_before must not be in the future",
+	}
+)
+
+func (s *AdminService) InvalidateUserPermissions(ctx context.Context, params api.InvalidateUserPermissionsParams) (err error) {
+	defer func() {
+		s.audits.Record(ctx, audit.ActionInvalidatePermissions, audit.Target{ID: params.UserID}, err)
+	}()
+
+	if err := authorize(ctx, authz.RoleAdmin); err != nil {
+		return err
+	}
+	if s.roles == nil {
+		return errPermissionCacheNotConfigured
+	}
+
+	return s.roles.Invalidate(ctx, params.UserID)
+}
+
+func (s *AdminService) GetPermissionCacheStats(ctx context.Context) (*api.PermissionCacheStats, error) {
+	if err := authorize(ctx, authz.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if s.roles == nil {
+		return nil, errPermissionCacheNotConfigured
+	}
+
+	stats := s.roles.Stats()
+	return &api.PermissionCacheStats{
+		Hits:   stats.Hits,
+		Misses: stats.Misses,
+	}, nil
+}
+
+func (s *AdminService) ListUserSessions(ctx context.Context, params api.ListUserSessionsParams) ([]api.Session, error) {
+	if err := authorize(ctx, authz.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if s.sessions == nil {
+		return nil, errSessionsNotConfigured
+	}
+
+	sessions, err := s.sessions.List(ctx, params.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(sessions, func(sess *session.Session, _ int) api.Session {
+		return api.Session{
+			SessionID:    sess.ID,
+			UserID:       sess.UserID,
+			Role:         sess.Role,
+			AuthMethod:   sess.AuthMethod,
+			CreatedAt:    sess.CreatedAt,
+			LastAccessed: sess.LastAccessed,
+			ExpiresAt:    sess.ExpiresAt,
+		}
+	}), nil
+}
+
+// RevokeSession はセッションを削除し､そのセッションで発行済みのアクセストークンも失効させる
+// ログアウト済みのセッションでもアクセストークンは有効期限まで使えるため､セッションが存在しない場合も失効させる
+func (s *AdminService) RevokeSession(ctx context.Context, params api.RevokeSessionParams) (err error) {
+	defer func() { s.audits.Record(ctx, audit.ActionRevokeSession, audit.Target{ID: params.SessionID}, err) }()
+
+	if err := authorize(ctx, authz.RoleAdmin); err != nil {
+		return err
+	}
+	if s.sessions == nil || s.tokens == nil {
+		return errSessionsNotConfigured
+	}
+	if s.revocations == nil {
+		return errRevocationNotConfigured
+	}
+
+	if err := s.revocations.RevokeSession(ctx, params.SessionID, s.tokens.AccessTokenDuration()); err != nil {
+		return err
+	}
+	sess, err := s.sessions.Delete(ctx, params.SessionID)
+	if err != nil {
+		if errors.Is(err, session.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	// ロールを再解決させるために権限キャッシュも無効化する
+	if s.roles != nil {
+		if err := s.roles.Invalidate(ctx, sess.UserID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *AdminService) RevokeAccessToken(ctx context.Context, params api.RevokeAccessTokenParams) (err error) {
+	defer func() { s.audits.Record(ctx, audit.ActionRevokeAccessToken, audit.Target{ID: params.Jti}, err) }()
+
+	if err := authorize(ctx, authz.RoleAdmin); err != nil {
+		return err
+	}
+	if s.tokens == nil {
+		return errAuthNotConfigured
+	}
+	if s.revocations == nil {
+		return errRevocationNotConfigured
+	}
+
+	return s.revocations.RevokeToken(ctx, params.Jti, s.tokens.AccessTokenDuration())
+}
+
+// RevokeAllTokens は指定した時刻より前に発行されたアクセストークンと､その時刻より前にログインしたセッションを失効させる
+// 鍵の漏洩などの緊急時に使うことを想定している
+func (s *AdminService) RevokeAllTokens(ctx context.Context, req api.OptRevokeAllTokensRequest) (_ *api.Revocation, err error) {
+	defer func() { s.audits.Record(ctx, audit.ActionRevokeAllTokens, audit.Target{}, err) }()
+
+	if err := authorize(ctx, authz.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if s.revocations == nil {
+		return nil, errRevocationNotConfigured
+	}
+
+	now := s.now()
+	issuedBefore := now
+	if v, ok := req.Value.IssuedBefore.Get(); req.Set && ok {
+		if v.After(now) {
+			return nil, errRevocationInFuture
+		}
+		issuedBefore = v
+	}
+	if err := s.revocations.RevokeIssuedBefore(ctx, issuedBefore); err != nil {
+		return nil, err
+	}
+	before, err := s.revocations.RevokedBefore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &api.Revocation{
+		RevokedBefore: before,
+	}, nil
+}
@@ -0,0 +1,297 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/authz"
+	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/github"
+	"github.com/tacokumo/portal-api/pkg/github/githubtest"
+	"github.com/tacokumo/portal-api/pkg/session"
+)
+
+func newTestRoleResolver(t *testing.T) (*authz.RoleResolver, *githubtest.Server) {
+	t.Helper()
+
+	srv := githubtest.NewServer(t)
+	srv.AddUser("gho_token", github.User{ID: 42, Login: "octocat"})
+	srv.AddMembership("gho_token", "tacokumo")
+	roles := authz.NewRoleResolver(config.OrganizationConfig{
+		Name:        "tacokumo",
+		DefaultRole: authz.RoleViewer,
+	}, github.NewClient(srv.URL, srv.Client()), authz.NewMemoryPermissionCache())
+	return roles, srv
+}
+
+func TestAdminService_InvalidateUserPermissions(t *testing.T) {
+	tests := []struct {
+		name         string
+		role         string
+		expectedCode int
+	}{
+		{
+			name: "adminは権限キャッシュを無効化できること",
+			role: authz.RoleAdmin,
+		},
+		{
+			name:         "writerの場合は403となること",
+			role:         authz.RoleWriter,
+			expectedCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			roles, srv := newTestRoleResolver(t)
+			_, err := roles.Resolve(t.Context(), "42", "gho_token")
+			require.NoError(t, err)
+			service := NewAdminService(roles, nil, nil, nil, nil)
+
+			err = service.InvalidateUserPermissions(withRole(t.Context(), tt.role), api.InvalidateUserPermissionsParams{UserID: "42"})
+			if tt.expectedCode != 0 {
+				var ewc *ErrorWithCode
+				assert.ErrorAs(t, err, &ewc)
+				assert.Equal(t, tt.expectedCode, ewc.Code)
+				return
+			}
+			assert.NoError(t, err)
+
+			// 無効化された場合はGitHub APIを参照するため障害がそのまま返る
+			srv.Fail(http.StatusServiceUnavailable)
+			_, err = roles.Resolve(t.Context(), "42", "gho_token")
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("権限キャッシュが設定されていない場合は503となること", func(t *testing.T) {
+		t.Parallel()
+
+		err := NewAdminService(nil, nil, nil, nil, nil).InvalidateUserPermissions(withRole(t.Context(), authz.RoleAdmin), api.InvalidateUserPermissionsParams{UserID: "42"})
+		var ewc *ErrorWithCode
+		assert.ErrorAs(t, err, &ewc)
+		assert.Equal(t, http.StatusServiceUnavailable, ewc.Code)
+	})
+}
+
+func TestAdminService_GetPermissionCacheStats(t *testing.T) {
+	t.Parallel()
+
+	t.Run("キャッシュのヒット･ミスの回数を返すこと", func(t *testing.T) {
+		t.Parallel()
+
+		roles, _ := newTestRoleResolver(t)
+		for range 3 {
+			_, err := roles.Resolve(t.Context(), "42", "gho_token")
+			require.NoError(t, err)
+		}
+
+		ret, err := NewAdminService(roles, nil, nil, nil, nil).GetPermissionCacheStats(withRole(t.Context(), authz.RoleAdmin))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), ret.Hits)
+		assert.Equal(t, int64(1), ret.Misses)
+	})
+
+	t.Run("viewerの場合は403となること", func(t *testing.T) {
+		t.Parallel()
+
+		roles, _ := newTestRoleResolver(t)
+		_, err := NewAdminService(roles, nil, nil, nil, nil).GetPermissionCacheStats(withRole(t.Context(), authz.RoleViewer))
+		assert.ErrorIs(t, err, errForbidden)
+	})
+}
+
+func newTestSessionAdminService(t *testing.T) (*AdminService, *session.Manager, auth.RevocationList) {
+	t.Helper()
+
+	sessions := session.NewManager(session.NewMemoryStore(), 8*time.Hour)
+	revocations := auth.NewMemoryRevocationList()
+	return NewAdminService(nil, newTestTokenService(t), sessions, revocations, nil), sessions, revocations
+}
+
+func TestAdminService_ListUserSessions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ユーザーのセッション一覧を返すこと", func(t *testing.T) {
+		t.Parallel()
+
+		service, sessions, _ := newTestSessionAdminService(t)
+		created, _, err := sessions.Create(t.Context(), "42", authz.RoleViewer, auth.AuthMethodOAuth)
+		require.NoError(t, err)
+		_, _, err = sessions.Create(t.Context(), "43", authz.RoleViewer, auth.AuthMethodOAuth)
+		require.NoError(t, err)
+
+		ret, err := service.ListUserSessions(withRole(t.Context(), authz.RoleAdmin), api.ListUserSessionsParams{UserID: "42"})
+		assert.NoError(t, err)
+		require.Len(t, ret, 1)
+		assert.Equal(t, created.ID, ret[0].SessionID)
+		assert.Equal(t, "42", ret[0].UserID)
+		assert.Equal(t, authz.RoleViewer, ret[0].Role)
+		assert.Equal(t, auth.AuthMethodOAuth, ret[0].AuthMethod)
+	})
+
+	t.Run("writerの場合は403となること", func(t *testing.T) {
+		t.Parallel()
+
+		service, _, _ := newTestSessionAdminService(t)
+		_, err := service.ListUserSessions(withRole(t.Context(), authz.RoleWriter), api.ListUserSessionsParams{UserID: "42"})
+		assert.ErrorIs(t, err, errForbidden)
+	})
+
+	t.Run("セッション管理が設定されていない場合は503となること", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewAdminService(nil, nil, nil, nil, nil).ListUserSessions(withRole(t.Context(), authz.RoleAdmin), api.ListUserSessionsParams{UserID: "42"})
+		assert.ErrorIs(t, err, errSessionsNotConfigured)
+	})
+}
+
+func TestAdminService_RevokeSession(t *testing.T) {
+	t.Parallel()
+
+	t.Run("セッションを削除し､そのセッションのアクセストークンを失効させること", func(t *testing.T) {
+		t.Parallel()
+
+		service, sessions, revocations := newTestSessionAdminService(t)
+		created, refreshToken, err := sessions.Create(t.Context(), "42", authz.RoleViewer, auth.AuthMethodOAuth)
+		require.NoError(t, err)
+		issued, err := service.tokens.IssueAccessToken(auth.TokenSubject{UserID: "42", Role: authz.RoleViewer, SessionID: created.ID})
+		require.NoError(t, err)
+
+		err = service.RevokeSession(withRole(t.Context(), authz.RoleAdmin), api.RevokeSessionParams{SessionID: created.ID})
+		assert.NoError(t, err)
+
+		revoked, err := revocations.IsRevoked(t.Context(), issued.Claims)
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+
+		_, _, err = sessions.Refresh(t.Context(), refreshToken)
+		assert.ErrorIs(t, err, session.ErrNotFound)
+	})
+
+	t.Run("存在しないセッションでも成功すること", func(t *testing.T) {
+		t.Parallel()
+
+		service, _, _ := newTestSessionAdminService(t)
+		err := service.RevokeSession(withRole(t.Context(), authz.RoleAdmin), api.RevokeSessionParams{SessionID: "unknown"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("権限キャッシュを無効化すること", func(t *testing.T) {
+		t.Parallel()
+
+		roles, srv := newTestRoleResolver(t)
+		_, err := roles.Resolve(t.Context(), "42", "gho_token")
+		require.NoError(t, err)
+		sessions := session.NewManager(session.NewMemoryStore(), 8*time.Hour)
+		service := NewAdminService(roles, newTestTokenService(t), sessions, auth.NewMemoryRevocationList(), nil)
+		created, _, err := sessions.Create(t.Context(), "42", authz.RoleViewer, auth.AuthMethodOAuth)
+		require.NoError(t, err)
+
+		err = service.RevokeSession(withRole(t.Context(), authz.RoleAdmin), api.RevokeSessionParams{SessionID: created.ID})
+		assert.NoError(t, err)
+
+		srv.Fail(http.StatusServiceUnavailable)
+		_, err = roles.Resolve(t.Context(), "42", "gho_token")
+		assert.Error(t, err)
+	})
+
+	t.Run("viewerの場合は403となること", func(t *testing.T) {
+		t.Parallel()
+
+		service, _, _ := newTestSessionAdminService(t)
+		err := service.RevokeSession(withRole(t.Context(), authz.RoleViewer), api.RevokeSessionParams{SessionID: "session-id"})
+		assert.ErrorIs(t, err, errForbidden)
+	})
+}
+
+func TestAdminService_RevokeAccessToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("JTIを指定してアクセストークンを失効させること", func(t *testing.T) {
+		t.Parallel()
+
+		service, _, revocations := newTestSessionAdminService(t)
+		issued, err := service.tokens.IssueAccessToken(auth.TokenSubject{UserID: "42", Role: authz.RoleViewer})
+		require.NoError(t, err)
+
+		err = service.RevokeAccessToken(withRole(t.Context(), authz.RoleAdmin), api.RevokeAccessTokenParams{Jti: issued.Claims.ID})
+		assert.NoError(t, err)
+
+		revoked, err := revocations.IsRevoked(t.Context(), issued.Claims)
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+	})
+
+	t.Run("失効リストが設定されていない場合は503となること", func(t *testing.T) {
+		t.Parallel()
+
+		service := NewAdminService(nil, newTestTokenService(t), nil, nil, nil)
+		err := service.RevokeAccessToken(withRole(t.Context(), authz.RoleAdmin), api.RevokeAccessTokenParams{Jti: "jti"})
+		assert.ErrorIs(t, err, errRevocationNotConfigured)
+	})
+}
+
+func TestAdminService_RevokeAllTokens(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		req           api.OptRevokeAllTokensRequest
+		expected      time.Time
+		expectedError error
+	}{
+		{
+			name:     "時刻を省略した場合は現在時刻より前のトークンを失効させること",
+			expected: now,
+		},
+		{
+			name: "指定した時刻より前のトークンを失効させること",
+			req: api.NewOptRevokeAllTokensRequest(api.RevokeAllTokensRequest{
+				IssuedBefore: api.NewOptDateTime(now.Add(-time.Hour)),
+			}),
+			expected: now.Add(-time.Hour),
+		},
+		{
+			name: "未来の時刻は400となること",
+			req: api.NewOptRevokeAllTokensRequest(api.RevokeAllTokensRequest{
+				IssuedBefore: api.NewOptDateTime(now.Add(time.Hour)),
+			}),
+			expectedError: errRevocationInFuture,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, _, _ := newTestSessionAdminService(t)
+			service.now = func() time.Time { return now }
+
+			ret, err := service.RevokeAllTokens(withRole(t.Context(), authz.RoleAdmin), tt.req)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, tt.expected.Equal(ret.RevokedBefore), "expected %v, got %v", tt.expected, ret.RevokedBefore)
+		})
+	}
+
+	t.Run("writerの場合は403となること", func(t *testing.T) {
+		t.Parallel()
+
+		service, _, _ := newTestSessionAdminService(t)
+		_, err := service.RevokeAllTokens(withRole(t.Context(), authz.RoleWriter), api.OptRevokeAllTokensRequest{})
+		assert.ErrorIs(t, err, errForbidden)
+	})
+}
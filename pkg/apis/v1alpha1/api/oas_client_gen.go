@@ -11,6 +11,7 @@ import (
 	"github.com/go-faster/errors"
 	"github.com/ogen-go/ogen/conv"
 	ht "github.com/ogen-go/ogen/http"
+	"github.com/ogen-go/ogen/ogenerrors"
 	"github.com/ogen-go/ogen/otelogen"
 	"github.com/ogen-go/ogen/uri"
 	"go.opentelemetry.io/otel/attribute"
@@ -32,19 +33,56 @@ type Invoker interface {
 	// アプリケーションを作成するAPI.
 	//
 	// POST /v1alpha1/applications
-	CreateApplication(ctx context.Context, request *CreateApplicationRequest) (*Application, error)
+	CreateApplication(ctx context.Context, request *CreateApplicationRequest, params CreateApplicationParams) (*Application, error)
 	// CreateApplicationSecret invokes CreateApplicationSecret operation.
 	//
 	// 特定のアプリケーションのシークレットを作成するAPI.
 	//
 	// POST /v1alpha1/applications/{name}/secret
 	CreateApplicationSecret(ctx context.Context, request *CreateSecretRequest, params CreateApplicationSecretParams) (*Secret, error)
+	// DeleteApplication invokes DeleteApplication operation.
+	//
+	// アプリケーションとそのシークレットを削除するAPI｡resource_versionが最新でない場合は409を返す｡シークレットを先に削除するため､途中で失敗した場合は同じリクエストを再試行すると残りを削除できる.
+	//
+	// DELETE /v1alpha1/applications/{name}
+	DeleteApplication(ctx context.Context, params DeleteApplicationParams) error
+	// DeleteApplicationSecret invokes DeleteApplicationSecret operation.
+	//
+	// 特定のアプリケーションのシークレットを削除し､アプリケーションからの参照を外すAPI.
+	//
+	// DELETE /v1alpha1/applications/{name}/secret
+	DeleteApplicationSecret(ctx context.Context, params DeleteApplicationSecretParams) error
+	// DeleteApplicationSecretKey invokes DeleteApplicationSecretKey operation.
+	//
+	// 特定のアプリケーションのシークレットからキーを1つ削除するAPI.
+	//
+	// DELETE /v1alpha1/applications/{name}/secret/{key}
+	DeleteApplicationSecretKey(ctx context.Context, params DeleteApplicationSecretKeyParams) (*Secret, error)
+	// ExchangeInstallationToken invokes ExchangeInstallationToken operation.
+	//
+	// GitHub AppのInstallation Access
+	// Tokenを検証し､アクセスできるリポジトリに限定したアクセストークンを発行するAPI.
+	//
+	// POST /auth/token/installation
+	ExchangeInstallationToken(ctx context.Context, request *ExchangeInstallationTokenRequest) (*TokenResponse, error)
+	// ExchangePersonalAccessToken invokes ExchangePersonalAccessToken operation.
+	//
+	// GitHub Personal Access Tokenを検証し､アクセストークンを発行するAPI.
+	//
+	// POST /auth/token/pat
+	ExchangePersonalAccessToken(ctx context.Context, request *ExchangePersonalAccessTokenRequest) (*TokenResponse, error)
 	// GetApplication invokes GetApplication operation.
 	//
 	// 特定のアプリケーションを取得するAPI.
 	//
 	// GET /v1alpha1/applications/{name}
 	GetApplication(ctx context.Context, params GetApplicationParams) (*Application, error)
+	// GetApplicationRelease invokes GetApplicationRelease operation.
+	//
+	// アプリケーションのステージのReleaseを取得するAPI.
+	//
+	// GET /v1alpha1/applications/{name}/releases/{release}
+	GetApplicationRelease(ctx context.Context, params GetApplicationReleaseParams) (*Release, error)
 	// GetApplicationSecret invokes GetApplicationSecret operation.
 	//
 	// 特定のアプリケーションのシークレットを取得するAPI.
@@ -53,10 +91,24 @@ type Invoker interface {
 	GetApplicationSecret(ctx context.Context, params GetApplicationSecretParams) (*Secret, error)
 	// GetApplications invokes GetApplications operation.
 	//
-	// アプリケーション一覧を取得するAPI.
+	// アプリケーション一覧を取得するAPI｡全件を配列で返すため､ページングが必要な場合は/v1alpha2/applicationsを使う.
+	//
+	// Deprecated: schema marks this operation as deprecated.
 	//
 	// GET /v1alpha1/applications
 	GetApplications(ctx context.Context) ([]Application, error)
+	// GetAuthGitHubCallback invokes GetAuthGitHubCallback operation.
+	//
+	// GitHub OAuthのコールバックを受け取り､認可コードを交換するAPI.
+	//
+	// GET /auth/github/callback
+	GetAuthGitHubCallback(ctx context.Context, params GetAuthGitHubCallbackParams) (*AuthResult, error)
+	// GetAuthGitHubLogin invokes GetAuthGitHubLogin operation.
+	//
+	// GitHub OAuthの認可URLへリダイレクトするAPI.
+	//
+	// GET /auth/github/login
+	GetAuthGitHubLogin(ctx context.Context) (*GetAuthGitHubLoginFound, error)
 	// GetHealthLiveness invokes GetHealthLiveness operation.
 	//
 	// Liveness statusを取得するAPI.
@@ -65,21 +117,137 @@ type Invoker interface {
 	GetHealthLiveness(ctx context.Context) (*HealthCheckStatus, error)
 	// GetHealthReadiness invokes GetHealthReadiness operation.
 	//
-	// Readiness statusを取得するAPI.
+	// Readiness
+	// statusを取得するAPI｡Applicationのキャッシュの同期が完了するまでは503を返す.
 	//
 	// GET /health/readiness
 	GetHealthReadiness(ctx context.Context) (*HealthCheckStatus, error)
+	// GetJWKS invokes GetJWKS operation.
+	//
+	// アクセストークンの署名を検証するための公開鍵を取得するAPI.
+	//
+	// GET /.well-known/jwks.json
+	GetJWKS(ctx context.Context) (*JWKS, error)
+	// GetPermissionCacheStats invokes GetPermissionCacheStats operation.
+	//
+	// 権限キャッシュのヒット･ミスの回数を取得するAPI.
+	//
+	// GET /v1alpha1/admin/permissions/stats
+	GetPermissionCacheStats(ctx context.Context) (*PermissionCacheStats, error)
+	// GetSecretKeyRotation invokes GetSecretKeyRotation operation.
+	//
+	// 鍵のローテーションによる再暗号化の進捗を取得するAPI.
+	//
+	// GET /v1alpha1/admin/secret-keys/rotation
+	GetSecretKeyRotation(ctx context.Context) (*SecretKeyRotation, error)
+	// InvalidateUserPermissions invokes InvalidateUserPermissions operation.
+	//
+	// ユーザーの権限キャッシュを無効化し､次回の認証時にGitHubのTeam情報を再取得させるAPI.
+	//
+	// DELETE /v1alpha1/admin/users/{user_id}/permissions
+	InvalidateUserPermissions(ctx context.Context, params InvalidateUserPermissionsParams) error
+	// ListApplicationReleases invokes ListApplicationReleases operation.
+	//
+	// アプリケーションのステージごとのReleaseを取得するAPI
+	// コントローラーはステージごとに1つのReleaseを作成して更新し続けるため､過去のReleaseの履歴は含まれない
+	// ステージはappconfigに定義された順に返す
+	// 履歴の番号とロールバックはコントローラーが対応するまで提供しない（docs/adr/006-controller-integration.md）.
+	//
+	// GET /v1alpha1/applications/{name}/releases
+	ListApplicationReleases(ctx context.Context, params ListApplicationReleasesParams) ([]Release, error)
+	// ListApplications invokes ListApplications operation.
+	//
+	// アプリケーション一覧をページングして取得するAPI｡レスポンスのnext_page_tokenをpage_tokenに指定すると次のページを取得できる.
+	//
+	// GET /v1alpha2/applications
+	ListApplications(ctx context.Context, params ListApplicationsParams) (*ApplicationList, error)
+	// ListUserSessions invokes ListUserSessions operation.
+	//
+	// ユーザーの有効なセッション一覧を取得するAPI.
+	//
+	// GET /v1alpha1/admin/users/{user_id}/sessions
+	ListUserSessions(ctx context.Context, params ListUserSessionsParams) ([]Session, error)
+	// Logout invokes Logout operation.
+	//
+	// リフレッシュトークンが属するセッションを削除するAPI.
+	//
+	// POST /auth/logout
+	Logout(ctx context.Context, request *RefreshTokenRequest) error
+	// PatchApplication invokes PatchApplication operation.
+	//
+	// JSON Merge Patch (RFC 7396)
+	// でアプリケーションを部分的に更新するAPI｡resource_versionを指定した場合は最新でなければ409を返す.
+	//
+	// PATCH /v1alpha1/applications/{name}
+	PatchApplication(ctx context.Context, request *PatchApplicationRequest, params PatchApplicationParams) (*Application, error)
+	// PatchApplicationSecret invokes PatchApplicationSecret operation.
+	//
+	// 特定のアプリケーションのシークレットにitemsをマージするAPI｡itemsに含まれないキーは変更しない.
+	//
+	// PATCH /v1alpha1/applications/{name}/secret
+	PatchApplicationSecret(ctx context.Context, request *CreateSecretRequest, params PatchApplicationSecretParams) (*SecretUpdate, error)
+	// RefreshToken invokes RefreshToken operation.
+	//
+	// リフレッシュトークンをローテーションし､新しいアクセストークンを発行するAPI.
+	//
+	// POST /auth/token/refresh
+	RefreshToken(ctx context.Context, request *RefreshTokenRequest) (*TokenResponse, error)
+	// RevokeAccessToken invokes RevokeAccessToken operation.
+	//
+	// JTIを指定してアクセストークンを即時に失効させるAPI.
+	//
+	// DELETE /v1alpha1/admin/tokens/{jti}
+	RevokeAccessToken(ctx context.Context, params RevokeAccessTokenParams) error
+	// RevokeAllTokens invokes RevokeAllTokens operation.
+	//
+	// 指定した時刻より前に発行された全てのアクセストークンとセッションを失効させるAPI.
+	//
+	// POST /v1alpha1/admin/revocations
+	RevokeAllTokens(ctx context.Context, request OptRevokeAllTokensRequest) (*Revocation, error)
+	// RevokeSession invokes RevokeSession operation.
+	//
+	// セッションを削除し､そのセッションで発行されたアクセストークンを即時に失効させるAPI.
+	//
+	// DELETE /v1alpha1/admin/sessions/{session_id}
+	RevokeSession(ctx context.Context, params RevokeSessionParams) error
+	// RotateSecretKey invokes RotateSecretKey operation.
+	//
+	// Secretを暗号化する鍵をローテーションし､既存のSecretを新しい鍵でバックグラウンドで再暗号化するAPI.
+	//
+	// POST /v1alpha1/admin/secret-keys/rotate
+	RotateSecretKey(ctx context.Context) (*SecretKeyRotation, error)
+	// UpdateApplication invokes UpdateApplication operation.
+	//
+	// アプリケーションを置き換えるAPI｡resource_versionが最新でない場合は409を返す.
+	//
+	// PUT /v1alpha1/applications/{name}
+	UpdateApplication(ctx context.Context, request *UpdateApplicationRequest, params UpdateApplicationParams) (*Application, error)
 	// UpdateApplicationSecret invokes UpdateApplicationSecret operation.
 	//
-	// 特定のアプリケーションのシークレットを更新するAPI.
+	// 特定のアプリケーションのシークレットをitemsで置き換えるAPI｡itemsに含まれないキーは削除する.
 	//
 	// PUT /v1alpha1/applications/{name}/secret
-	UpdateApplicationSecret(ctx context.Context, request *CreateSecretRequest, params UpdateApplicationSecretParams) (*Secret, error)
+	UpdateApplicationSecret(ctx context.Context, request *CreateSecretRequest, params UpdateApplicationSecretParams) (*SecretUpdate, error)
+	// WatchApplications invokes WatchApplications operation.
+	//
+	// アプリケーションの変更をServer-Sent Eventsで配信するAPI
+	// 各イベントのeventは次のいずれかであり､idにはresource_versionを設定する
+	// - ADDED､MODIFIED､DELETED:
+	// dataはApplication｡MODIFIEDはクライアントが知らないアプリケーションに対しても送られることがあるため､追加または更新として扱う
+	// - BOOKMARK: dataは{"resource_version": "...
+	// "}｡再接続時にresource_versionに指定すると続きから受け取れる
+	// - ERROR:
+	// dataはError｡送信後にストリームを終了する｡codeが41000の場合はresource_versionが古すぎるため､一覧を取得し直してから再接続する
+	// 接続を維持するために一定間隔でコメント行を送る｡アクセストークンの有効期限が切れた場合や､失効させた場合はERRORを送って終了する.
+	//
+	// GET /v1alpha1/applications/watch
+	WatchApplications(ctx context.Context, params WatchApplicationsParams) (WatchApplicationsOK, error)
 }
 
 // Client implements OAS client.
 type Client struct {
 	serverURL *url.URL
+	sec       SecuritySource
 	baseClient
 }
 type errorHandler interface {
@@ -92,7 +260,7 @@ var _ Handler = struct {
 }{}
 
 // NewClient initializes new Client defined by OAS.
-func NewClient(serverURL string, opts ...ClientOption) (*Client, error) {
+func NewClient(serverURL string, sec SecuritySource, opts ...ClientOption) (*Client, error) {
 	u, err := url.Parse(serverURL)
 	if err != nil {
 		return nil, err
@@ -105,6 +273,7 @@ func NewClient(serverURL string, opts ...ClientOption) (*Client, error) {
 	}
 	return &Client{
 		serverURL:  u,
+		sec:        sec,
 		baseClient: c,
 	}, nil
 }
@@ -129,12 +298,12 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 // アプリケーションを作成するAPI.
 //
 // POST /v1alpha1/applications
-func (c *Client) CreateApplication(ctx context.Context, request *CreateApplicationRequest) (*Application, error) {
-	res, err := c.sendCreateApplication(ctx, request)
+func (c *Client) CreateApplication(ctx context.Context, request *CreateApplicationRequest, params CreateApplicationParams) (*Application, error) {
+	res, err := c.sendCreateApplication(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCreateApplication(ctx context.Context, request *CreateApplicationRequest) (res *Application, err error) {
+func (c *Client) sendCreateApplication(ctx context.Context, request *CreateApplicationRequest, params CreateApplicationParams) (res *Application, err error) {
 	otelAttrs := []attribute.KeyValue{
 		otelogen.OperationID("CreateApplication"),
 		semconv.HTTPRequestMethodKey.String("POST"),
@@ -175,6 +344,44 @@ func (c *Client) sendCreateApplication(ctx context.Context, request *CreateAppli
 	pathParts[0] = "/v1alpha1/applications"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "wait" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "wait",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Wait.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "timeout" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "timeout",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Timeout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
@@ -184,6 +391,39 @@ func (c *Client) sendCreateApplication(ctx context.Context, request *CreateAppli
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, CreateApplicationOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -279,6 +519,39 @@ func (c *Client) sendCreateApplicationSecret(ctx context.Context, request *Creat
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, CreateApplicationSecretOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -295,20 +568,20 @@ func (c *Client) sendCreateApplicationSecret(ctx context.Context, request *Creat
 	return result, nil
 }
 
-// GetApplication invokes GetApplication operation.
+// DeleteApplication invokes DeleteApplication operation.
 //
-// 特定のアプリケーションを取得するAPI.
+// アプリケーションとそのシークレットを削除するAPI｡resource_versionが最新でない場合は409を返す｡シークレットを先に削除するため､途中で失敗した場合は同じリクエストを再試行すると残りを削除できる.
 //
-// GET /v1alpha1/applications/{name}
-func (c *Client) GetApplication(ctx context.Context, params GetApplicationParams) (*Application, error) {
-	res, err := c.sendGetApplication(ctx, params)
-	return res, err
+// DELETE /v1alpha1/applications/{name}
+func (c *Client) DeleteApplication(ctx context.Context, params DeleteApplicationParams) error {
+	_, err := c.sendDeleteApplication(ctx, params)
+	return err
 }
 
-func (c *Client) sendGetApplication(ctx context.Context, params GetApplicationParams) (res *Application, err error) {
+func (c *Client) sendDeleteApplication(ctx context.Context, params DeleteApplicationParams) (res *DeleteApplicationNoContent, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetApplication"),
-		semconv.HTTPRequestMethodKey.String("GET"),
+		otelogen.OperationID("DeleteApplication"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
 		semconv.URLTemplateKey.String("/v1alpha1/applications/{name}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
@@ -325,7 +598,7 @@ func (c *Client) sendGetApplication(ctx context.Context, params GetApplicationPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetApplicationOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteApplicationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -364,12 +637,63 @@ func (c *Client) sendGetApplication(ctx context.Context, params GetApplicationPa
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "resource_version" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "resource_version",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.ResourceVersion))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DeleteApplicationOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -378,7 +702,7 @@ func (c *Client) sendGetApplication(ctx context.Context, params GetApplicationPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetApplicationResponse(resp)
+	result, err := decodeDeleteApplicationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -386,20 +710,20 @@ func (c *Client) sendGetApplication(ctx context.Context, params GetApplicationPa
 	return result, nil
 }
 
-// GetApplicationSecret invokes GetApplicationSecret operation.
+// DeleteApplicationSecret invokes DeleteApplicationSecret operation.
 //
-// 特定のアプリケーションのシークレットを取得するAPI.
+// 特定のアプリケーションのシークレットを削除し､アプリケーションからの参照を外すAPI.
 //
-// GET /v1alpha1/applications/{name}/secret
-func (c *Client) GetApplicationSecret(ctx context.Context, params GetApplicationSecretParams) (*Secret, error) {
-	res, err := c.sendGetApplicationSecret(ctx, params)
-	return res, err
+// DELETE /v1alpha1/applications/{name}/secret
+func (c *Client) DeleteApplicationSecret(ctx context.Context, params DeleteApplicationSecretParams) error {
+	_, err := c.sendDeleteApplicationSecret(ctx, params)
+	return err
 }
 
-func (c *Client) sendGetApplicationSecret(ctx context.Context, params GetApplicationSecretParams) (res *Secret, err error) {
+func (c *Client) sendDeleteApplicationSecret(ctx context.Context, params DeleteApplicationSecretParams) (res *DeleteApplicationSecretNoContent, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetApplicationSecret"),
-		semconv.HTTPRequestMethodKey.String("GET"),
+		otelogen.OperationID("DeleteApplicationSecret"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
 		semconv.URLTemplateKey.String("/v1alpha1/applications/{name}/secret"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
@@ -416,7 +740,7 @@ func (c *Client) sendGetApplicationSecret(ctx context.Context, params GetApplica
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetApplicationSecretOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteApplicationSecretOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -457,11 +781,44 @@ func (c *Client) sendGetApplicationSecret(ctx context.Context, params GetApplica
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DeleteApplicationSecretOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -470,7 +827,7 @@ func (c *Client) sendGetApplicationSecret(ctx context.Context, params GetApplica
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetApplicationSecretResponse(resp)
+	result, err := decodeDeleteApplicationSecretResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -478,21 +835,21 @@ func (c *Client) sendGetApplicationSecret(ctx context.Context, params GetApplica
 	return result, nil
 }
 
-// GetApplications invokes GetApplications operation.
+// DeleteApplicationSecretKey invokes DeleteApplicationSecretKey operation.
 //
-// アプリケーション一覧を取得するAPI.
+// 特定のアプリケーションのシークレットからキーを1つ削除するAPI.
 //
-// GET /v1alpha1/applications
-func (c *Client) GetApplications(ctx context.Context) ([]Application, error) {
-	res, err := c.sendGetApplications(ctx)
+// DELETE /v1alpha1/applications/{name}/secret/{key}
+func (c *Client) DeleteApplicationSecretKey(ctx context.Context, params DeleteApplicationSecretKeyParams) (*Secret, error) {
+	res, err := c.sendDeleteApplicationSecretKey(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetApplications(ctx context.Context) (res []Application, err error) {
+func (c *Client) sendDeleteApplicationSecretKey(ctx context.Context, params DeleteApplicationSecretKeyParams) (res *Secret, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetApplications"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/v1alpha1/applications"),
+		otelogen.OperationID("DeleteApplicationSecretKey"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/v1alpha1/applications/{name}/secret/{key}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -508,7 +865,7 @@ func (c *Client) sendGetApplications(ctx context.Context) (res []Application, er
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetApplicationsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteApplicationSecretKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -525,16 +882,86 @@ func (c *Client) sendGetApplications(ctx context.Context) (res []Application, er
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1alpha1/applications"
+	var pathParts [4]string
+	pathParts[0] = "/v1alpha1/applications/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/secret/"
+	{
+		// Encode "key" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "key",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Key))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DeleteApplicationSecretKeyOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -543,7 +970,7 @@ func (c *Client) sendGetApplications(ctx context.Context) (res []Application, er
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetApplicationsResponse(resp)
+	result, err := decodeDeleteApplicationSecretKeyResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -551,21 +978,22 @@ func (c *Client) sendGetApplications(ctx context.Context) (res []Application, er
 	return result, nil
 }
 
-// GetHealthLiveness invokes GetHealthLiveness operation.
+// ExchangeInstallationToken invokes ExchangeInstallationToken operation.
 //
-// Liveness statusを取得するAPI.
+// GitHub AppのInstallation Access
+// Tokenを検証し､アクセスできるリポジトリに限定したアクセストークンを発行するAPI.
 //
-// GET /health/liveness
-func (c *Client) GetHealthLiveness(ctx context.Context) (*HealthCheckStatus, error) {
-	res, err := c.sendGetHealthLiveness(ctx)
+// POST /auth/token/installation
+func (c *Client) ExchangeInstallationToken(ctx context.Context, request *ExchangeInstallationTokenRequest) (*TokenResponse, error) {
+	res, err := c.sendExchangeInstallationToken(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetHealthLiveness(ctx context.Context) (res *HealthCheckStatus, err error) {
+func (c *Client) sendExchangeInstallationToken(ctx context.Context, request *ExchangeInstallationTokenRequest) (res *TokenResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetHealthLiveness"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/health/liveness"),
+		otelogen.OperationID("ExchangeInstallationToken"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/auth/token/installation"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -581,7 +1009,7 @@ func (c *Client) sendGetHealthLiveness(ctx context.Context) (res *HealthCheckSta
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetHealthLivenessOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ExchangeInstallationTokenOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -599,14 +1027,17 @@ func (c *Client) sendGetHealthLiveness(ctx context.Context) (res *HealthCheckSta
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/health/liveness"
+	pathParts[0] = "/auth/token/installation"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeExchangeInstallationTokenRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -616,7 +1047,7 @@ func (c *Client) sendGetHealthLiveness(ctx context.Context) (res *HealthCheckSta
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetHealthLivenessResponse(resp)
+	result, err := decodeExchangeInstallationTokenResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -624,21 +1055,21 @@ func (c *Client) sendGetHealthLiveness(ctx context.Context) (res *HealthCheckSta
 	return result, nil
 }
 
-// GetHealthReadiness invokes GetHealthReadiness operation.
+// ExchangePersonalAccessToken invokes ExchangePersonalAccessToken operation.
 //
-// Readiness statusを取得するAPI.
+// GitHub Personal Access Tokenを検証し､アクセストークンを発行するAPI.
 //
-// GET /health/readiness
-func (c *Client) GetHealthReadiness(ctx context.Context) (*HealthCheckStatus, error) {
-	res, err := c.sendGetHealthReadiness(ctx)
+// POST /auth/token/pat
+func (c *Client) ExchangePersonalAccessToken(ctx context.Context, request *ExchangePersonalAccessTokenRequest) (*TokenResponse, error) {
+	res, err := c.sendExchangePersonalAccessToken(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetHealthReadiness(ctx context.Context) (res *HealthCheckStatus, err error) {
+func (c *Client) sendExchangePersonalAccessToken(ctx context.Context, request *ExchangePersonalAccessTokenRequest) (res *TokenResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetHealthReadiness"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/health/readiness"),
+		otelogen.OperationID("ExchangePersonalAccessToken"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/auth/token/pat"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -654,7 +1085,7 @@ func (c *Client) sendGetHealthReadiness(ctx context.Context) (res *HealthCheckSt
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetHealthReadinessOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ExchangePersonalAccessTokenOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -672,14 +1103,17 @@ func (c *Client) sendGetHealthReadiness(ctx context.Context) (res *HealthCheckSt
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/health/readiness"
+	pathParts[0] = "/auth/token/pat"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeExchangePersonalAccessTokenRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -689,7 +1123,7 @@ func (c *Client) sendGetHealthReadiness(ctx context.Context) (res *HealthCheckSt
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetHealthReadinessResponse(resp)
+	result, err := decodeExchangePersonalAccessTokenResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -697,21 +1131,21 @@ func (c *Client) sendGetHealthReadiness(ctx context.Context) (res *HealthCheckSt
 	return result, nil
 }
 
-// UpdateApplicationSecret invokes UpdateApplicationSecret operation.
+// GetApplication invokes GetApplication operation.
 //
-// 特定のアプリケーションのシークレットを更新するAPI.
+// 特定のアプリケーションを取得するAPI.
 //
-// PUT /v1alpha1/applications/{name}/secret
-func (c *Client) UpdateApplicationSecret(ctx context.Context, request *CreateSecretRequest, params UpdateApplicationSecretParams) (*Secret, error) {
-	res, err := c.sendUpdateApplicationSecret(ctx, request, params)
+// GET /v1alpha1/applications/{name}
+func (c *Client) GetApplication(ctx context.Context, params GetApplicationParams) (*Application, error) {
+	res, err := c.sendGetApplication(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUpdateApplicationSecret(ctx context.Context, request *CreateSecretRequest, params UpdateApplicationSecretParams) (res *Secret, err error) {
+func (c *Client) sendGetApplication(ctx context.Context, params GetApplicationParams) (res *Application, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("UpdateApplicationSecret"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/v1alpha1/applications/{name}/secret"),
+		otelogen.OperationID("GetApplication"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/v1alpha1/applications/{name}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -727,7 +1161,7 @@ func (c *Client) sendUpdateApplicationSecret(ctx context.Context, request *Creat
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateApplicationSecretOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetApplicationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -744,7 +1178,7 @@ func (c *Client) sendUpdateApplicationSecret(ctx context.Context, request *Creat
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
+	var pathParts [2]string
 	pathParts[0] = "/v1alpha1/applications/"
 	{
 		// Encode "name" parameter.
@@ -764,16 +1198,45 @@ func (c *Client) sendUpdateApplicationSecret(ctx context.Context, request *Creat
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/secret"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateApplicationSecretRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetApplicationOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
 	}
 
 	stage = "SendRequest"
@@ -784,7 +1247,2901 @@ func (c *Client) sendUpdateApplicationSecret(ctx context.Context, request *Creat
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateApplicationSecretResponse(resp)
+	result, err := decodeGetApplicationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetApplicationRelease invokes GetApplicationRelease operation.
+//
+// アプリケーションのステージのReleaseを取得するAPI.
+//
+// GET /v1alpha1/applications/{name}/releases/{release}
+func (c *Client) GetApplicationRelease(ctx context.Context, params GetApplicationReleaseParams) (*Release, error) {
+	res, err := c.sendGetApplicationRelease(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetApplicationRelease(ctx context.Context, params GetApplicationReleaseParams) (res *Release, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetApplicationRelease"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/v1alpha1/applications/{name}/releases/{release}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetApplicationReleaseOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/v1alpha1/applications/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/releases/"
+	{
+		// Encode "release" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "release",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Release))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetApplicationReleaseOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetApplicationReleaseResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetApplicationSecret invokes GetApplicationSecret operation.
+//
+// 特定のアプリケーションのシークレットを取得するAPI.
+//
+// GET /v1alpha1/applications/{name}/secret
+func (c *Client) GetApplicationSecret(ctx context.Context, params GetApplicationSecretParams) (*Secret, error) {
+	res, err := c.sendGetApplicationSecret(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetApplicationSecret(ctx context.Context, params GetApplicationSecretParams) (res *Secret, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetApplicationSecret"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/v1alpha1/applications/{name}/secret"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetApplicationSecretOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1alpha1/applications/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/secret"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetApplicationSecretOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetApplicationSecretResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetApplications invokes GetApplications operation.
+//
+// アプリケーション一覧を取得するAPI｡全件を配列で返すため､ページングが必要な場合は/v1alpha2/applicationsを使う.
+//
+// Deprecated: schema marks this operation as deprecated.
+//
+// GET /v1alpha1/applications
+func (c *Client) GetApplications(ctx context.Context) ([]Application, error) {
+	res, err := c.sendGetApplications(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetApplications(ctx context.Context) (res []Application, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetApplications"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/v1alpha1/applications"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetApplicationsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1alpha1/applications"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetApplicationsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetApplicationsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetAuthGitHubCallback invokes GetAuthGitHubCallback operation.
+//
+// GitHub OAuthのコールバックを受け取り､認可コードを交換するAPI.
+//
+// GET /auth/github/callback
+func (c *Client) GetAuthGitHubCallback(ctx context.Context, params GetAuthGitHubCallbackParams) (*AuthResult, error) {
+	res, err := c.sendGetAuthGitHubCallback(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetAuthGitHubCallback(ctx context.Context, params GetAuthGitHubCallbackParams) (res *AuthResult, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetAuthGitHubCallback"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/auth/github/callback"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetAuthGitHubCallbackOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/auth/github/callback"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "code" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "code",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Code.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "state" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "state",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.State.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "error" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "error",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Error.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetAuthGitHubCallbackResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetAuthGitHubLogin invokes GetAuthGitHubLogin operation.
+//
+// GitHub OAuthの認可URLへリダイレクトするAPI.
+//
+// GET /auth/github/login
+func (c *Client) GetAuthGitHubLogin(ctx context.Context) (*GetAuthGitHubLoginFound, error) {
+	res, err := c.sendGetAuthGitHubLogin(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetAuthGitHubLogin(ctx context.Context) (res *GetAuthGitHubLoginFound, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetAuthGitHubLogin"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/auth/github/login"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetAuthGitHubLoginOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/auth/github/login"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetAuthGitHubLoginResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetHealthLiveness invokes GetHealthLiveness operation.
+//
+// Liveness statusを取得するAPI.
+//
+// GET /health/liveness
+func (c *Client) GetHealthLiveness(ctx context.Context) (*HealthCheckStatus, error) {
+	res, err := c.sendGetHealthLiveness(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetHealthLiveness(ctx context.Context) (res *HealthCheckStatus, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetHealthLiveness"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/health/liveness"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetHealthLivenessOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/health/liveness"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetHealthLivenessResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetHealthReadiness invokes GetHealthReadiness operation.
+//
+// Readiness
+// statusを取得するAPI｡Applicationのキャッシュの同期が完了するまでは503を返す.
+//
+// GET /health/readiness
+func (c *Client) GetHealthReadiness(ctx context.Context) (*HealthCheckStatus, error) {
+	res, err := c.sendGetHealthReadiness(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetHealthReadiness(ctx context.Context) (res *HealthCheckStatus, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetHealthReadiness"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/health/readiness"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetHealthReadinessOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/health/readiness"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetHealthReadinessResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetJWKS invokes GetJWKS operation.
+//
+// アクセストークンの署名を検証するための公開鍵を取得するAPI.
+//
+// GET /.well-known/jwks.json
+func (c *Client) GetJWKS(ctx context.Context) (*JWKS, error) {
+	res, err := c.sendGetJWKS(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetJWKS(ctx context.Context) (res *JWKS, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetJWKS"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/.well-known/jwks.json"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetJWKSOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/.well-known/jwks.json"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetJWKSResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPermissionCacheStats invokes GetPermissionCacheStats operation.
+//
+// 権限キャッシュのヒット･ミスの回数を取得するAPI.
+//
+// GET /v1alpha1/admin/permissions/stats
+func (c *Client) GetPermissionCacheStats(ctx context.Context) (*PermissionCacheStats, error) {
+	res, err := c.sendGetPermissionCacheStats(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetPermissionCacheStats(ctx context.Context) (res *PermissionCacheStats, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetPermissionCacheStats"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/v1alpha1/admin/permissions/stats"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPermissionCacheStatsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1alpha1/admin/permissions/stats"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetPermissionCacheStatsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetPermissionCacheStatsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetSecretKeyRotation invokes GetSecretKeyRotation operation.
+//
+// 鍵のローテーションによる再暗号化の進捗を取得するAPI.
+//
+// GET /v1alpha1/admin/secret-keys/rotation
+func (c *Client) GetSecretKeyRotation(ctx context.Context) (*SecretKeyRotation, error) {
+	res, err := c.sendGetSecretKeyRotation(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetSecretKeyRotation(ctx context.Context) (res *SecretKeyRotation, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetSecretKeyRotation"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/v1alpha1/admin/secret-keys/rotation"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetSecretKeyRotationOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1alpha1/admin/secret-keys/rotation"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetSecretKeyRotationOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetSecretKeyRotationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// InvalidateUserPermissions invokes InvalidateUserPermissions operation.
+//
+// ユーザーの権限キャッシュを無効化し､次回の認証時にGitHubのTeam情報を再取得させるAPI.
+//
+// DELETE /v1alpha1/admin/users/{user_id}/permissions
+func (c *Client) InvalidateUserPermissions(ctx context.Context, params InvalidateUserPermissionsParams) error {
+	_, err := c.sendInvalidateUserPermissions(ctx, params)
+	return err
+}
+
+func (c *Client) sendInvalidateUserPermissions(ctx context.Context, params InvalidateUserPermissionsParams) (res *InvalidateUserPermissionsNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("InvalidateUserPermissions"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/v1alpha1/admin/users/{user_id}/permissions"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, InvalidateUserPermissionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1alpha1/admin/users/"
+	{
+		// Encode "user_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "user_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UserID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/permissions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, InvalidateUserPermissionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeInvalidateUserPermissionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListApplicationReleases invokes ListApplicationReleases operation.
+//
+// アプリケーションのステージごとのReleaseを取得するAPI
+// コントローラーはステージごとに1つのReleaseを作成して更新し続けるため､過去のReleaseの履歴は含まれない
+// ステージはappconfigに定義された順に返す
+// 履歴の番号とロールバックはコントローラーが対応するまで提供しない（docs/adr/006-controller-integration.md）.
+//
+// GET /v1alpha1/applications/{name}/releases
+func (c *Client) ListApplicationReleases(ctx context.Context, params ListApplicationReleasesParams) ([]Release, error) {
+	res, err := c.sendListApplicationReleases(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListApplicationReleases(ctx context.Context, params ListApplicationReleasesParams) (res []Release, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListApplicationReleases"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/v1alpha1/applications/{name}/releases"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListApplicationReleasesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1alpha1/applications/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/releases"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListApplicationReleasesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListApplicationReleasesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListApplications invokes ListApplications operation.
+//
+// アプリケーション一覧をページングして取得するAPI｡レスポンスのnext_page_tokenをpage_tokenに指定すると次のページを取得できる.
+//
+// GET /v1alpha2/applications
+func (c *Client) ListApplications(ctx context.Context, params ListApplicationsParams) (*ApplicationList, error) {
+	res, err := c.sendListApplications(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListApplications(ctx context.Context, params ListApplicationsParams) (res *ApplicationList, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListApplications"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/v1alpha2/applications"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListApplicationsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1alpha2/applications"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page_token" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page_token",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PageToken.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "repository_url" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "repository_url",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.RepositoryURL.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "appconfig_branch" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "appconfig_branch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.AppconfigBranch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "label_selector" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "label_selector",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.LabelSelector.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "name_prefix" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name_prefix",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.NamePrefix.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListApplicationsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListApplicationsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListUserSessions invokes ListUserSessions operation.
+//
+// ユーザーの有効なセッション一覧を取得するAPI.
+//
+// GET /v1alpha1/admin/users/{user_id}/sessions
+func (c *Client) ListUserSessions(ctx context.Context, params ListUserSessionsParams) ([]Session, error) {
+	res, err := c.sendListUserSessions(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListUserSessions(ctx context.Context, params ListUserSessionsParams) (res []Session, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListUserSessions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/v1alpha1/admin/users/{user_id}/sessions"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListUserSessionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1alpha1/admin/users/"
+	{
+		// Encode "user_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "user_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UserID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/sessions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListUserSessionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListUserSessionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Logout invokes Logout operation.
+//
+// リフレッシュトークンが属するセッションを削除するAPI.
+//
+// POST /auth/logout
+func (c *Client) Logout(ctx context.Context, request *RefreshTokenRequest) error {
+	_, err := c.sendLogout(ctx, request)
+	return err
+}
+
+func (c *Client) sendLogout(ctx context.Context, request *RefreshTokenRequest) (res *LogoutNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("Logout"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/auth/logout"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, LogoutOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/auth/logout"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeLogoutRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeLogoutResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PatchApplication invokes PatchApplication operation.
+//
+// JSON Merge Patch (RFC 7396)
+// でアプリケーションを部分的に更新するAPI｡resource_versionを指定した場合は最新でなければ409を返す.
+//
+// PATCH /v1alpha1/applications/{name}
+func (c *Client) PatchApplication(ctx context.Context, request *PatchApplicationRequest, params PatchApplicationParams) (*Application, error) {
+	res, err := c.sendPatchApplication(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPatchApplication(ctx context.Context, request *PatchApplicationRequest, params PatchApplicationParams) (res *Application, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("PatchApplication"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/v1alpha1/applications/{name}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PatchApplicationOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1alpha1/applications/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePatchApplicationRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, PatchApplicationOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePatchApplicationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PatchApplicationSecret invokes PatchApplicationSecret operation.
+//
+// 特定のアプリケーションのシークレットにitemsをマージするAPI｡itemsに含まれないキーは変更しない.
+//
+// PATCH /v1alpha1/applications/{name}/secret
+func (c *Client) PatchApplicationSecret(ctx context.Context, request *CreateSecretRequest, params PatchApplicationSecretParams) (*SecretUpdate, error) {
+	res, err := c.sendPatchApplicationSecret(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPatchApplicationSecret(ctx context.Context, request *CreateSecretRequest, params PatchApplicationSecretParams) (res *SecretUpdate, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("PatchApplicationSecret"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/v1alpha1/applications/{name}/secret"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PatchApplicationSecretOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1alpha1/applications/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/secret"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePatchApplicationSecretRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, PatchApplicationSecretOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePatchApplicationSecretResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RefreshToken invokes RefreshToken operation.
+//
+// リフレッシュトークンをローテーションし､新しいアクセストークンを発行するAPI.
+//
+// POST /auth/token/refresh
+func (c *Client) RefreshToken(ctx context.Context, request *RefreshTokenRequest) (*TokenResponse, error) {
+	res, err := c.sendRefreshToken(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendRefreshToken(ctx context.Context, request *RefreshTokenRequest) (res *TokenResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RefreshToken"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/auth/token/refresh"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RefreshTokenOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/auth/token/refresh"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRefreshTokenRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRefreshTokenResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RevokeAccessToken invokes RevokeAccessToken operation.
+//
+// JTIを指定してアクセストークンを即時に失効させるAPI.
+//
+// DELETE /v1alpha1/admin/tokens/{jti}
+func (c *Client) RevokeAccessToken(ctx context.Context, params RevokeAccessTokenParams) error {
+	_, err := c.sendRevokeAccessToken(ctx, params)
+	return err
+}
+
+func (c *Client) sendRevokeAccessToken(ctx context.Context, params RevokeAccessTokenParams) (res *RevokeAccessTokenNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RevokeAccessToken"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/v1alpha1/admin/tokens/{jti}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RevokeAccessTokenOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1alpha1/admin/tokens/"
+	{
+		// Encode "jti" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "jti",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Jti))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RevokeAccessTokenOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRevokeAccessTokenResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RevokeAllTokens invokes RevokeAllTokens operation.
+//
+// 指定した時刻より前に発行された全てのアクセストークンとセッションを失効させるAPI.
+//
+// POST /v1alpha1/admin/revocations
+func (c *Client) RevokeAllTokens(ctx context.Context, request OptRevokeAllTokensRequest) (*Revocation, error) {
+	res, err := c.sendRevokeAllTokens(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendRevokeAllTokens(ctx context.Context, request OptRevokeAllTokensRequest) (res *Revocation, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RevokeAllTokens"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/v1alpha1/admin/revocations"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RevokeAllTokensOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1alpha1/admin/revocations"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRevokeAllTokensRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RevokeAllTokensOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRevokeAllTokensResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RevokeSession invokes RevokeSession operation.
+//
+// セッションを削除し､そのセッションで発行されたアクセストークンを即時に失効させるAPI.
+//
+// DELETE /v1alpha1/admin/sessions/{session_id}
+func (c *Client) RevokeSession(ctx context.Context, params RevokeSessionParams) error {
+	_, err := c.sendRevokeSession(ctx, params)
+	return err
+}
+
+func (c *Client) sendRevokeSession(ctx context.Context, params RevokeSessionParams) (res *RevokeSessionNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RevokeSession"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/v1alpha1/admin/sessions/{session_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RevokeSessionOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1alpha1/admin/sessions/"
+	{
+		// Encode "session_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "session_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.SessionID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RevokeSessionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRevokeSessionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RotateSecretKey invokes RotateSecretKey operation.
+//
+// Secretを暗号化する鍵をローテーションし､既存のSecretを新しい鍵でバックグラウンドで再暗号化するAPI.
+//
+// POST /v1alpha1/admin/secret-keys/rotate
+func (c *Client) RotateSecretKey(ctx context.Context) (*SecretKeyRotation, error) {
+	res, err := c.sendRotateSecretKey(ctx)
+	return res, err
+}
+
+func (c *Client) sendRotateSecretKey(ctx context.Context) (res *SecretKeyRotation, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RotateSecretKey"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/v1alpha1/admin/secret-keys/rotate"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RotateSecretKeyOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1alpha1/admin/secret-keys/rotate"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RotateSecretKeyOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRotateSecretKeyResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpdateApplication invokes UpdateApplication operation.
+//
+// アプリケーションを置き換えるAPI｡resource_versionが最新でない場合は409を返す.
+//
+// PUT /v1alpha1/applications/{name}
+func (c *Client) UpdateApplication(ctx context.Context, request *UpdateApplicationRequest, params UpdateApplicationParams) (*Application, error) {
+	res, err := c.sendUpdateApplication(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendUpdateApplication(ctx context.Context, request *UpdateApplicationRequest, params UpdateApplicationParams) (res *Application, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("UpdateApplication"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/v1alpha1/applications/{name}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateApplicationOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1alpha1/applications/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateApplicationRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UpdateApplicationOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdateApplicationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpdateApplicationSecret invokes UpdateApplicationSecret operation.
+//
+// 特定のアプリケーションのシークレットをitemsで置き換えるAPI｡itemsに含まれないキーは削除する.
+//
+// PUT /v1alpha1/applications/{name}/secret
+func (c *Client) UpdateApplicationSecret(ctx context.Context, request *CreateSecretRequest, params UpdateApplicationSecretParams) (*SecretUpdate, error) {
+	res, err := c.sendUpdateApplicationSecret(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendUpdateApplicationSecret(ctx context.Context, request *CreateSecretRequest, params UpdateApplicationSecretParams) (res *SecretUpdate, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("UpdateApplicationSecret"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/v1alpha1/applications/{name}/secret"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateApplicationSecretOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1alpha1/applications/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/secret"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateApplicationSecretRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UpdateApplicationSecretOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdateApplicationSecretResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// WatchApplications invokes WatchApplications operation.
+//
+// アプリケーションの変更をServer-Sent Eventsで配信するAPI
+// 各イベントのeventは次のいずれかであり､idにはresource_versionを設定する
+// - ADDED､MODIFIED､DELETED:
+// dataはApplication｡MODIFIEDはクライアントが知らないアプリケーションに対しても送られることがあるため､追加または更新として扱う
+// - BOOKMARK: dataは{"resource_version": "...
+// "}｡再接続時にresource_versionに指定すると続きから受け取れる
+// - ERROR:
+// dataはError｡送信後にストリームを終了する｡codeが41000の場合はresource_versionが古すぎるため､一覧を取得し直してから再接続する
+// 接続を維持するために一定間隔でコメント行を送る｡アクセストークンの有効期限が切れた場合や､失効させた場合はERRORを送って終了する.
+//
+// GET /v1alpha1/applications/watch
+func (c *Client) WatchApplications(ctx context.Context, params WatchApplicationsParams) (WatchApplicationsOK, error) {
+	res, err := c.sendWatchApplications(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendWatchApplications(ctx context.Context, params WatchApplicationsParams) (res WatchApplicationsOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("WatchApplications"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/v1alpha1/applications/watch"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, WatchApplicationsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1alpha1/applications/watch"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "resource_version" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "resource_version",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ResourceVersion.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Last-Event-ID",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.LastEventID.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, WatchApplicationsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeWatchApplicationsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6,12 +6,37 @@ package api
 type OperationName = string
 
 const (
-	CreateApplicationOperation       OperationName = "CreateApplication"
-	CreateApplicationSecretOperation OperationName = "CreateApplicationSecret"
-	GetApplicationOperation          OperationName = "GetApplication"
-	GetApplicationSecretOperation    OperationName = "GetApplicationSecret"
-	GetApplicationsOperation         OperationName = "GetApplications"
-	GetHealthLivenessOperation       OperationName = "GetHealthLiveness"
-	GetHealthReadinessOperation      OperationName = "GetHealthReadiness"
-	UpdateApplicationSecretOperation OperationName = "UpdateApplicationSecret"
+	CreateApplicationOperation           OperationName = "CreateApplication"
+	CreateApplicationSecretOperation     OperationName = "CreateApplicationSecret"
+	DeleteApplicationOperation           OperationName = "DeleteApplication"
+	DeleteApplicationSecretOperation     OperationName = "DeleteApplicationSecret"
+	DeleteApplicationSecretKeyOperation  OperationName = "DeleteApplicationSecretKey"
+	ExchangeInstallationTokenOperation   OperationName = "ExchangeInstallationToken"
+	ExchangePersonalAccessTokenOperation OperationName = "ExchangePersonalAccessToken"
+	GetApplicationOperation              OperationName = "GetApplication"
+	GetApplicationReleaseOperation       OperationName = "GetApplicationRelease"
+	GetApplicationSecretOperation        OperationName = "GetApplicationSecret"
+	GetApplicationsOperation             OperationName = "GetApplications"
+	GetAuthGitHubCallbackOperation       OperationName = "GetAuthGitHubCallback"
+	GetAuthGitHubLoginOperation          OperationName = "GetAuthGitHubLogin"
+	GetHealthLivenessOperation           OperationName = "GetHealthLiveness"
+	GetHealthReadinessOperation          OperationName = "GetHealthReadiness"
+	GetJWKSOperation                     OperationName = "GetJWKS"
+	GetPermissionCacheStatsOperation     OperationName = "GetPermissionCacheStats"
+	GetSecretKeyRotationOperation        OperationName = "GetSecretKeyRotation"
+	InvalidateUserPermissionsOperation   OperationName = "InvalidateUserPermissions"
+	ListApplicationReleasesOperation     OperationName = "ListApplicationReleases"
+	ListApplicationsOperation            OperationName = "ListApplications"
+	ListUserSessionsOperation            OperationName = "ListUserSessions"
+	LogoutOperation                      OperationName = "Logout"
+	PatchApplicationOperation            OperationName = "PatchApplication"
+	PatchApplicationSecretOperation      OperationName = "PatchApplicationSecret"
+	RefreshTokenOperation                OperationName = "RefreshToken"
+	RevokeAccessTokenOperation           OperationName = "RevokeAccessToken"
+	RevokeAllTokensOperation             OperationName = "RevokeAllTokens"
+	RevokeSessionOperation               OperationName = "RevokeSession"
+	RotateSecretKeyOperation             OperationName = "RotateSecretKey"
+	UpdateApplicationOperation           OperationName = "UpdateApplication"
+	UpdateApplicationSecretOperation     OperationName = "UpdateApplicationSecret"
+	WatchApplicationsOperation           OperationName = "WatchApplications"
 )
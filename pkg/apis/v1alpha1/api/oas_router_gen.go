@@ -40,7 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
-	args := [1]string{}
+	args := [2]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -61,6 +61,188 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
+			case '.': // Prefix: ".well-known/jwks.json"
+
+				if l := len(".well-known/jwks.json"); len(elem) >= l && elem[0:l] == ".well-known/jwks.json" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleGetJWKSRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
+			case 'a': // Prefix: "auth/"
+
+				if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'g': // Prefix: "github/"
+
+					if l := len("github/"); len(elem) >= l && elem[0:l] == "github/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "callback"
+
+						if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetAuthGitHubCallbackRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					case 'l': // Prefix: "login"
+
+						if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetAuthGitHubLoginRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					}
+
+				case 'l': // Prefix: "logout"
+
+					if l := len("logout"); len(elem) >= l && elem[0:l] == "logout" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleLogoutRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+
+				case 't': // Prefix: "token/"
+
+					if l := len("token/"); len(elem) >= l && elem[0:l] == "token/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'i': // Prefix: "installation"
+
+						if l := len("installation"); len(elem) >= l && elem[0:l] == "installation" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleExchangeInstallationTokenRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					case 'p': // Prefix: "pat"
+
+						if l := len("pat"); len(elem) >= l && elem[0:l] == "pat" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleExchangePersonalAccessTokenRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					case 'r': // Prefix: "refresh"
+
+						if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleRefreshTokenRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					}
+
+				}
+
 			case 'h': // Prefix: "health/"
 
 				if l := len("health/"); len(elem) >= l && elem[0:l] == "health/" {
@@ -115,87 +297,548 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 				}
 
-			case 'v': // Prefix: "v1alpha1/applications"
+			case 'v': // Prefix: "v1alpha"
 
-				if l := len("v1alpha1/applications"); len(elem) >= l && elem[0:l] == "v1alpha1/applications" {
+				if l := len("v1alpha"); len(elem) >= l && elem[0:l] == "v1alpha" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					switch r.Method {
-					case "GET":
-						s.handleGetApplicationsRequest([0]string{}, elemIsEscaped, w, r)
-					case "POST":
-						s.handleCreateApplicationRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET,POST")
-					}
-
-					return
+					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/"
+				case '1': // Prefix: "1/a"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("1/a"); len(elem) >= l && elem[0:l] == "1/a" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "name"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
+					if len(elem) == 0 {
+						break
 					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
+					switch elem[0] {
+					case 'd': // Prefix: "dmin/"
 
-					if len(elem) == 0 {
-						switch r.Method {
-						case "GET":
-							s.handleGetApplicationRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
+						if l := len("dmin/"); len(elem) >= l && elem[0:l] == "dmin/" {
+							elem = elem[l:]
+						} else {
+							break
 						}
 
-						return
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/secret"
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'p': // Prefix: "permissions/stats"
+
+							if l := len("permissions/stats"); len(elem) >= l && elem[0:l] == "permissions/stats" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetPermissionCacheStatsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						case 'r': // Prefix: "revocations"
+
+							if l := len("revocations"); len(elem) >= l && elem[0:l] == "revocations" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleRevokeAllTokensRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 's': // Prefix: "se"
+
+							if l := len("se"); len(elem) >= l && elem[0:l] == "se" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "cret-keys/rotat"
+
+								if l := len("cret-keys/rotat"); len(elem) >= l && elem[0:l] == "cret-keys/rotat" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'e': // Prefix: "e"
+
+									if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRotateSecretKeyRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 'i': // Prefix: "ion"
+
+									if l := len("ion"); len(elem) >= l && elem[0:l] == "ion" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetSecretKeyRotationRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							case 's': // Prefix: "ssions/"
+
+								if l := len("ssions/"); len(elem) >= l && elem[0:l] == "ssions/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "session_id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleRevokeSessionRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE")
+									}
+
+									return
+								}
+
+							}
 
-						if l := len("/secret"); len(elem) >= l && elem[0:l] == "/secret" {
+						case 't': // Prefix: "tokens/"
+
+							if l := len("tokens/"); len(elem) >= l && elem[0:l] == "tokens/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "jti"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleRevokeAccessTokenRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE")
+								}
+
+								return
+							}
+
+						case 'u': // Prefix: "users/"
+
+							if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "user_id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'p': // Prefix: "permissions"
+
+									if l := len("permissions"); len(elem) >= l && elem[0:l] == "permissions" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleInvalidateUserPermissionsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+
+								case 's': // Prefix: "sessions"
+
+									if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleListUserSessionsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					case 'p': // Prefix: "pplications"
+
+						if l := len("pplications"); len(elem) >= l && elem[0:l] == "pplications" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch r.Method {
 							case "GET":
-								s.handleGetApplicationSecretRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
+								s.handleGetApplicationsRequest([0]string{}, elemIsEscaped, w, r)
 							case "POST":
-								s.handleCreateApplicationSecretRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							case "PUT":
-								s.handleUpdateApplicationSecretRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
+								s.handleCreateApplicationRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET,POST,PUT")
+								s.notAllowed(w, r, "GET,POST")
 							}
 
 							return
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'w': // Prefix: "watch"
+								origElem := elem
+								if l := len("watch"); len(elem) >= l && elem[0:l] == "watch" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleWatchApplicationsRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+							// Param: "name"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "DELETE":
+									s.handleDeleteApplicationRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleGetApplicationRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PATCH":
+									s.handlePatchApplicationRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleUpdateApplicationRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET,PATCH,PUT")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'r': // Prefix: "releases"
+
+									if l := len("releases"); len(elem) >= l && elem[0:l] == "releases" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleListApplicationReleasesRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "release"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[1] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetApplicationReleaseRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									}
+
+								case 's': // Prefix: "secret"
+
+									if l := len("secret"); len(elem) >= l && elem[0:l] == "secret" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteApplicationSecretRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetApplicationSecretRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "PATCH":
+											s.handlePatchApplicationSecretRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleCreateApplicationSecretRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handleUpdateApplicationSecretRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,GET,PATCH,POST,PUT")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "key"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[1] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "DELETE":
+												s.handleDeleteApplicationSecretKeyRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							}
 
+						}
+
+					}
+
+				case '2': // Prefix: "2/applications"
+
+					if l := len("2/applications"); len(elem) >= l && elem[0:l] == "2/applications" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleListApplicationsRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
 					}
 
 				}
@@ -215,7 +858,7 @@ type Route struct {
 	operationGroup string
 	pathPattern    string
 	count          int
-	args           [1]string
+	args           [2]string
 }
 
 // Name returns ogen operation name.
@@ -300,6 +943,223 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				break
 			}
 			switch elem[0] {
+			case '.': // Prefix: ".well-known/jwks.json"
+
+				if l := len(".well-known/jwks.json"); len(elem) >= l && elem[0:l] == ".well-known/jwks.json" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = GetJWKSOperation
+						r.summary = "Get JSON Web Key Set"
+						r.operationID = "GetJWKS"
+						r.operationGroup = ""
+						r.pathPattern = "/.well-known/jwks.json"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 'a': // Prefix: "auth/"
+
+				if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'g': // Prefix: "github/"
+
+					if l := len("github/"); len(elem) >= l && elem[0:l] == "github/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "callback"
+
+						if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetAuthGitHubCallbackOperation
+								r.summary = "GitHub OAuth Callback"
+								r.operationID = "GetAuthGitHubCallback"
+								r.operationGroup = ""
+								r.pathPattern = "/auth/github/callback"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'l': // Prefix: "login"
+
+						if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetAuthGitHubLoginOperation
+								r.summary = "Start GitHub OAuth Login"
+								r.operationID = "GetAuthGitHubLogin"
+								r.operationGroup = ""
+								r.pathPattern = "/auth/github/login"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'l': // Prefix: "logout"
+
+					if l := len("logout"); len(elem) >= l && elem[0:l] == "logout" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = LogoutOperation
+							r.summary = "Logout"
+							r.operationID = "Logout"
+							r.operationGroup = ""
+							r.pathPattern = "/auth/logout"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 't': // Prefix: "token/"
+
+					if l := len("token/"); len(elem) >= l && elem[0:l] == "token/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'i': // Prefix: "installation"
+
+						if l := len("installation"); len(elem) >= l && elem[0:l] == "installation" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = ExchangeInstallationTokenOperation
+								r.summary = "Exchange Installation Access Token"
+								r.operationID = "ExchangeInstallationToken"
+								r.operationGroup = ""
+								r.pathPattern = "/auth/token/installation"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'p': // Prefix: "pat"
+
+						if l := len("pat"); len(elem) >= l && elem[0:l] == "pat" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = ExchangePersonalAccessTokenOperation
+								r.summary = "Exchange Personal Access Token"
+								r.operationID = "ExchangePersonalAccessToken"
+								r.operationGroup = ""
+								r.pathPattern = "/auth/token/pat"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'r': // Prefix: "refresh"
+
+						if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = RefreshTokenOperation
+								r.summary = "Refresh Access Token"
+								r.operationID = "RefreshToken"
+								r.operationGroup = ""
+								r.pathPattern = "/auth/token/refresh"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				}
+
 			case 'h': // Prefix: "health/"
 
 				if l := len("health/"); len(elem) >= l && elem[0:l] == "health/" {
@@ -364,117 +1224,652 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				}
 
-			case 'v': // Prefix: "v1alpha1/applications"
+			case 'v': // Prefix: "v1alpha"
 
-				if l := len("v1alpha1/applications"); len(elem) >= l && elem[0:l] == "v1alpha1/applications" {
+				if l := len("v1alpha"); len(elem) >= l && elem[0:l] == "v1alpha" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						r.name = GetApplicationsOperation
-						r.summary = "Get Applications"
-						r.operationID = "GetApplications"
-						r.operationGroup = ""
-						r.pathPattern = "/v1alpha1/applications"
-						r.args = args
-						r.count = 0
-						return r, true
-					case "POST":
-						r.name = CreateApplicationOperation
-						r.summary = "Create Application"
-						r.operationID = "CreateApplication"
-						r.operationGroup = ""
-						r.pathPattern = "/v1alpha1/applications"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
+					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/"
+				case '1': // Prefix: "1/a"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("1/a"); len(elem) >= l && elem[0:l] == "1/a" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "name"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
-
 					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							r.name = GetApplicationOperation
-							r.summary = "Get Application"
-							r.operationID = "GetApplication"
-							r.operationGroup = ""
-							r.pathPattern = "/v1alpha1/applications/{name}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
-						}
+						break
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/secret"
+					case 'd': // Prefix: "dmin/"
 
-						if l := len("/secret"); len(elem) >= l && elem[0:l] == "/secret" {
+						if l := len("dmin/"); len(elem) >= l && elem[0:l] == "dmin/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'p': // Prefix: "permissions/stats"
+
+							if l := len("permissions/stats"); len(elem) >= l && elem[0:l] == "permissions/stats" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetPermissionCacheStatsOperation
+									r.summary = "Get Permission Cache Stats"
+									r.operationID = "GetPermissionCacheStats"
+									r.operationGroup = ""
+									r.pathPattern = "/v1alpha1/admin/permissions/stats"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'r': // Prefix: "revocations"
+
+							if l := len("revocations"); len(elem) >= l && elem[0:l] == "revocations" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = RevokeAllTokensOperation
+									r.summary = "Revoke All Tokens"
+									r.operationID = "RevokeAllTokens"
+									r.operationGroup = ""
+									r.pathPattern = "/v1alpha1/admin/revocations"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 's': // Prefix: "se"
+
+							if l := len("se"); len(elem) >= l && elem[0:l] == "se" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "cret-keys/rotat"
+
+								if l := len("cret-keys/rotat"); len(elem) >= l && elem[0:l] == "cret-keys/rotat" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'e': // Prefix: "e"
+
+									if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = RotateSecretKeyOperation
+											r.summary = "Rotate Secret Key"
+											r.operationID = "RotateSecretKey"
+											r.operationGroup = ""
+											r.pathPattern = "/v1alpha1/admin/secret-keys/rotate"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'i': // Prefix: "ion"
+
+									if l := len("ion"); len(elem) >= l && elem[0:l] == "ion" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetSecretKeyRotationOperation
+											r.summary = "Get Secret Key Rotation"
+											r.operationID = "GetSecretKeyRotation"
+											r.operationGroup = ""
+											r.pathPattern = "/v1alpha1/admin/secret-keys/rotation"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 's': // Prefix: "ssions/"
+
+								if l := len("ssions/"); len(elem) >= l && elem[0:l] == "ssions/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "session_id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = RevokeSessionOperation
+										r.summary = "Revoke Session"
+										r.operationID = "RevokeSession"
+										r.operationGroup = ""
+										r.pathPattern = "/v1alpha1/admin/sessions/{session_id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 't': // Prefix: "tokens/"
+
+							if l := len("tokens/"); len(elem) >= l && elem[0:l] == "tokens/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "jti"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = RevokeAccessTokenOperation
+									r.summary = "Revoke Access Token"
+									r.operationID = "RevokeAccessToken"
+									r.operationGroup = ""
+									r.pathPattern = "/v1alpha1/admin/tokens/{jti}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'u': // Prefix: "users/"
+
+							if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "user_id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'p': // Prefix: "permissions"
+
+									if l := len("permissions"); len(elem) >= l && elem[0:l] == "permissions" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = InvalidateUserPermissionsOperation
+											r.summary = "Invalidate User Permissions"
+											r.operationID = "InvalidateUserPermissions"
+											r.operationGroup = ""
+											r.pathPattern = "/v1alpha1/admin/users/{user_id}/permissions"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 's': // Prefix: "sessions"
+
+									if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = ListUserSessionsOperation
+											r.summary = "List User Sessions"
+											r.operationID = "ListUserSessions"
+											r.operationGroup = ""
+											r.pathPattern = "/v1alpha1/admin/users/{user_id}/sessions"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					case 'p': // Prefix: "pplications"
+
+						if l := len("pplications"); len(elem) >= l && elem[0:l] == "pplications" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch method {
 							case "GET":
-								r.name = GetApplicationSecretOperation
-								r.summary = "Get Application Secret"
-								r.operationID = "GetApplicationSecret"
+								r.name = GetApplicationsOperation
+								r.summary = "Get Applications"
+								r.operationID = "GetApplications"
 								r.operationGroup = ""
-								r.pathPattern = "/v1alpha1/applications/{name}/secret"
+								r.pathPattern = "/v1alpha1/applications"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
 							case "POST":
-								r.name = CreateApplicationSecretOperation
-								r.summary = "Create Application Secret"
-								r.operationID = "CreateApplicationSecret"
+								r.name = CreateApplicationOperation
+								r.summary = "Create Application"
+								r.operationID = "CreateApplication"
 								r.operationGroup = ""
-								r.pathPattern = "/v1alpha1/applications/{name}/secret"
+								r.pathPattern = "/v1alpha1/applications"
 								r.args = args
-								r.count = 1
-								return r, true
-							case "PUT":
-								r.name = UpdateApplicationSecretOperation
-								r.summary = "Update Application Secret"
-								r.operationID = "UpdateApplicationSecret"
-								r.operationGroup = ""
-								r.pathPattern = "/v1alpha1/applications/{name}/secret"
-								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
 							default:
 								return
 							}
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'w': // Prefix: "watch"
+								origElem := elem
+								if l := len("watch"); len(elem) >= l && elem[0:l] == "watch" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = WatchApplicationsOperation
+										r.summary = "Watch Applications"
+										r.operationID = "WatchApplications"
+										r.operationGroup = ""
+										r.pathPattern = "/v1alpha1/applications/watch"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+							// Param: "name"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = DeleteApplicationOperation
+									r.summary = "Delete Application"
+									r.operationID = "DeleteApplication"
+									r.operationGroup = ""
+									r.pathPattern = "/v1alpha1/applications/{name}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = GetApplicationOperation
+									r.summary = "Get Application"
+									r.operationID = "GetApplication"
+									r.operationGroup = ""
+									r.pathPattern = "/v1alpha1/applications/{name}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PATCH":
+									r.name = PatchApplicationOperation
+									r.summary = "Patch Application"
+									r.operationID = "PatchApplication"
+									r.operationGroup = ""
+									r.pathPattern = "/v1alpha1/applications/{name}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = UpdateApplicationOperation
+									r.summary = "Update Application"
+									r.operationID = "UpdateApplication"
+									r.operationGroup = ""
+									r.pathPattern = "/v1alpha1/applications/{name}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'r': // Prefix: "releases"
+
+									if l := len("releases"); len(elem) >= l && elem[0:l] == "releases" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = ListApplicationReleasesOperation
+											r.summary = "List Application Releases"
+											r.operationID = "ListApplicationReleases"
+											r.operationGroup = ""
+											r.pathPattern = "/v1alpha1/applications/{name}/releases"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "release"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[1] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetApplicationReleaseOperation
+												r.summary = "Get Application Release"
+												r.operationID = "GetApplicationRelease"
+												r.operationGroup = ""
+												r.pathPattern = "/v1alpha1/applications/{name}/releases/{release}"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 's': // Prefix: "secret"
+
+									if l := len("secret"); len(elem) >= l && elem[0:l] == "secret" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "DELETE":
+											r.name = DeleteApplicationSecretOperation
+											r.summary = "Delete Application Secret"
+											r.operationID = "DeleteApplicationSecret"
+											r.operationGroup = ""
+											r.pathPattern = "/v1alpha1/applications/{name}/secret"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "GET":
+											r.name = GetApplicationSecretOperation
+											r.summary = "Get Application Secret"
+											r.operationID = "GetApplicationSecret"
+											r.operationGroup = ""
+											r.pathPattern = "/v1alpha1/applications/{name}/secret"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "PATCH":
+											r.name = PatchApplicationSecretOperation
+											r.summary = "Patch Application Secret"
+											r.operationID = "PatchApplicationSecret"
+											r.operationGroup = ""
+											r.pathPattern = "/v1alpha1/applications/{name}/secret"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "POST":
+											r.name = CreateApplicationSecretOperation
+											r.summary = "Create Application Secret"
+											r.operationID = "CreateApplicationSecret"
+											r.operationGroup = ""
+											r.pathPattern = "/v1alpha1/applications/{name}/secret"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "PUT":
+											r.name = UpdateApplicationSecretOperation
+											r.summary = "Update Application Secret"
+											r.operationID = "UpdateApplicationSecret"
+											r.operationGroup = ""
+											r.pathPattern = "/v1alpha1/applications/{name}/secret"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "key"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[1] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "DELETE":
+												r.name = DeleteApplicationSecretKeyOperation
+												r.summary = "Delete Application Secret Key"
+												r.operationID = "DeleteApplicationSecretKey"
+												r.operationGroup = ""
+												r.pathPattern = "/v1alpha1/applications/{name}/secret/{key}"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							}
+
+						}
 
 					}
 
+				case '2': // Prefix: "2/applications"
+
+					if l := len("2/applications"); len(elem) >= l && elem[0:l] == "2/applications" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = ListApplicationsOperation
+							r.summary = "List Applications"
+							r.operationID = "ListApplications"
+							r.operationGroup = ""
+							r.pathPattern = "/v1alpha2/applications"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
 				}
 
 			}
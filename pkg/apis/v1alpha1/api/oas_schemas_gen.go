@@ -4,6 +4,11 @@ package api
 
 import (
 	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/go-faster/errors"
 )
 
 func (s *ErrorStatusCode) Error() string {
@@ -12,13 +17,20 @@ func (s *ErrorStatusCode) Error() string {
 
 // Ref: #/components/schemas/Application
 type Application struct {
-	ID              string    `json:"id"`
-	Name            string    `json:"name"`
-	Description     OptString `json:"description"`
-	DisplayID       string    `json:"display_id"`
-	RepositoryURL   string    `json:"repository_url"`
-	AppconfigPath   string    `json:"appconfig_path"`
-	AppconfigBranch string    `json:"appconfig_branch"`
+	// Kubernetes上のオブジェクトのUID.
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description OptString `json:"description"`
+	// 作成時に割り当てられる表示用のID｡名前を変更しても変わらない.
+	DisplayID       string `json:"display_id"`
+	RepositoryURL   string `json:"repository_url"`
+	AppconfigPath   string `json:"appconfig_path"`
+	AppconfigBranch string `json:"appconfig_branch"`
+	// 楽観的排他制御に使うバージョン｡更新･削除時に指定する.
+	ResourceVersion string `json:"resource_version"`
+	// Specを変更するたびに増える世代番号.
+	Generation int64             `json:"generation"`
+	Status     ApplicationStatus `json:"status"`
 }
 
 // GetID returns the value of ID.
@@ -56,6 +68,21 @@ func (s *Application) GetAppconfigBranch() string {
 	return s.AppconfigBranch
 }
 
+// GetResourceVersion returns the value of ResourceVersion.
+func (s *Application) GetResourceVersion() string {
+	return s.ResourceVersion
+}
+
+// GetGeneration returns the value of Generation.
+func (s *Application) GetGeneration() int64 {
+	return s.Generation
+}
+
+// GetStatus returns the value of Status.
+func (s *Application) GetStatus() ApplicationStatus {
+	return s.Status
+}
+
 // SetID sets the value of ID.
 func (s *Application) SetID(val string) {
 	s.ID = val
@@ -91,13 +118,296 @@ func (s *Application) SetAppconfigBranch(val string) {
 	s.AppconfigBranch = val
 }
 
+// SetResourceVersion sets the value of ResourceVersion.
+func (s *Application) SetResourceVersion(val string) {
+	s.ResourceVersion = val
+}
+
+// SetGeneration sets the value of Generation.
+func (s *Application) SetGeneration(val int64) {
+	s.Generation = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Application) SetStatus(val ApplicationStatus) {
+	s.Status = val
+}
+
+// Ref: #/components/schemas/ApplicationList
+type ApplicationList struct {
+	Items []Application `json:"items"`
+	// 次のページを取得するためのトークン｡最後のページの場合は含まれない｡トークンはソート済みの一覧における次のページの開始位置を表し､一覧のスナップショットは保持しない｡そのため､ページの取得の間にアプリケーションが作成･削除されると､以降のページでアプリケーションが欠けたり重複したりすることがある｡名前順以外のソートにも対応するため､Kubernetesのcontinueによるページングは用いない.
+	NextPageToken OptString `json:"next_page_token"`
+}
+
+// GetItems returns the value of Items.
+func (s *ApplicationList) GetItems() []Application {
+	return s.Items
+}
+
+// GetNextPageToken returns the value of NextPageToken.
+func (s *ApplicationList) GetNextPageToken() OptString {
+	return s.NextPageToken
+}
+
+// SetItems sets the value of Items.
+func (s *ApplicationList) SetItems(val []Application) {
+	s.Items = val
+}
+
+// SetNextPageToken sets the value of NextPageToken.
+func (s *ApplicationList) SetNextPageToken(val OptString) {
+	s.NextPageToken = val
+}
+
+// Portal-controller-kubernetesによる調整の状態.
+// Ref: #/components/schemas/ApplicationStatus
+type ApplicationStatus struct {
+	// Provisioning､Waiting､Running､Errorのいずれか｡コントローラーが一度も調整していない場合は空.
+	State string `json:"state"`
+	// 最新の世代の調整が完了し､Runningになっているか
+	// portal-controller-kubernetes v0.8.
+	// 1はRunningになった後にspecの変更を調整しないため､
+	// PUTやPATCHでspecを変更した後は再作成するまでfalseのままとなる（docs/adr/006-controller-integration.md）
+	// readyになるまで待つ場合は､stateがRunningかつobserved_generationがgenerationより小さくなった時点で待つのをやめる.
+	Ready bool `json:"ready"`
+	// コントローラーが最後に調整した世代番号｡generationより小さい場合は最新の世代が反映されていない.
+	ObservedGeneration int64 `json:"observed_generation"`
+	// ステージごとに現在のReleaseの名前｡appconfigに定義されたステージの順に並ぶ
+	// コミットや状態はGET
+	// /v1alpha1/applications/{name}/releasesで取得する｡Releaseを作成する前は空.
+	CurrentReleases []string `json:"current_releases"`
+	// 調整に失敗している場合のエラーメッセージ.
+	LastError  OptString   `json:"last_error"`
+	Conditions []Condition `json:"conditions"`
+}
+
+// GetState returns the value of State.
+func (s *ApplicationStatus) GetState() string {
+	return s.State
+}
+
+// GetReady returns the value of Ready.
+func (s *ApplicationStatus) GetReady() bool {
+	return s.Ready
+}
+
+// GetObservedGeneration returns the value of ObservedGeneration.
+func (s *ApplicationStatus) GetObservedGeneration() int64 {
+	return s.ObservedGeneration
+}
+
+// GetCurrentReleases returns the value of CurrentReleases.
+func (s *ApplicationStatus) GetCurrentReleases() []string {
+	return s.CurrentReleases
+}
+
+// GetLastError returns the value of LastError.
+func (s *ApplicationStatus) GetLastError() OptString {
+	return s.LastError
+}
+
+// GetConditions returns the value of Conditions.
+func (s *ApplicationStatus) GetConditions() []Condition {
+	return s.Conditions
+}
+
+// SetState sets the value of State.
+func (s *ApplicationStatus) SetState(val string) {
+	s.State = val
+}
+
+// SetReady sets the value of Ready.
+func (s *ApplicationStatus) SetReady(val bool) {
+	s.Ready = val
+}
+
+// SetObservedGeneration sets the value of ObservedGeneration.
+func (s *ApplicationStatus) SetObservedGeneration(val int64) {
+	s.ObservedGeneration = val
+}
+
+// SetCurrentReleases sets the value of CurrentReleases.
+func (s *ApplicationStatus) SetCurrentReleases(val []string) {
+	s.CurrentReleases = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *ApplicationStatus) SetLastError(val OptString) {
+	s.LastError = val
+}
+
+// SetConditions sets the value of Conditions.
+func (s *ApplicationStatus) SetConditions(val []Condition) {
+	s.Conditions = val
+}
+
+// Ref: #/components/schemas/AuthResult
+type AuthResult struct {
+	User        GitHubUser `json:"user"`
+	AccessToken string     `json:"access_token"`
+	TokenType   string     `json:"token_type"`
+	// アクセストークンの有効期間（秒）.
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// GetUser returns the value of User.
+func (s *AuthResult) GetUser() GitHubUser {
+	return s.User
+}
+
+// GetAccessToken returns the value of AccessToken.
+func (s *AuthResult) GetAccessToken() string {
+	return s.AccessToken
+}
+
+// GetTokenType returns the value of TokenType.
+func (s *AuthResult) GetTokenType() string {
+	return s.TokenType
+}
+
+// GetExpiresIn returns the value of ExpiresIn.
+func (s *AuthResult) GetExpiresIn() int64 {
+	return s.ExpiresIn
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *AuthResult) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// SetUser sets the value of User.
+func (s *AuthResult) SetUser(val GitHubUser) {
+	s.User = val
+}
+
+// SetAccessToken sets the value of AccessToken.
+func (s *AuthResult) SetAccessToken(val string) {
+	s.AccessToken = val
+}
+
+// SetTokenType sets the value of TokenType.
+func (s *AuthResult) SetTokenType(val string) {
+	s.TokenType = val
+}
+
+// SetExpiresIn sets the value of ExpiresIn.
+func (s *AuthResult) SetExpiresIn(val int64) {
+	s.ExpiresIn = val
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *AuthResult) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
+type BearerAuth struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
+}
+
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
+}
+
+// Ref: #/components/schemas/Condition
+type Condition struct {
+	Type string `json:"type"`
+	// True､False､Unknownのいずれか.
+	Status             string    `json:"status"`
+	ObservedGeneration int64     `json:"observed_generation"`
+	Reason             string    `json:"reason"`
+	Message            string    `json:"message"`
+	LastTransitionTime time.Time `json:"last_transition_time"`
+}
+
+// GetType returns the value of Type.
+func (s *Condition) GetType() string {
+	return s.Type
+}
+
+// GetStatus returns the value of Status.
+func (s *Condition) GetStatus() string {
+	return s.Status
+}
+
+// GetObservedGeneration returns the value of ObservedGeneration.
+func (s *Condition) GetObservedGeneration() int64 {
+	return s.ObservedGeneration
+}
+
+// GetReason returns the value of Reason.
+func (s *Condition) GetReason() string {
+	return s.Reason
+}
+
+// GetMessage returns the value of Message.
+func (s *Condition) GetMessage() string {
+	return s.Message
+}
+
+// GetLastTransitionTime returns the value of LastTransitionTime.
+func (s *Condition) GetLastTransitionTime() time.Time {
+	return s.LastTransitionTime
+}
+
+// SetType sets the value of Type.
+func (s *Condition) SetType(val string) {
+	s.Type = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Condition) SetStatus(val string) {
+	s.Status = val
+}
+
+// SetObservedGeneration sets the value of ObservedGeneration.
+func (s *Condition) SetObservedGeneration(val int64) {
+	s.ObservedGeneration = val
+}
+
+// SetReason sets the value of Reason.
+func (s *Condition) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetMessage sets the value of Message.
+func (s *Condition) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetLastTransitionTime sets the value of LastTransitionTime.
+func (s *Condition) SetLastTransitionTime(val time.Time) {
+	s.LastTransitionTime = val
+}
+
 // Ref: #/components/schemas/CreateApplicationRequest
 type CreateApplicationRequest struct {
-	Name            string    `json:"name"`
-	Description     OptString `json:"description"`
-	RepositoryURL   string    `json:"repository_url"`
-	AppconfigPath   string    `json:"appconfig_path"`
-	AppconfigBranch string    `json:"appconfig_branch"`
+	// DNS-1123ラベルの形式（英小文字･数字･ハイフン､63文字以内）.
+	Name        string    `json:"name"`
+	Description OptString `json:"description"`
+	// Appconfigが格納されているGitリポジトリのURL｡許可されたホストのhttpsのURLのみ指定できる.
+	RepositoryURL url.URL `json:"repository_url"`
+	// リポジトリのルートからの相対パス｡..を含むことはできない.
+	AppconfigPath   string `json:"appconfig_path"`
+	AppconfigBranch string `json:"appconfig_branch"`
 }
 
 // GetName returns the value of Name.
@@ -111,7 +421,7 @@ func (s *CreateApplicationRequest) GetDescription() OptString {
 }
 
 // GetRepositoryURL returns the value of RepositoryURL.
-func (s *CreateApplicationRequest) GetRepositoryURL() string {
+func (s *CreateApplicationRequest) GetRepositoryURL() url.URL {
 	return s.RepositoryURL
 }
 
@@ -136,7 +446,7 @@ func (s *CreateApplicationRequest) SetDescription(val OptString) {
 }
 
 // SetRepositoryURL sets the value of RepositoryURL.
-func (s *CreateApplicationRequest) SetRepositoryURL(val string) {
+func (s *CreateApplicationRequest) SetRepositoryURL(val url.URL) {
 	s.RepositoryURL = val
 }
 
@@ -150,6 +460,40 @@ func (s *CreateApplicationRequest) SetAppconfigBranch(val string) {
 	s.AppconfigBranch = val
 }
 
+type CreateApplicationWait string
+
+const (
+	CreateApplicationWaitReady CreateApplicationWait = "ready"
+)
+
+// AllValues returns all CreateApplicationWait values.
+func (CreateApplicationWait) AllValues() []CreateApplicationWait {
+	return []CreateApplicationWait{
+		CreateApplicationWaitReady,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CreateApplicationWait) MarshalText() ([]byte, error) {
+	switch s {
+	case CreateApplicationWaitReady:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CreateApplicationWait) UnmarshalText(data []byte) error {
+	switch CreateApplicationWait(data) {
+	case CreateApplicationWaitReady:
+		*s = CreateApplicationWaitReady
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/CreateSecretRequest
 type CreateSecretRequest struct {
 	Items []SecretItem `json:"items"`
@@ -165,10 +509,39 @@ func (s *CreateSecretRequest) SetItems(val []SecretItem) {
 	s.Items = val
 }
 
+// DeleteApplicationNoContent is response for DeleteApplication operation.
+type DeleteApplicationNoContent struct{}
+
+// DeleteApplicationSecretNoContent is response for DeleteApplicationSecret operation.
+type DeleteApplicationSecretNoContent struct{}
+
+// エラーレスポンス｡codeはエラーの種類を表す固定の値であり､上3桁は対応するHTTPステータスコードを表す
+// | code  | status | 意味 |
+// |-------|--------|------|
+// | 0     | 500    | 内部エラー｡詳細はサーバーのログにのみ出力される |
+// | 40000 | 400    | リクエストが不正 |
+// | 40001 | 400    |
+// 入力値の検証に失敗｡detailsにフィールドごとのエラーを含む |
+// | 40100 | 401    | 認証されていない､またはトークンが無効 |
+// | 40300 | 403    | 操作が許可されていない |
+// | 40400 | 404    | リソースが存在しない |
+// | 40900 | 409    | resource_versionが最新でない |
+// | 40901 | 409    | 同名のリソースが既に存在する |
+// | 41000 | 410    | resource_versionが古すぎるため監視を再開できない |
+// | 42200 | 422    |
+// Kubernetesがリソースを受け付けなかった｡detailsにフィールドごとのエラーを含む |
+// | 42900 | 429    | GitHub APIのレート制限を超えた｡時間をおいて再試行する |
+// | 50200 | 502    | GitHub APIへのリクエストが失敗した |
+// | 50300 | 503    | 必要な機能が設定されていない､またはKubernetes
+// APIが利用できない |
+// | 50400 | 504    | Kubernetes APIへのリクエストがタイムアウトした |.
 // Ref: #/components/schemas/Error
 type Error struct {
+	// エラーコード｡一覧はErrorスキーマの説明を参照.
 	Code    int32  `json:"code"`
 	Message string `json:"message"`
+	// 入力値の検証に失敗したフィールドごとのエラー.
+	Details []FieldViolation `json:"details"`
 }
 
 // GetCode returns the value of Code.
@@ -181,6 +554,11 @@ func (s *Error) GetMessage() string {
 	return s.Message
 }
 
+// GetDetails returns the value of Details.
+func (s *Error) GetDetails() []FieldViolation {
+	return s.Details
+}
+
 // SetCode sets the value of Code.
 func (s *Error) SetCode(val int32) {
 	s.Code = val
@@ -191,6 +569,11 @@ func (s *Error) SetMessage(val string) {
 	s.Message = val
 }
 
+// SetDetails sets the value of Details.
+func (s *Error) SetDetails(val []FieldViolation) {
+	s.Details = val
+}
+
 // ErrorStatusCode wraps Error with StatusCode.
 type ErrorStatusCode struct {
 	StatusCode int
@@ -217,115 +600,1376 @@ func (s *ErrorStatusCode) SetResponse(val Error) {
 	s.Response = val
 }
 
-// Ref: #/components/schemas/HealthCheckStatus
-type HealthCheckStatus struct {
-	Status string `json:"status"`
+// Ref: #/components/schemas/ExchangeInstallationTokenRequest
+type ExchangeInstallationTokenRequest struct {
+	// GitHub AppのInstallation Access Token.
+	Token string `json:"token"`
 }
 
-// GetStatus returns the value of Status.
-func (s *HealthCheckStatus) GetStatus() string {
-	return s.Status
+// GetToken returns the value of Token.
+func (s *ExchangeInstallationTokenRequest) GetToken() string {
+	return s.Token
 }
 
-// SetStatus sets the value of Status.
-func (s *HealthCheckStatus) SetStatus(val string) {
-	s.Status = val
+// SetToken sets the value of Token.
+func (s *ExchangeInstallationTokenRequest) SetToken(val string) {
+	s.Token = val
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// Ref: #/components/schemas/ExchangePersonalAccessTokenRequest
+type ExchangePersonalAccessTokenRequest struct {
+	// GitHub Personal Access Token.
+	Token string `json:"token"`
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetToken returns the value of Token.
+func (s *ExchangePersonalAccessTokenRequest) GetToken() string {
+	return s.Token
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// SetToken sets the value of Token.
+func (s *ExchangePersonalAccessTokenRequest) SetToken(val string) {
+	s.Token = val
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// Ref: #/components/schemas/FieldViolation
+type FieldViolation struct {
+	// エラーのあったフィールド名（例: repository_url）.
+	Field   string `json:"field"`
+	Message string `json:"message"`
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// GetField returns the value of Field.
+func (s *FieldViolation) GetField() string {
+	return s.Field
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetMessage returns the value of Message.
+func (s *FieldViolation) GetMessage() string {
+	return s.Message
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetField sets the value of Field.
+func (s *FieldViolation) SetField(val string) {
+	s.Field = val
 }
 
-// Ref: #/components/schemas/Secret
-type Secret struct {
-	ID    string       `json:"id"`
-	Items []SecretItem `json:"items"`
+// SetMessage sets the value of Message.
+func (s *FieldViolation) SetMessage(val string) {
+	s.Message = val
+}
+
+// GetAuthGitHubLoginFound is response for GetAuthGitHubLogin operation.
+type GetAuthGitHubLoginFound struct {
+	Location string
+}
+
+// GetLocation returns the value of Location.
+func (s *GetAuthGitHubLoginFound) GetLocation() string {
+	return s.Location
+}
+
+// SetLocation sets the value of Location.
+func (s *GetAuthGitHubLoginFound) SetLocation(val string) {
+	s.Location = val
+}
+
+// Ref: #/components/schemas/GitHubUser
+type GitHubUser struct {
+	ID    int64     `json:"id"`
+	Login string    `json:"login"`
+	Name  OptString `json:"name"`
 }
 
 // GetID returns the value of ID.
-func (s *Secret) GetID() string {
+func (s *GitHubUser) GetID() int64 {
 	return s.ID
 }
 
-// GetItems returns the value of Items.
-func (s *Secret) GetItems() []SecretItem {
-	return s.Items
+// GetLogin returns the value of Login.
+func (s *GitHubUser) GetLogin() string {
+	return s.Login
+}
+
+// GetName returns the value of Name.
+func (s *GitHubUser) GetName() OptString {
+	return s.Name
 }
 
 // SetID sets the value of ID.
-func (s *Secret) SetID(val string) {
+func (s *GitHubUser) SetID(val int64) {
 	s.ID = val
 }
 
-// SetItems sets the value of Items.
-func (s *Secret) SetItems(val []SecretItem) {
-	s.Items = val
+// SetLogin sets the value of Login.
+func (s *GitHubUser) SetLogin(val string) {
+	s.Login = val
 }
 
-// Ref: #/components/schemas/SecretItem
-type SecretItem struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+// SetName sets the value of Name.
+func (s *GitHubUser) SetName(val OptString) {
+	s.Name = val
 }
 
-// GetKey returns the value of Key.
-func (s *SecretItem) GetKey() string {
-	return s.Key
+// Ref: #/components/schemas/HealthCheckStatus
+type HealthCheckStatus struct {
+	Status string `json:"status"`
 }
 
-// GetValue returns the value of Value.
-func (s *SecretItem) GetValue() string {
-	return s.Value
+// GetStatus returns the value of Status.
+func (s *HealthCheckStatus) GetStatus() string {
+	return s.Status
 }
 
-// SetKey sets the value of Key.
-func (s *SecretItem) SetKey(val string) {
-	s.Key = val
+// SetStatus sets the value of Status.
+func (s *HealthCheckStatus) SetStatus(val string) {
+	s.Status = val
 }
 
-// SetValue sets the value of Value.
-func (s *SecretItem) SetValue(val string) {
-	s.Value = val
+// InvalidateUserPermissionsNoContent is response for InvalidateUserPermissions operation.
+type InvalidateUserPermissionsNoContent struct{}
+
+// Ref: #/components/schemas/JWK
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// GetKty returns the value of Kty.
+func (s *JWK) GetKty() string {
+	return s.Kty
+}
+
+// GetUse returns the value of Use.
+func (s *JWK) GetUse() string {
+	return s.Use
+}
+
+// GetAlg returns the value of Alg.
+func (s *JWK) GetAlg() string {
+	return s.Alg
+}
+
+// GetKid returns the value of Kid.
+func (s *JWK) GetKid() string {
+	return s.Kid
+}
+
+// GetN returns the value of N.
+func (s *JWK) GetN() string {
+	return s.N
+}
+
+// GetE returns the value of E.
+func (s *JWK) GetE() string {
+	return s.E
+}
+
+// SetKty sets the value of Kty.
+func (s *JWK) SetKty(val string) {
+	s.Kty = val
+}
+
+// SetUse sets the value of Use.
+func (s *JWK) SetUse(val string) {
+	s.Use = val
+}
+
+// SetAlg sets the value of Alg.
+func (s *JWK) SetAlg(val string) {
+	s.Alg = val
+}
+
+// SetKid sets the value of Kid.
+func (s *JWK) SetKid(val string) {
+	s.Kid = val
+}
+
+// SetN sets the value of N.
+func (s *JWK) SetN(val string) {
+	s.N = val
+}
+
+// SetE sets the value of E.
+func (s *JWK) SetE(val string) {
+	s.E = val
+}
+
+// Ref: #/components/schemas/JWKS
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// GetKeys returns the value of Keys.
+func (s *JWKS) GetKeys() []JWK {
+	return s.Keys
+}
+
+// SetKeys sets the value of Keys.
+func (s *JWKS) SetKeys(val []JWK) {
+	s.Keys = val
+}
+
+type ListApplicationsSort string
+
+const (
+	ListApplicationsSortName           ListApplicationsSort = "name"
+	ListApplicationsSortMinusName      ListApplicationsSort = "-name"
+	ListApplicationsSortCreatedAt      ListApplicationsSort = "created_at"
+	ListApplicationsSortMinusCreatedAt ListApplicationsSort = "-created_at"
+)
+
+// AllValues returns all ListApplicationsSort values.
+func (ListApplicationsSort) AllValues() []ListApplicationsSort {
+	return []ListApplicationsSort{
+		ListApplicationsSortName,
+		ListApplicationsSortMinusName,
+		ListApplicationsSortCreatedAt,
+		ListApplicationsSortMinusCreatedAt,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListApplicationsSort) MarshalText() ([]byte, error) {
+	switch s {
+	case ListApplicationsSortName:
+		return []byte(s), nil
+	case ListApplicationsSortMinusName:
+		return []byte(s), nil
+	case ListApplicationsSortCreatedAt:
+		return []byte(s), nil
+	case ListApplicationsSortMinusCreatedAt:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListApplicationsSort) UnmarshalText(data []byte) error {
+	switch ListApplicationsSort(data) {
+	case ListApplicationsSortName:
+		*s = ListApplicationsSortName
+		return nil
+	case ListApplicationsSortMinusName:
+		*s = ListApplicationsSortMinusName
+		return nil
+	case ListApplicationsSortCreatedAt:
+		*s = ListApplicationsSortCreatedAt
+		return nil
+	case ListApplicationsSortMinusCreatedAt:
+		*s = ListApplicationsSortMinusCreatedAt
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// LogoutNoContent is response for Logout operation.
+type LogoutNoContent struct{}
+
+// NewOptCreateApplicationWait returns new OptCreateApplicationWait with value set to v.
+func NewOptCreateApplicationWait(v CreateApplicationWait) OptCreateApplicationWait {
+	return OptCreateApplicationWait{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateApplicationWait is optional CreateApplicationWait.
+type OptCreateApplicationWait struct {
+	Value CreateApplicationWait
+	Set   bool
+}
+
+// IsSet returns true if OptCreateApplicationWait was set.
+func (o OptCreateApplicationWait) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateApplicationWait) Reset() {
+	var v CreateApplicationWait
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateApplicationWait) SetTo(v CreateApplicationWait) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateApplicationWait) Get() (v CreateApplicationWait, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateApplicationWait) Or(d CreateApplicationWait) CreateApplicationWait {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListApplicationsSort returns new OptListApplicationsSort with value set to v.
+func NewOptListApplicationsSort(v ListApplicationsSort) OptListApplicationsSort {
+	return OptListApplicationsSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListApplicationsSort is optional ListApplicationsSort.
+type OptListApplicationsSort struct {
+	Value ListApplicationsSort
+	Set   bool
+}
+
+// IsSet returns true if OptListApplicationsSort was set.
+func (o OptListApplicationsSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListApplicationsSort) Reset() {
+	var v ListApplicationsSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListApplicationsSort) SetTo(v ListApplicationsSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListApplicationsSort) Get() (v ListApplicationsSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListApplicationsSort) Or(d ListApplicationsSort) ListApplicationsSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilString) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRevokeAllTokensRequest returns new OptRevokeAllTokensRequest with value set to v.
+func NewOptRevokeAllTokensRequest(v RevokeAllTokensRequest) OptRevokeAllTokensRequest {
+	return OptRevokeAllTokensRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRevokeAllTokensRequest is optional RevokeAllTokensRequest.
+type OptRevokeAllTokensRequest struct {
+	Value RevokeAllTokensRequest
+	Set   bool
+}
+
+// IsSet returns true if OptRevokeAllTokensRequest was set.
+func (o OptRevokeAllTokensRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRevokeAllTokensRequest) Reset() {
+	var v RevokeAllTokensRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRevokeAllTokensRequest) SetTo(v RevokeAllTokensRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRevokeAllTokensRequest) Get() (v RevokeAllTokensRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRevokeAllTokensRequest) Or(d RevokeAllTokensRequest) RevokeAllTokensRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptURI returns new OptURI with value set to v.
+func NewOptURI(v url.URL) OptURI {
+	return OptURI{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptURI is optional url.URL.
+type OptURI struct {
+	Value url.URL
+	Set   bool
+}
+
+// IsSet returns true if OptURI was set.
+func (o OptURI) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptURI) Reset() {
+	var v url.URL
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptURI) SetTo(v url.URL) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptURI) Get() (v url.URL, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptURI) Or(d url.URL) url.URL {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// 指定したフィールドのみを更新する.
+// Ref: #/components/schemas/PatchApplicationRequest
+type PatchApplicationRequest struct {
+	// Nullを指定した場合は説明を削除する.
+	Description OptNilString `json:"description"`
+	// Appconfigが格納されているGitリポジトリのURL｡許可されたホストのhttpsのURLのみ指定できる.
+	RepositoryURL OptURI `json:"repository_url"`
+	// リポジトリのルートからの相対パス｡..を含むことはできない.
+	AppconfigPath   OptString `json:"appconfig_path"`
+	AppconfigBranch OptString `json:"appconfig_branch"`
+	// 取得したアプリケーションのresource_version.
+	ResourceVersion OptString `json:"resource_version"`
+}
+
+// GetDescription returns the value of Description.
+func (s *PatchApplicationRequest) GetDescription() OptNilString {
+	return s.Description
+}
+
+// GetRepositoryURL returns the value of RepositoryURL.
+func (s *PatchApplicationRequest) GetRepositoryURL() OptURI {
+	return s.RepositoryURL
+}
+
+// GetAppconfigPath returns the value of AppconfigPath.
+func (s *PatchApplicationRequest) GetAppconfigPath() OptString {
+	return s.AppconfigPath
+}
+
+// GetAppconfigBranch returns the value of AppconfigBranch.
+func (s *PatchApplicationRequest) GetAppconfigBranch() OptString {
+	return s.AppconfigBranch
+}
+
+// GetResourceVersion returns the value of ResourceVersion.
+func (s *PatchApplicationRequest) GetResourceVersion() OptString {
+	return s.ResourceVersion
+}
+
+// SetDescription sets the value of Description.
+func (s *PatchApplicationRequest) SetDescription(val OptNilString) {
+	s.Description = val
+}
+
+// SetRepositoryURL sets the value of RepositoryURL.
+func (s *PatchApplicationRequest) SetRepositoryURL(val OptURI) {
+	s.RepositoryURL = val
+}
+
+// SetAppconfigPath sets the value of AppconfigPath.
+func (s *PatchApplicationRequest) SetAppconfigPath(val OptString) {
+	s.AppconfigPath = val
+}
+
+// SetAppconfigBranch sets the value of AppconfigBranch.
+func (s *PatchApplicationRequest) SetAppconfigBranch(val OptString) {
+	s.AppconfigBranch = val
+}
+
+// SetResourceVersion sets the value of ResourceVersion.
+func (s *PatchApplicationRequest) SetResourceVersion(val OptString) {
+	s.ResourceVersion = val
+}
+
+// Ref: #/components/schemas/PermissionCacheStats
+type PermissionCacheStats struct {
+	// プロセス起動後のキャッシュヒット数.
+	Hits int64 `json:"hits"`
+	// プロセス起動後のキャッシュミス数.
+	Misses int64 `json:"misses"`
+}
+
+// GetHits returns the value of Hits.
+func (s *PermissionCacheStats) GetHits() int64 {
+	return s.Hits
+}
+
+// GetMisses returns the value of Misses.
+func (s *PermissionCacheStats) GetMisses() int64 {
+	return s.Misses
+}
+
+// SetHits sets the value of Hits.
+func (s *PermissionCacheStats) SetHits(val int64) {
+	s.Hits = val
+}
+
+// SetMisses sets the value of Misses.
+func (s *PermissionCacheStats) SetMisses(val int64) {
+	s.Misses = val
+}
+
+// Ref: #/components/schemas/RefreshTokenRequest
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *RefreshTokenRequest) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *RefreshTokenRequest) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
+// Portal-controller-kubernetesがアプリケーションのリリース設定からステージごとに作成したRelease.
+// Ref: #/components/schemas/Release
+type Release struct {
+	// Kubernetes上のオブジェクトのUID.
+	ID string `json:"id"`
+	// <アプリケーション名>-<ステージ名>の形式のRelease名.
+	Name string `json:"name"`
+	// Appconfigに定義されたステージ名.
+	Stage string `json:"stage"`
+	// デプロイするコミット｡コントローラーがステージのブランチの最新のコミットを設定する前は含まれない.
+	CommitSha       OptString `json:"commit_sha"`
+	RepositoryURL   string    `json:"repository_url"`
+	AppconfigPath   string    `json:"appconfig_path"`
+	AppconfigBranch string    `json:"appconfig_branch"`
+	// Deploying､Deployed､Failedのいずれか｡コントローラーが一度も調整していない場合は空.
+	State      string      `json:"state"`
+	Conditions []Condition `json:"conditions"`
+	CreatedAt  time.Time   `json:"created_at"`
+	// 状態が最後に変化した時刻｡一度も変化していない場合は含まれない.
+	UpdatedAt OptDateTime `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Release) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Release) GetName() string {
+	return s.Name
+}
+
+// GetStage returns the value of Stage.
+func (s *Release) GetStage() string {
+	return s.Stage
+}
+
+// GetCommitSha returns the value of CommitSha.
+func (s *Release) GetCommitSha() OptString {
+	return s.CommitSha
+}
+
+// GetRepositoryURL returns the value of RepositoryURL.
+func (s *Release) GetRepositoryURL() string {
+	return s.RepositoryURL
+}
+
+// GetAppconfigPath returns the value of AppconfigPath.
+func (s *Release) GetAppconfigPath() string {
+	return s.AppconfigPath
+}
+
+// GetAppconfigBranch returns the value of AppconfigBranch.
+func (s *Release) GetAppconfigBranch() string {
+	return s.AppconfigBranch
+}
+
+// GetState returns the value of State.
+func (s *Release) GetState() string {
+	return s.State
+}
+
+// GetConditions returns the value of Conditions.
+func (s *Release) GetConditions() []Condition {
+	return s.Conditions
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Release) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Release) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Release) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Release) SetName(val string) {
+	s.Name = val
+}
+
+// SetStage sets the value of Stage.
+func (s *Release) SetStage(val string) {
+	s.Stage = val
+}
+
+// SetCommitSha sets the value of CommitSha.
+func (s *Release) SetCommitSha(val OptString) {
+	s.CommitSha = val
+}
+
+// SetRepositoryURL sets the value of RepositoryURL.
+func (s *Release) SetRepositoryURL(val string) {
+	s.RepositoryURL = val
+}
+
+// SetAppconfigPath sets the value of AppconfigPath.
+func (s *Release) SetAppconfigPath(val string) {
+	s.AppconfigPath = val
+}
+
+// SetAppconfigBranch sets the value of AppconfigBranch.
+func (s *Release) SetAppconfigBranch(val string) {
+	s.AppconfigBranch = val
+}
+
+// SetState sets the value of State.
+func (s *Release) SetState(val string) {
+	s.State = val
+}
+
+// SetConditions sets the value of Conditions.
+func (s *Release) SetConditions(val []Condition) {
+	s.Conditions = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Release) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Release) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// Ref: #/components/schemas/Revocation
+type Revocation struct {
+	// この時刻より前に発行されたトークンは無効.
+	RevokedBefore time.Time `json:"revoked_before"`
+}
+
+// GetRevokedBefore returns the value of RevokedBefore.
+func (s *Revocation) GetRevokedBefore() time.Time {
+	return s.RevokedBefore
+}
+
+// SetRevokedBefore sets the value of RevokedBefore.
+func (s *Revocation) SetRevokedBefore(val time.Time) {
+	s.RevokedBefore = val
+}
+
+// RevokeAccessTokenNoContent is response for RevokeAccessToken operation.
+type RevokeAccessTokenNoContent struct{}
+
+// Ref: #/components/schemas/RevokeAllTokensRequest
+type RevokeAllTokensRequest struct {
+	// この時刻より前に発行されたトークンを失効させる｡省略した場合は現在時刻.
+	IssuedBefore OptDateTime `json:"issued_before"`
+}
+
+// GetIssuedBefore returns the value of IssuedBefore.
+func (s *RevokeAllTokensRequest) GetIssuedBefore() OptDateTime {
+	return s.IssuedBefore
+}
+
+// SetIssuedBefore sets the value of IssuedBefore.
+func (s *RevokeAllTokensRequest) SetIssuedBefore(val OptDateTime) {
+	s.IssuedBefore = val
+}
+
+// RevokeSessionNoContent is response for RevokeSession operation.
+type RevokeSessionNoContent struct{}
+
+// Ref: #/components/schemas/Secret
+type Secret struct {
+	ID    string       `json:"id"`
+	Items []SecretItem `json:"items"`
+}
+
+// GetID returns the value of ID.
+func (s *Secret) GetID() string {
+	return s.ID
+}
+
+// GetItems returns the value of Items.
+func (s *Secret) GetItems() []SecretItem {
+	return s.Items
+}
+
+// SetID sets the value of ID.
+func (s *Secret) SetID(val string) {
+	s.ID = val
+}
+
+// SetItems sets the value of Items.
+func (s *Secret) SetItems(val []SecretItem) {
+	s.Items = val
+}
+
+// Ref: #/components/schemas/SecretItem
+type SecretItem struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// GetKey returns the value of Key.
+func (s *SecretItem) GetKey() string {
+	return s.Key
+}
+
+// GetValue returns the value of Value.
+func (s *SecretItem) GetValue() string {
+	return s.Value
+}
+
+// SetKey sets the value of Key.
+func (s *SecretItem) SetKey(val string) {
+	s.Key = val
+}
+
+// SetValue sets the value of Value.
+func (s *SecretItem) SetValue(val string) {
+	s.Value = val
+}
+
+// Ref: #/components/schemas/SecretItemChange
+type SecretItemChange struct {
+	Key string `json:"key"`
+	// 更新前の値と比べた変更内容｡removedは更新前にのみ存在したキーを表す.
+	Status SecretItemChangeStatus `json:"status"`
+}
+
+// GetKey returns the value of Key.
+func (s *SecretItemChange) GetKey() string {
+	return s.Key
+}
+
+// GetStatus returns the value of Status.
+func (s *SecretItemChange) GetStatus() SecretItemChangeStatus {
+	return s.Status
+}
+
+// SetKey sets the value of Key.
+func (s *SecretItemChange) SetKey(val string) {
+	s.Key = val
+}
+
+// SetStatus sets the value of Status.
+func (s *SecretItemChange) SetStatus(val SecretItemChangeStatus) {
+	s.Status = val
+}
+
+// 更新前の値と比べた変更内容｡removedは更新前にのみ存在したキーを表す.
+type SecretItemChangeStatus string
+
+const (
+	SecretItemChangeStatusAdded     SecretItemChangeStatus = "added"
+	SecretItemChangeStatusUpdated   SecretItemChangeStatus = "updated"
+	SecretItemChangeStatusUnchanged SecretItemChangeStatus = "unchanged"
+	SecretItemChangeStatusRemoved   SecretItemChangeStatus = "removed"
+)
+
+// AllValues returns all SecretItemChangeStatus values.
+func (SecretItemChangeStatus) AllValues() []SecretItemChangeStatus {
+	return []SecretItemChangeStatus{
+		SecretItemChangeStatusAdded,
+		SecretItemChangeStatusUpdated,
+		SecretItemChangeStatusUnchanged,
+		SecretItemChangeStatusRemoved,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SecretItemChangeStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case SecretItemChangeStatusAdded:
+		return []byte(s), nil
+	case SecretItemChangeStatusUpdated:
+		return []byte(s), nil
+	case SecretItemChangeStatusUnchanged:
+		return []byte(s), nil
+	case SecretItemChangeStatusRemoved:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SecretItemChangeStatus) UnmarshalText(data []byte) error {
+	switch SecretItemChangeStatus(data) {
+	case SecretItemChangeStatusAdded:
+		*s = SecretItemChangeStatusAdded
+		return nil
+	case SecretItemChangeStatusUpdated:
+		*s = SecretItemChangeStatusUpdated
+		return nil
+	case SecretItemChangeStatusUnchanged:
+		*s = SecretItemChangeStatusUnchanged
+		return nil
+	case SecretItemChangeStatusRemoved:
+		*s = SecretItemChangeStatusRemoved
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/SecretKeyRotation
+type SecretKeyRotation struct {
+	// 新しい暗号化に使う最新の鍵のID.
+	KeyID int64 `json:"key_id"`
+	// 全てのSecretの数.
+	Total int64 `json:"total"`
+	// 最新の鍵で再暗号化されていないSecretの数.
+	Remaining int64 `json:"remaining"`
+	// このサーバーで再暗号化を実行中かどうか.
+	Running bool `json:"running"`
+	// 直前の再暗号化が失敗した場合のエラー.
+	LastError OptString `json:"last_error"`
+}
+
+// GetKeyID returns the value of KeyID.
+func (s *SecretKeyRotation) GetKeyID() int64 {
+	return s.KeyID
+}
+
+// GetTotal returns the value of Total.
+func (s *SecretKeyRotation) GetTotal() int64 {
+	return s.Total
+}
+
+// GetRemaining returns the value of Remaining.
+func (s *SecretKeyRotation) GetRemaining() int64 {
+	return s.Remaining
+}
+
+// GetRunning returns the value of Running.
+func (s *SecretKeyRotation) GetRunning() bool {
+	return s.Running
+}
+
+// GetLastError returns the value of LastError.
+func (s *SecretKeyRotation) GetLastError() OptString {
+	return s.LastError
+}
+
+// SetKeyID sets the value of KeyID.
+func (s *SecretKeyRotation) SetKeyID(val int64) {
+	s.KeyID = val
+}
+
+// SetTotal sets the value of Total.
+func (s *SecretKeyRotation) SetTotal(val int64) {
+	s.Total = val
+}
+
+// SetRemaining sets the value of Remaining.
+func (s *SecretKeyRotation) SetRemaining(val int64) {
+	s.Remaining = val
+}
+
+// SetRunning sets the value of Running.
+func (s *SecretKeyRotation) SetRunning(val bool) {
+	s.Running = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *SecretKeyRotation) SetLastError(val OptString) {
+	s.LastError = val
+}
+
+// Ref: #/components/schemas/SecretUpdate
+type SecretUpdate struct {
+	ID string `json:"id"`
+	// 更新前後のいずれかに存在する全てのキー｡キー順に並べ､値は返さない.
+	Items []SecretItemChange `json:"items"`
+}
+
+// GetID returns the value of ID.
+func (s *SecretUpdate) GetID() string {
+	return s.ID
+}
+
+// GetItems returns the value of Items.
+func (s *SecretUpdate) GetItems() []SecretItemChange {
+	return s.Items
+}
+
+// SetID sets the value of ID.
+func (s *SecretUpdate) SetID(val string) {
+	s.ID = val
+}
+
+// SetItems sets the value of Items.
+func (s *SecretUpdate) SetItems(val []SecretItemChange) {
+	s.Items = val
+}
+
+// Ref: #/components/schemas/Session
+type Session struct {
+	// セッションID.
+	SessionID string `json:"session_id"`
+	// GitHubのユーザーID.
+	UserID string `json:"user_id"`
+	// セッション作成時に解決されたロール.
+	Role string `json:"role"`
+	// 認証方式.
+	AuthMethod string `json:"auth_method"`
+	// ログイン日時.
+	CreatedAt time.Time `json:"created_at"`
+	// 最後にリフレッシュされた日時.
+	LastAccessed time.Time `json:"last_accessed"`
+	// リフレッシュトークンの有効期限.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// GetSessionID returns the value of SessionID.
+func (s *Session) GetSessionID() string {
+	return s.SessionID
+}
+
+// GetUserID returns the value of UserID.
+func (s *Session) GetUserID() string {
+	return s.UserID
+}
+
+// GetRole returns the value of Role.
+func (s *Session) GetRole() string {
+	return s.Role
+}
+
+// GetAuthMethod returns the value of AuthMethod.
+func (s *Session) GetAuthMethod() string {
+	return s.AuthMethod
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Session) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetLastAccessed returns the value of LastAccessed.
+func (s *Session) GetLastAccessed() time.Time {
+	return s.LastAccessed
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *Session) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// SetSessionID sets the value of SessionID.
+func (s *Session) SetSessionID(val string) {
+	s.SessionID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *Session) SetUserID(val string) {
+	s.UserID = val
+}
+
+// SetRole sets the value of Role.
+func (s *Session) SetRole(val string) {
+	s.Role = val
+}
+
+// SetAuthMethod sets the value of AuthMethod.
+func (s *Session) SetAuthMethod(val string) {
+	s.AuthMethod = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Session) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetLastAccessed sets the value of LastAccessed.
+func (s *Session) SetLastAccessed(val time.Time) {
+	s.LastAccessed = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *Session) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+// Ref: #/components/schemas/TokenResponse
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	// アクセストークンの有効期間（秒）.
+	ExpiresIn    int64     `json:"expires_in"`
+	RefreshToken OptString `json:"refresh_token"`
+}
+
+// GetAccessToken returns the value of AccessToken.
+func (s *TokenResponse) GetAccessToken() string {
+	return s.AccessToken
+}
+
+// GetTokenType returns the value of TokenType.
+func (s *TokenResponse) GetTokenType() string {
+	return s.TokenType
+}
+
+// GetExpiresIn returns the value of ExpiresIn.
+func (s *TokenResponse) GetExpiresIn() int64 {
+	return s.ExpiresIn
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *TokenResponse) GetRefreshToken() OptString {
+	return s.RefreshToken
+}
+
+// SetAccessToken sets the value of AccessToken.
+func (s *TokenResponse) SetAccessToken(val string) {
+	s.AccessToken = val
+}
+
+// SetTokenType sets the value of TokenType.
+func (s *TokenResponse) SetTokenType(val string) {
+	s.TokenType = val
+}
+
+// SetExpiresIn sets the value of ExpiresIn.
+func (s *TokenResponse) SetExpiresIn(val int64) {
+	s.ExpiresIn = val
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *TokenResponse) SetRefreshToken(val OptString) {
+	s.RefreshToken = val
+}
+
+// Ref: #/components/schemas/UpdateApplicationRequest
+type UpdateApplicationRequest struct {
+	// 省略した場合は説明を削除する.
+	Description OptString `json:"description"`
+	// Appconfigが格納されているGitリポジトリのURL｡許可されたホストのhttpsのURLのみ指定できる.
+	RepositoryURL url.URL `json:"repository_url"`
+	// リポジトリのルートからの相対パス｡..を含むことはできない.
+	AppconfigPath   string `json:"appconfig_path"`
+	AppconfigBranch string `json:"appconfig_branch"`
+	// 取得したアプリケーションのresource_version.
+	ResourceVersion string `json:"resource_version"`
+}
+
+// GetDescription returns the value of Description.
+func (s *UpdateApplicationRequest) GetDescription() OptString {
+	return s.Description
+}
+
+// GetRepositoryURL returns the value of RepositoryURL.
+func (s *UpdateApplicationRequest) GetRepositoryURL() url.URL {
+	return s.RepositoryURL
+}
+
+// GetAppconfigPath returns the value of AppconfigPath.
+func (s *UpdateApplicationRequest) GetAppconfigPath() string {
+	return s.AppconfigPath
+}
+
+// GetAppconfigBranch returns the value of AppconfigBranch.
+func (s *UpdateApplicationRequest) GetAppconfigBranch() string {
+	return s.AppconfigBranch
+}
+
+// GetResourceVersion returns the value of ResourceVersion.
+func (s *UpdateApplicationRequest) GetResourceVersion() string {
+	return s.ResourceVersion
+}
+
+// SetDescription sets the value of Description.
+func (s *UpdateApplicationRequest) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetRepositoryURL sets the value of RepositoryURL.
+func (s *UpdateApplicationRequest) SetRepositoryURL(val url.URL) {
+	s.RepositoryURL = val
+}
+
+// SetAppconfigPath sets the value of AppconfigPath.
+func (s *UpdateApplicationRequest) SetAppconfigPath(val string) {
+	s.AppconfigPath = val
+}
+
+// SetAppconfigBranch sets the value of AppconfigBranch.
+func (s *UpdateApplicationRequest) SetAppconfigBranch(val string) {
+	s.AppconfigBranch = val
+}
+
+// SetResourceVersion sets the value of ResourceVersion.
+func (s *UpdateApplicationRequest) SetResourceVersion(val string) {
+	s.ResourceVersion = val
+}
+
+type WatchApplicationsOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s WatchApplicationsOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
@@ -0,0 +1,98 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+// SecurityHandler is handler for security parameters.
+type SecurityHandler interface {
+	// HandleBearerAuth handles BearerAuth security.
+	// Portal APIが発行したアクセストークン｡GitHubのPersonal Access TokenとInstallation
+	// Access Tokenは `/auth/token/pat`･`/auth/token/installation`
+	// でアクセストークンに交換してから利用する.
+	HandleBearerAuth(ctx context.Context, operationName OperationName, t BearerAuth) (context.Context, error)
+}
+
+func findAuthorization(h http.Header, prefix string) (string, bool) {
+	v, ok := h["Authorization"]
+	if !ok {
+		return "", false
+	}
+	for _, vv := range v {
+		scheme, value, ok := strings.Cut(vv, " ")
+		if !ok || !strings.EqualFold(scheme, prefix) {
+			continue
+		}
+		return value, true
+	}
+	return "", false
+}
+
+var operationRolesBearerAuth = map[string][]string{
+	CreateApplicationOperation:          []string{},
+	CreateApplicationSecretOperation:    []string{},
+	DeleteApplicationOperation:          []string{},
+	DeleteApplicationSecretOperation:    []string{},
+	DeleteApplicationSecretKeyOperation: []string{},
+	GetApplicationOperation:             []string{},
+	GetApplicationReleaseOperation:      []string{},
+	GetApplicationSecretOperation:       []string{},
+	GetApplicationsOperation:            []string{},
+	GetPermissionCacheStatsOperation:    []string{},
+	GetSecretKeyRotationOperation:       []string{},
+	InvalidateUserPermissionsOperation:  []string{},
+	ListApplicationReleasesOperation:    []string{},
+	ListApplicationsOperation:           []string{},
+	ListUserSessionsOperation:           []string{},
+	PatchApplicationOperation:           []string{},
+	PatchApplicationSecretOperation:     []string{},
+	RevokeAccessTokenOperation:          []string{},
+	RevokeAllTokensOperation:            []string{},
+	RevokeSessionOperation:              []string{},
+	RotateSecretKeyOperation:            []string{},
+	UpdateApplicationOperation:          []string{},
+	UpdateApplicationSecretOperation:    []string{},
+	WatchApplicationsOperation:          []string{},
+}
+
+func (s *Server) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t BearerAuth
+	token, ok := findAuthorization(req.Header, "Bearer")
+	if !ok {
+		return ctx, false, nil
+	}
+	t.Token = token
+	t.Roles = operationRolesBearerAuth[operationName]
+	rctx, err := s.sec.HandleBearerAuth(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+// SecuritySource is provider of security values (tokens, passwords, etc.).
+type SecuritySource interface {
+	// BearerAuth provides BearerAuth security value.
+	// Portal APIが発行したアクセストークン｡GitHubのPersonal Access TokenとInstallation
+	// Access Tokenは `/auth/token/pat`･`/auth/token/installation`
+	// でアクセストークンに交換してから利用する.
+	BearerAuth(ctx context.Context, operationName OperationName) (BearerAuth, error)
+}
+
+func (s *Client) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.BearerAuth(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"BearerAuth\"")
+	}
+	req.Header.Set("Authorization", "Bearer "+t.Token)
+	return nil
+}
@@ -13,19 +13,56 @@ type Handler interface {
 	// アプリケーションを作成するAPI.
 	//
 	// POST /v1alpha1/applications
-	CreateApplication(ctx context.Context, req *CreateApplicationRequest) (*Application, error)
+	CreateApplication(ctx context.Context, req *CreateApplicationRequest, params CreateApplicationParams) (*Application, error)
 	// CreateApplicationSecret implements CreateApplicationSecret operation.
 	//
 	// 特定のアプリケーションのシークレットを作成するAPI.
 	//
 	// POST /v1alpha1/applications/{name}/secret
 	CreateApplicationSecret(ctx context.Context, req *CreateSecretRequest, params CreateApplicationSecretParams) (*Secret, error)
+	// DeleteApplication implements DeleteApplication operation.
+	//
+	// アプリケーションとそのシークレットを削除するAPI｡resource_versionが最新でない場合は409を返す｡シークレットを先に削除するため､途中で失敗した場合は同じリクエストを再試行すると残りを削除できる.
+	//
+	// DELETE /v1alpha1/applications/{name}
+	DeleteApplication(ctx context.Context, params DeleteApplicationParams) error
+	// DeleteApplicationSecret implements DeleteApplicationSecret operation.
+	//
+	// 特定のアプリケーションのシークレットを削除し､アプリケーションからの参照を外すAPI.
+	//
+	// DELETE /v1alpha1/applications/{name}/secret
+	DeleteApplicationSecret(ctx context.Context, params DeleteApplicationSecretParams) error
+	// DeleteApplicationSecretKey implements DeleteApplicationSecretKey operation.
+	//
+	// 特定のアプリケーションのシークレットからキーを1つ削除するAPI.
+	//
+	// DELETE /v1alpha1/applications/{name}/secret/{key}
+	DeleteApplicationSecretKey(ctx context.Context, params DeleteApplicationSecretKeyParams) (*Secret, error)
+	// ExchangeInstallationToken implements ExchangeInstallationToken operation.
+	//
+	// GitHub AppのInstallation Access
+	// Tokenを検証し､アクセスできるリポジトリに限定したアクセストークンを発行するAPI.
+	//
+	// POST /auth/token/installation
+	ExchangeInstallationToken(ctx context.Context, req *ExchangeInstallationTokenRequest) (*TokenResponse, error)
+	// ExchangePersonalAccessToken implements ExchangePersonalAccessToken operation.
+	//
+	// GitHub Personal Access Tokenを検証し､アクセストークンを発行するAPI.
+	//
+	// POST /auth/token/pat
+	ExchangePersonalAccessToken(ctx context.Context, req *ExchangePersonalAccessTokenRequest) (*TokenResponse, error)
 	// GetApplication implements GetApplication operation.
 	//
 	// 特定のアプリケーションを取得するAPI.
 	//
 	// GET /v1alpha1/applications/{name}
 	GetApplication(ctx context.Context, params GetApplicationParams) (*Application, error)
+	// GetApplicationRelease implements GetApplicationRelease operation.
+	//
+	// アプリケーションのステージのReleaseを取得するAPI.
+	//
+	// GET /v1alpha1/applications/{name}/releases/{release}
+	GetApplicationRelease(ctx context.Context, params GetApplicationReleaseParams) (*Release, error)
 	// GetApplicationSecret implements GetApplicationSecret operation.
 	//
 	// 特定のアプリケーションのシークレットを取得するAPI.
@@ -34,10 +71,24 @@ type Handler interface {
 	GetApplicationSecret(ctx context.Context, params GetApplicationSecretParams) (*Secret, error)
 	// GetApplications implements GetApplications operation.
 	//
-	// アプリケーション一覧を取得するAPI.
+	// アプリケーション一覧を取得するAPI｡全件を配列で返すため､ページングが必要な場合は/v1alpha2/applicationsを使う.
+	//
+	// Deprecated: schema marks this operation as deprecated.
 	//
 	// GET /v1alpha1/applications
 	GetApplications(ctx context.Context) ([]Application, error)
+	// GetAuthGitHubCallback implements GetAuthGitHubCallback operation.
+	//
+	// GitHub OAuthのコールバックを受け取り､認可コードを交換するAPI.
+	//
+	// GET /auth/github/callback
+	GetAuthGitHubCallback(ctx context.Context, params GetAuthGitHubCallbackParams) (*AuthResult, error)
+	// GetAuthGitHubLogin implements GetAuthGitHubLogin operation.
+	//
+	// GitHub OAuthの認可URLへリダイレクトするAPI.
+	//
+	// GET /auth/github/login
+	GetAuthGitHubLogin(ctx context.Context) (*GetAuthGitHubLoginFound, error)
 	// GetHealthLiveness implements GetHealthLiveness operation.
 	//
 	// Liveness statusを取得するAPI.
@@ -46,16 +97,131 @@ type Handler interface {
 	GetHealthLiveness(ctx context.Context) (*HealthCheckStatus, error)
 	// GetHealthReadiness implements GetHealthReadiness operation.
 	//
-	// Readiness statusを取得するAPI.
+	// Readiness
+	// statusを取得するAPI｡Applicationのキャッシュの同期が完了するまでは503を返す.
 	//
 	// GET /health/readiness
 	GetHealthReadiness(ctx context.Context) (*HealthCheckStatus, error)
+	// GetJWKS implements GetJWKS operation.
+	//
+	// アクセストークンの署名を検証するための公開鍵を取得するAPI.
+	//
+	// GET /.well-known/jwks.json
+	GetJWKS(ctx context.Context) (*JWKS, error)
+	// GetPermissionCacheStats implements GetPermissionCacheStats operation.
+	//
+	// 権限キャッシュのヒット･ミスの回数を取得するAPI.
+	//
+	// GET /v1alpha1/admin/permissions/stats
+	GetPermissionCacheStats(ctx context.Context) (*PermissionCacheStats, error)
+	// GetSecretKeyRotation implements GetSecretKeyRotation operation.
+	//
+	// 鍵のローテーションによる再暗号化の進捗を取得するAPI.
+	//
+	// GET /v1alpha1/admin/secret-keys/rotation
+	GetSecretKeyRotation(ctx context.Context) (*SecretKeyRotation, error)
+	// InvalidateUserPermissions implements InvalidateUserPermissions operation.
+	//
+	// ユーザーの権限キャッシュを無効化し､次回の認証時にGitHubのTeam情報を再取得させるAPI.
+	//
+	// DELETE /v1alpha1/admin/users/{user_id}/permissions
+	InvalidateUserPermissions(ctx context.Context, params InvalidateUserPermissionsParams) error
+	// ListApplicationReleases implements ListApplicationReleases operation.
+	//
+	// アプリケーションのステージごとのReleaseを取得するAPI
+	// コントローラーはステージごとに1つのReleaseを作成して更新し続けるため､過去のReleaseの履歴は含まれない
+	// ステージはappconfigに定義された順に返す
+	// 履歴の番号とロールバックはコントローラーが対応するまで提供しない（docs/adr/006-controller-integration.md）.
+	//
+	// GET /v1alpha1/applications/{name}/releases
+	ListApplicationReleases(ctx context.Context, params ListApplicationReleasesParams) ([]Release, error)
+	// ListApplications implements ListApplications operation.
+	//
+	// アプリケーション一覧をページングして取得するAPI｡レスポンスのnext_page_tokenをpage_tokenに指定すると次のページを取得できる.
+	//
+	// GET /v1alpha2/applications
+	ListApplications(ctx context.Context, params ListApplicationsParams) (*ApplicationList, error)
+	// ListUserSessions implements ListUserSessions operation.
+	//
+	// ユーザーの有効なセッション一覧を取得するAPI.
+	//
+	// GET /v1alpha1/admin/users/{user_id}/sessions
+	ListUserSessions(ctx context.Context, params ListUserSessionsParams) ([]Session, error)
+	// Logout implements Logout operation.
+	//
+	// リフレッシュトークンが属するセッションを削除するAPI.
+	//
+	// POST /auth/logout
+	Logout(ctx context.Context, req *RefreshTokenRequest) error
+	// PatchApplication implements PatchApplication operation.
+	//
+	// JSON Merge Patch (RFC 7396)
+	// でアプリケーションを部分的に更新するAPI｡resource_versionを指定した場合は最新でなければ409を返す.
+	//
+	// PATCH /v1alpha1/applications/{name}
+	PatchApplication(ctx context.Context, req *PatchApplicationRequest, params PatchApplicationParams) (*Application, error)
+	// PatchApplicationSecret implements PatchApplicationSecret operation.
+	//
+	// 特定のアプリケーションのシークレットにitemsをマージするAPI｡itemsに含まれないキーは変更しない.
+	//
+	// PATCH /v1alpha1/applications/{name}/secret
+	PatchApplicationSecret(ctx context.Context, req *CreateSecretRequest, params PatchApplicationSecretParams) (*SecretUpdate, error)
+	// RefreshToken implements RefreshToken operation.
+	//
+	// リフレッシュトークンをローテーションし､新しいアクセストークンを発行するAPI.
+	//
+	// POST /auth/token/refresh
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest) (*TokenResponse, error)
+	// RevokeAccessToken implements RevokeAccessToken operation.
+	//
+	// JTIを指定してアクセストークンを即時に失効させるAPI.
+	//
+	// DELETE /v1alpha1/admin/tokens/{jti}
+	RevokeAccessToken(ctx context.Context, params RevokeAccessTokenParams) error
+	// RevokeAllTokens implements RevokeAllTokens operation.
+	//
+	// 指定した時刻より前に発行された全てのアクセストークンとセッションを失効させるAPI.
+	//
+	// POST /v1alpha1/admin/revocations
+	RevokeAllTokens(ctx context.Context, req OptRevokeAllTokensRequest) (*Revocation, error)
+	// RevokeSession implements RevokeSession operation.
+	//
+	// セッションを削除し､そのセッションで発行されたアクセストークンを即時に失効させるAPI.
+	//
+	// DELETE /v1alpha1/admin/sessions/{session_id}
+	RevokeSession(ctx context.Context, params RevokeSessionParams) error
+	// RotateSecretKey implements RotateSecretKey operation.
+	//
+	// Secretを暗号化する鍵をローテーションし､既存のSecretを新しい鍵でバックグラウンドで再暗号化するAPI.
+	//
+	// POST /v1alpha1/admin/secret-keys/rotate
+	RotateSecretKey(ctx context.Context) (*SecretKeyRotation, error)
+	// UpdateApplication implements UpdateApplication operation.
+	//
+	// アプリケーションを置き換えるAPI｡resource_versionが最新でない場合は409を返す.
+	//
+	// PUT /v1alpha1/applications/{name}
+	UpdateApplication(ctx context.Context, req *UpdateApplicationRequest, params UpdateApplicationParams) (*Application, error)
 	// UpdateApplicationSecret implements UpdateApplicationSecret operation.
 	//
-	// 特定のアプリケーションのシークレットを更新するAPI.
+	// 特定のアプリケーションのシークレットをitemsで置き換えるAPI｡itemsに含まれないキーは削除する.
 	//
 	// PUT /v1alpha1/applications/{name}/secret
-	UpdateApplicationSecret(ctx context.Context, req *CreateSecretRequest, params UpdateApplicationSecretParams) (*Secret, error)
+	UpdateApplicationSecret(ctx context.Context, req *CreateSecretRequest, params UpdateApplicationSecretParams) (*SecretUpdate, error)
+	// WatchApplications implements WatchApplications operation.
+	//
+	// アプリケーションの変更をServer-Sent Eventsで配信するAPI
+	// 各イベントのeventは次のいずれかであり､idにはresource_versionを設定する
+	// - ADDED､MODIFIED､DELETED:
+	// dataはApplication｡MODIFIEDはクライアントが知らないアプリケーションに対しても送られることがあるため､追加または更新として扱う
+	// - BOOKMARK: dataは{"resource_version": "...
+	// "}｡再接続時にresource_versionに指定すると続きから受け取れる
+	// - ERROR:
+	// dataはError｡送信後にストリームを終了する｡codeが41000の場合はresource_versionが古すぎるため､一覧を取得し直してから再接続する
+	// 接続を維持するために一定間隔でコメント行を送る｡アクセストークンの有効期限が切れた場合や､失効させた場合はERRORを送って終了する.
+	//
+	// GET /v1alpha1/applications/watch
+	WatchApplications(ctx context.Context, params WatchApplicationsParams) (WatchApplicationsOK, error)
 	// NewError creates *ErrorStatusCode from error returned by handler.
 	//
 	// Used for common default response.
@@ -65,18 +231,20 @@ type Handler interface {
 // Server implements http server based on OpenAPI v3 specification and
 // calls Handler to handle requests.
 type Server struct {
-	h Handler
+	h   Handler
+	sec SecurityHandler
 	baseServer
 }
 
 // NewServer creates new Server.
-func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+func NewServer(h Handler, sec SecurityHandler, opts ...ServerOption) (*Server, error) {
 	s, err := newServerConfig(opts...).baseServer()
 	if err != nil {
 		return nil, err
 	}
 	return &Server{
 		h:          h,
+		sec:        sec,
 		baseServer: s,
 	}, nil
 }
@@ -18,7 +18,7 @@ var _ Handler = UnimplementedHandler{}
 // アプリケーションを作成するAPI.
 //
 // POST /v1alpha1/applications
-func (UnimplementedHandler) CreateApplication(ctx context.Context, req *CreateApplicationRequest) (r *Application, _ error) {
+func (UnimplementedHandler) CreateApplication(ctx context.Context, req *CreateApplicationRequest, params CreateApplicationParams) (r *Application, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -31,6 +31,52 @@ func (UnimplementedHandler) CreateApplicationSecret(ctx context.Context, req *Cr
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteApplication implements DeleteApplication operation.
+//
+// アプリケーションとそのシークレットを削除するAPI｡resource_versionが最新でない場合は409を返す｡シークレットを先に削除するため､途中で失敗した場合は同じリクエストを再試行すると残りを削除できる.
+//
+// DELETE /v1alpha1/applications/{name}
+func (UnimplementedHandler) DeleteApplication(ctx context.Context, params DeleteApplicationParams) error {
+	return ht.ErrNotImplemented
+}
+
+// DeleteApplicationSecret implements DeleteApplicationSecret operation.
+//
+// 特定のアプリケーションのシークレットを削除し､アプリケーションからの参照を外すAPI.
+//
+// DELETE /v1alpha1/applications/{name}/secret
+func (UnimplementedHandler) DeleteApplicationSecret(ctx context.Context, params DeleteApplicationSecretParams) error {
+	return ht.ErrNotImplemented
+}
+
+// DeleteApplicationSecretKey implements DeleteApplicationSecretKey operation.
+//
+// 特定のアプリケーションのシークレットからキーを1つ削除するAPI.
+//
+// DELETE /v1alpha1/applications/{name}/secret/{key}
+func (UnimplementedHandler) DeleteApplicationSecretKey(ctx context.Context, params DeleteApplicationSecretKeyParams) (r *Secret, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ExchangeInstallationToken implements ExchangeInstallationToken operation.
+//
+// GitHub AppのInstallation Access
+// Tokenを検証し､アクセスできるリポジトリに限定したアクセストークンを発行するAPI.
+//
+// POST /auth/token/installation
+func (UnimplementedHandler) ExchangeInstallationToken(ctx context.Context, req *ExchangeInstallationTokenRequest) (r *TokenResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ExchangePersonalAccessToken implements ExchangePersonalAccessToken operation.
+//
+// GitHub Personal Access Tokenを検証し､アクセストークンを発行するAPI.
+//
+// POST /auth/token/pat
+func (UnimplementedHandler) ExchangePersonalAccessToken(ctx context.Context, req *ExchangePersonalAccessTokenRequest) (r *TokenResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetApplication implements GetApplication operation.
 //
 // 特定のアプリケーションを取得するAPI.
@@ -40,6 +86,15 @@ func (UnimplementedHandler) GetApplication(ctx context.Context, params GetApplic
 	return r, ht.ErrNotImplemented
 }
 
+// GetApplicationRelease implements GetApplicationRelease operation.
+//
+// アプリケーションのステージのReleaseを取得するAPI.
+//
+// GET /v1alpha1/applications/{name}/releases/{release}
+func (UnimplementedHandler) GetApplicationRelease(ctx context.Context, params GetApplicationReleaseParams) (r *Release, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetApplicationSecret implements GetApplicationSecret operation.
 //
 // 特定のアプリケーションのシークレットを取得するAPI.
@@ -51,13 +106,33 @@ func (UnimplementedHandler) GetApplicationSecret(ctx context.Context, params Get
 
 // GetApplications implements GetApplications operation.
 //
-// アプリケーション一覧を取得するAPI.
+// アプリケーション一覧を取得するAPI｡全件を配列で返すため､ページングが必要な場合は/v1alpha2/applicationsを使う.
+//
+// Deprecated: schema marks this operation as deprecated.
 //
 // GET /v1alpha1/applications
 func (UnimplementedHandler) GetApplications(ctx context.Context) (r []Application, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// GetAuthGitHubCallback implements GetAuthGitHubCallback operation.
+//
+// GitHub OAuthのコールバックを受け取り､認可コードを交換するAPI.
+//
+// GET /auth/github/callback
+func (UnimplementedHandler) GetAuthGitHubCallback(ctx context.Context, params GetAuthGitHubCallbackParams) (r *AuthResult, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetAuthGitHubLogin implements GetAuthGitHubLogin operation.
+//
+// GitHub OAuthの認可URLへリダイレクトするAPI.
+//
+// GET /auth/github/login
+func (UnimplementedHandler) GetAuthGitHubLogin(ctx context.Context) (r *GetAuthGitHubLoginFound, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetHealthLiveness implements GetHealthLiveness operation.
 //
 // Liveness statusを取得するAPI.
@@ -69,19 +144,185 @@ func (UnimplementedHandler) GetHealthLiveness(ctx context.Context) (r *HealthChe
 
 // GetHealthReadiness implements GetHealthReadiness operation.
 //
-// Readiness statusを取得するAPI.
+// Readiness
+// statusを取得するAPI｡Applicationのキャッシュの同期が完了するまでは503を返す.
 //
 // GET /health/readiness
 func (UnimplementedHandler) GetHealthReadiness(ctx context.Context) (r *HealthCheckStatus, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// GetJWKS implements GetJWKS operation.
+//
+// アクセストークンの署名を検証するための公開鍵を取得するAPI.
+//
+// GET /.well-known/jwks.json
+func (UnimplementedHandler) GetJWKS(ctx context.Context) (r *JWKS, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPermissionCacheStats implements GetPermissionCacheStats operation.
+//
+// 権限キャッシュのヒット･ミスの回数を取得するAPI.
+//
+// GET /v1alpha1/admin/permissions/stats
+func (UnimplementedHandler) GetPermissionCacheStats(ctx context.Context) (r *PermissionCacheStats, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetSecretKeyRotation implements GetSecretKeyRotation operation.
+//
+// 鍵のローテーションによる再暗号化の進捗を取得するAPI.
+//
+// GET /v1alpha1/admin/secret-keys/rotation
+func (UnimplementedHandler) GetSecretKeyRotation(ctx context.Context) (r *SecretKeyRotation, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// InvalidateUserPermissions implements InvalidateUserPermissions operation.
+//
+// ユーザーの権限キャッシュを無効化し､次回の認証時にGitHubのTeam情報を再取得させるAPI.
+//
+// DELETE /v1alpha1/admin/users/{user_id}/permissions
+func (UnimplementedHandler) InvalidateUserPermissions(ctx context.Context, params InvalidateUserPermissionsParams) error {
+	return ht.ErrNotImplemented
+}
+
+// ListApplicationReleases implements ListApplicationReleases operation.
+//
+// アプリケーションのステージごとのReleaseを取得するAPI
+// コントローラーはステージごとに1つのReleaseを作成して更新し続けるため､過去のReleaseの履歴は含まれない
+// ステージはappconfigに定義された順に返す
+// 履歴の番号とロールバックはコントローラーが対応するまで提供しない（docs/adr/006-controller-integration.md）.
+//
+// GET /v1alpha1/applications/{name}/releases
+func (UnimplementedHandler) ListApplicationReleases(ctx context.Context, params ListApplicationReleasesParams) (r []Release, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListApplications implements ListApplications operation.
+//
+// アプリケーション一覧をページングして取得するAPI｡レスポンスのnext_page_tokenをpage_tokenに指定すると次のページを取得できる.
+//
+// GET /v1alpha2/applications
+func (UnimplementedHandler) ListApplications(ctx context.Context, params ListApplicationsParams) (r *ApplicationList, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListUserSessions implements ListUserSessions operation.
+//
+// ユーザーの有効なセッション一覧を取得するAPI.
+//
+// GET /v1alpha1/admin/users/{user_id}/sessions
+func (UnimplementedHandler) ListUserSessions(ctx context.Context, params ListUserSessionsParams) (r []Session, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// Logout implements Logout operation.
+//
+// リフレッシュトークンが属するセッションを削除するAPI.
+//
+// POST /auth/logout
+func (UnimplementedHandler) Logout(ctx context.Context, req *RefreshTokenRequest) error {
+	return ht.ErrNotImplemented
+}
+
+// PatchApplication implements PatchApplication operation.
+//
+// JSON Merge Patch (RFC 7396)
+// でアプリケーションを部分的に更新するAPI｡resource_versionを指定した場合は最新でなければ409を返す.
+//
+// PATCH /v1alpha1/applications/{name}
+func (UnimplementedHandler) PatchApplication(ctx context.Context, req *PatchApplicationRequest, params PatchApplicationParams) (r *Application, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PatchApplicationSecret implements PatchApplicationSecret operation.
+//
+// 特定のアプリケーションのシークレットにitemsをマージするAPI｡itemsに含まれないキーは変更しない.
+//
+// PATCH /v1alpha1/applications/{name}/secret
+func (UnimplementedHandler) PatchApplicationSecret(ctx context.Context, req *CreateSecretRequest, params PatchApplicationSecretParams) (r *SecretUpdate, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RefreshToken implements RefreshToken operation.
+//
+// リフレッシュトークンをローテーションし､新しいアクセストークンを発行するAPI.
+//
+// POST /auth/token/refresh
+func (UnimplementedHandler) RefreshToken(ctx context.Context, req *RefreshTokenRequest) (r *TokenResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RevokeAccessToken implements RevokeAccessToken operation.
+//
+// JTIを指定してアクセストークンを即時に失効させるAPI.
+//
+// DELETE /v1alpha1/admin/tokens/{jti}
+func (UnimplementedHandler) RevokeAccessToken(ctx context.Context, params RevokeAccessTokenParams) error {
+	return ht.ErrNotImplemented
+}
+
+// RevokeAllTokens implements RevokeAllTokens operation.
+//
+// 指定した時刻より前に発行された全てのアクセストークンとセッションを失効させるAPI.
+//
+// POST /v1alpha1/admin/revocations
+func (UnimplementedHandler) RevokeAllTokens(ctx context.Context, req OptRevokeAllTokensRequest) (r *Revocation, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RevokeSession implements RevokeSession operation.
+//
+// セッションを削除し､そのセッションで発行されたアクセストークンを即時に失効させるAPI.
+//
+// DELETE /v1alpha1/admin/sessions/{session_id}
+func (UnimplementedHandler) RevokeSession(ctx context.Context, params RevokeSessionParams) error {
+	return ht.ErrNotImplemented
+}
+
+// RotateSecretKey implements RotateSecretKey operation.
+//
+// Secretを暗号化する鍵をローテーションし､既存のSecretを新しい鍵でバックグラウンドで再暗号化するAPI.
+//
+// POST /v1alpha1/admin/secret-keys/rotate
+func (UnimplementedHandler) RotateSecretKey(ctx context.Context) (r *SecretKeyRotation, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateApplication implements UpdateApplication operation.
+//
+// アプリケーションを置き換えるAPI｡resource_versionが最新でない場合は409を返す.
+//
+// PUT /v1alpha1/applications/{name}
+func (UnimplementedHandler) UpdateApplication(ctx context.Context, req *UpdateApplicationRequest, params UpdateApplicationParams) (r *Application, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateApplicationSecret implements UpdateApplicationSecret operation.
 //
-// 特定のアプリケーションのシークレットを更新するAPI.
+// 特定のアプリケーションのシークレットをitemsで置き換えるAPI｡itemsに含まれないキーは削除する.
 //
 // PUT /v1alpha1/applications/{name}/secret
-func (UnimplementedHandler) UpdateApplicationSecret(ctx context.Context, req *CreateSecretRequest, params UpdateApplicationSecretParams) (r *Secret, _ error) {
+func (UnimplementedHandler) UpdateApplicationSecret(ctx context.Context, req *CreateSecretRequest, params UpdateApplicationSecretParams) (r *SecretUpdate, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WatchApplications implements WatchApplications operation.
+//
+// アプリケーションの変更をServer-Sent Eventsで配信するAPI
+// 各イベントのeventは次のいずれかであり､idにはresource_versionを設定する
+// - ADDED､MODIFIED､DELETED:
+// dataはApplication｡MODIFIEDはクライアントが知らないアプリケーションに対しても送られることがあるため､追加または更新として扱う
+// - BOOKMARK: dataは{"resource_version": "...
+// "}｡再接続時にresource_versionに指定すると続きから受け取れる
+// - ERROR:
+// dataはError｡送信後にストリームを終了する｡codeが41000の場合はresource_versionが古すぎるため､一覧を取得し直してから再接続する
+// 接続を維持するために一定間隔でコメント行を送る｡アクセストークンの有効期限が切れた場合や､失効させた場合はERRORを送って終了する.
+//
+// GET /v1alpha1/applications/watch
+func (UnimplementedHandler) WatchApplications(ctx context.Context, params WatchApplicationsParams) (r WatchApplicationsOK, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -0,0 +1,170 @@
+package v1alpha1
+
+import (
+	"crypto/rand"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// annotationDescription はApplicationの説明を保存するアノテーション
+	// ApplicationSpecはコントローラーが扱うリリース設定であるため､Portal APIのみが使う情報はアノテーションに保存する
+	annotationDescription = "tacokumo.github.io/description"
+	// annotationDisplayID はApplicationの表示用IDを保存するアノテーション
+	annotationDisplayID = "tacokumo.github.io/display-id"
+
+	displayIDPrefix = "app-"
+	displayIDLength = 8
+)
+
+// displayIDAlphabet は読み間違えやすい文字（0/o､1/l/i）を除いた英数字
+const displayIDAlphabet = "23456789abcdefghjkmnpqrstuvwxyz"
+
+// newDisplayID はApplicationの作成時に割り当てる表示用IDを生成する
+func newDisplayID() string {
+	// 偏りが出ないようにアルファベットの長さの倍数を超える値は捨てる
+	limit := byte(256 - 256%len(displayIDAlphabet))
+	id := make([]byte, 0, displayIDLength)
+	buf := make([]byte, displayIDLength)
+	for len(id) < displayIDLength {
+		_, _ = rand.Read(buf)
+		for _, v := range buf {
+			if v < limit && len(id) < displayIDLength {
+				id = append(id, displayIDAlphabet[int(v)%len(displayIDAlphabet)])
+			}
+		}
+	}
+	return displayIDPrefix + string(id)
+}
+
+// displayID はappの表示用IDを返す
+// kubectlなどPortal APIの外で作成されアノテーションを持たない場合は､UIDから導出する
+func displayID(app *tacokumov1alpha1.Application) string {
+	if id := app.Annotations[annotationDisplayID]; id != "" {
+		return id
+	}
+	uid := strings.ReplaceAll(string(app.UID), "-", "")
+	if len(uid) > displayIDLength {
+		uid = uid[:displayIDLength]
+	}
+	return displayIDPrefix + uid
+}
+
+// ensureDisplayID はappが表示用IDのアノテーションを持たない場合に付与する
+// 一度保存した表示用IDは以降変わらない
+func ensureDisplayID(app *tacokumov1alpha1.Application) {
+	if app.Annotations[annotationDisplayID] != "" {
+		return
+	}
+	id := newDisplayID()
+	if app.UID != "" {
+		id = displayID(app)
+	}
+	setAnnotation(app, annotationDisplayID, id)
+}
+
+// setDescription はappの説明を設定する｡空の場合は削除する
+func setDescription(app *tacokumov1alpha1.Application, description string) {
+	if description == "" {
+		delete(app.Annotations, annotationDescription)
+		return
+	}
+	setAnnotation(app, annotationDescription, description)
+}
+
+func setAnnotation(app *tacokumov1alpha1.Application, key, value string) {
+	if app.Annotations == nil {
+		app.Annotations = make(map[string]string)
+	}
+	app.Annotations[key] = value
+}
+
+// toAPIApplication はApplicationをAPIのレスポンスに変換する
+// 全てのハンドラーはこの関数を通してレスポンスを生成する
+func toAPIApplication(app *tacokumov1alpha1.Application) api.Application {
+	ret := api.Application{
+		ID:              string(app.UID),
+		Name:            app.Name,
+		DisplayID:       displayID(app),
+		RepositoryURL:   app.Spec.ReleaseTemplate.Repo.URL,
+		AppconfigPath:   app.Spec.ReleaseTemplate.AppConfigPath,
+		AppconfigBranch: app.Spec.ReleaseTemplate.AppConfigBranch,
+		ResourceVersion: app.ResourceVersion,
+		Generation:      app.Generation,
+		Status:          toAPIApplicationStatus(app),
+	}
+	if description, ok := app.Annotations[annotationDescription]; ok {
+		ret.Description = api.NewOptString(description)
+	}
+	return ret
+}
+
+// toAPIApplicationStatus はportal-controller-kubernetesが記録したApplicationの状態をAPIのレスポンスに変換する
+func toAPIApplicationStatus(app *tacokumov1alpha1.Application) api.ApplicationStatus {
+	ret := api.ApplicationStatus{
+		State:              app.Status.State,
+		Ready:              isApplicationReady(app),
+		ObservedGeneration: observedGeneration(app),
+		// status.releasesはステージごとのReleaseであり､履歴ではない
+		CurrentReleases: lo.Map(app.Status.Releases, func(ref corev1.ObjectReference, _ int) string {
+			return ref.Name
+		}),
+		Conditions: toAPIConditions(app.Status.Conditions),
+	}
+	ready := meta.FindStatusCondition(app.Status.Conditions, tacokumov1alpha1.ConditionTypeReady)
+	if ready != nil && (app.Status.State == tacokumov1alpha1.ApplicationStateError ||
+		ready.Reason == tacokumov1alpha1.ReasonReconcileError) {
+		ret.LastError = api.NewOptString(ready.Message)
+	}
+	return ret
+}
+
+func toAPIConditions(conditions []metav1.Condition) []api.Condition {
+	return lo.Map(conditions, func(c metav1.Condition, _ int) api.Condition {
+		return api.Condition{
+			Type:               c.Type,
+			Status:             string(c.Status),
+			ObservedGeneration: c.ObservedGeneration,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime.Time,
+		}
+	})
+}
+
+// isApplicationReady はappの最新の世代の調整が完了し､Runningになっているかを返す
+// portal-controller-kubernetesは成功時にReady Conditionを設定せず､Runningを成功として記録する
+func isApplicationReady(app *tacokumov1alpha1.Application) bool {
+	if app.Status.State != tacokumov1alpha1.ApplicationStateRunning {
+		return false
+	}
+	// 更新前の世代に対するRunningは現在の状態を表さない
+	return observedGeneration(app) >= app.Generation
+}
+
+// observedGeneration はコントローラーが調整した世代を返す｡Releaseを作成し終えていない場合は0を返す
+//
+// portal-controller-kubernetesは失敗時のみReady ConditionにObservedGenerationを記録し､成功時は世代を記録しない
+// またRunningになった後はspecの変更を調整しないため､Ready Conditionがない場合は作成時の世代のみを調整済みとみなす
+func observedGeneration(app *tacokumov1alpha1.Application) int64 {
+	if ready := meta.FindStatusCondition(app.Status.Conditions, tacokumov1alpha1.ConditionTypeReady); ready != nil {
+		return ready.ObservedGeneration
+	}
+	switch app.Status.State {
+	case "", tacokumov1alpha1.ApplicationStateProvisioning:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// isApplicationFailed はappの調整が失敗しており､待ってもReadyにならないかを返す
+func isApplicationFailed(app *tacokumov1alpha1.Application) bool {
+	return app.Status.State == tacokumov1alpha1.ApplicationStateError
+}
@@ -0,0 +1,240 @@
+package v1alpha1
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/authz"
+	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultListLimit = 100
+	// sortListChunkSize はキャッシュがない場合に全件を取得する際の1回のListの件数
+	sortListChunkSize = 500
+)
+
+var (
+	errInvalidPageToken = &ErrorWithCode{
+		Code:    http.StatusBadRequest,
+		Message: "invalid page_token",
+	}
+	errInvalidLabelSelector = &ErrorWithCode{
+		Code:    http.StatusBadRequest,
+		Message: "invalid label_selector",
+	}
+)
+
+// pageToken はnext_page_tokenの中身
+// クライアントには不透明な文字列として扱わせ､形式は後から変更できるようにする
+type pageToken struct {
+	// Offset はソート済みの一覧におけるページの開始位置
+	Offset int `json:"o,omitempty"`
+	// Sort はトークンを発行したときのソート順
+	Sort api.ListApplicationsSort `json:"s"`
+	// Filter はトークンを発行したときのフィルタのハッシュ
+	Filter string `json:"f,omitempty"`
+}
+
+func (t pageToken) String() string {
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func parsePageToken(s string, sort api.ListApplicationsSort, filter string) (pageToken, error) {
+	if s == "" {
+		return pageToken{Sort: sort, Filter: filter}, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return pageToken{}, errInvalidPageToken
+	}
+	t := pageToken{}
+	if err := json.Unmarshal(b, &t); err != nil || t.Offset < 0 {
+		return pageToken{}, errInvalidPageToken
+	}
+	// 途中でソート順やフィルタを変えると重複や欠落が起きるため受け付けない
+	if t.Sort != sort || t.Filter != filter {
+		return pageToken{}, errInvalidPageToken
+	}
+	return t, nil
+}
+
+// filterHash はページトークンに記録するフィルタのハッシュを返す
+// トークンはクライアントが読めるため､フィルタの値そのものではなくハッシュを記録する
+func filterHash(params api.ListApplicationsParams) string {
+	filters := lo.Map([]api.OptString{
+		params.RepositoryURL,
+		params.AppconfigBranch,
+		params.NamePrefix,
+		params.LabelSelector,
+	}, func(v api.OptString, _ int) *string {
+		if !v.IsSet() {
+			return nil
+		}
+		return &v.Value
+	})
+	b, _ := json.Marshal(filters)
+	sum := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
+
+// ListApplications はフィルタに一致するApplicationをページングして返す
+// 名前順以外のソート順はKubernetesがサポートせず､キャッシュもcontinueをサポートしないため､
+// ソート順によらず全件をキャッシュから取得してソートした上でoffsetでページングする
+// ページの取得の間に作成･削除されたApplicationによってページの境界がずれ､欠けや重複が起きうることはAPIの説明に示している
+func (s *ApplicationService) ListApplications(
+	ctx context.Context,
+	params api.ListApplicationsParams,
+) (*api.ApplicationList, error) {
+	if err := authorize(ctx, authz.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	sort := params.Sort.Or(api.ListApplicationsSortName)
+	token, err := parsePageToken(params.PageToken.Or(""), sort, filterHash(params))
+	if err != nil {
+		return nil, err
+	}
+	opts := []client.ListOption{client.InNamespace(s.config.PortalName)}
+	if v, ok := params.LabelSelector.Get(); ok {
+		selector, err := labels.Parse(v)
+		if err != nil {
+			return nil, errInvalidLabelSelector
+		}
+		opts = append(opts, client.MatchingLabelsSelector{Selector: selector})
+	}
+	filter := newApplicationFilter(ctx, params)
+	limit := params.Limit.Or(defaultListLimit)
+
+	items, next, err := s.listSorted(ctx, opts, filter, limit, token)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &api.ApplicationList{
+		Items: lo.Map(items, func(item tacokumov1alpha1.Application, _ int) api.Application {
+			return toAPIApplication(&item)
+		}),
+	}
+	if next != nil {
+		ret.NextPageToken = api.NewOptString(next.String())
+	}
+	return ret, nil
+}
+
+// listSorted はフィルタに一致する全てのApplicationを取得してソートし､token.Offsetからlimit件を返す
+func (s *ApplicationService) listSorted(
+	ctx context.Context,
+	opts []client.ListOption,
+	filter func(*tacokumov1alpha1.Application) bool,
+	limit int,
+	token pageToken,
+) ([]tacokumov1alpha1.Application, *pageToken, error) {
+	items, err := s.listFiltered(ctx, opts, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	slices.SortStableFunc(items, applicationComparator(token.Sort))
+	if token.Offset >= len(items) {
+		return []tacokumov1alpha1.Application{}, nil, nil
+	}
+	end := min(token.Offset+limit, len(items))
+	var next *pageToken
+	if end < len(items) {
+		next = &pageToken{Offset: end, Sort: token.Sort, Filter: token.Filter}
+	}
+	return items[token.Offset:end], next, nil
+}
+
+// listFiltered はフィルタに一致する全てのApplicationをcachedReaderから取得する
+// キャッシュはcontinueをサポートせず､limitを指定すると続きを取得できないため､メモリ上の全件を1回で取得する
+// キャッシュがない場合はAPIサーバーへの1回のListが大きくなり過ぎないように分割して取得する
+func (s *ApplicationService) listFiltered(
+	ctx context.Context,
+	opts []client.ListOption,
+	filter func(*tacokumov1alpha1.Application) bool,
+) ([]tacokumov1alpha1.Application, error) {
+	items := []tacokumov1alpha1.Application{}
+	cont := ""
+	for {
+		appList := tacokumov1alpha1.ApplicationList{}
+		pageOpts := opts
+		if s.reader == nil {
+			pageOpts = append(slices.Clone(opts), client.Limit(sortListChunkSize), client.Continue(cont))
+		}
+		if err := s.cachedReader().List(ctx, &appList, pageOpts...); err != nil {
+			return nil, err
+		}
+		for _, item := range appList.Items {
+			if filter(&item) {
+				items = append(items, item)
+			}
+		}
+		cont = appList.Continue
+		if s.reader != nil || cont == "" {
+			return items, nil
+		}
+	}
+}
+
+func applicationComparator(sort api.ListApplicationsSort) func(a, b tacokumov1alpha1.Application) int {
+	byName := func(a, b tacokumov1alpha1.Application) int {
+		return cmp.Compare(a.Name, b.Name)
+	}
+	byCreatedAt := func(a, b tacokumov1alpha1.Application) int {
+		// 作成日時が同じ場合も順序が安定するように名前で比較する
+		return cmp.Or(a.CreationTimestamp.Compare(b.CreationTimestamp.Time), byName(a, b))
+	}
+	switch sort {
+	case api.ListApplicationsSortMinusName:
+		return func(a, b tacokumov1alpha1.Application) int { return byName(b, a) }
+	case api.ListApplicationsSortCreatedAt:
+		return byCreatedAt
+	case api.ListApplicationsSortMinusCreatedAt:
+		return func(a, b tacokumov1alpha1.Application) int { return byCreatedAt(b, a) }
+	default:
+		return byName
+	}
+}
+
+// newApplicationFilter はKubernetesのListで絞り込めない条件のフィルタを生成する
+// カスタムリソースはmetadata.name以外のフィールドセレクタをサポートしないため､取得後に絞り込む
+func newApplicationFilter(ctx context.Context, params api.ListApplicationsParams) func(*tacokumov1alpha1.Application) bool {
+	return func(app *tacokumov1alpha1.Application) bool {
+		if v, ok := params.RepositoryURL.Get(); ok && !sameRepository(app.Spec.ReleaseTemplate.Repo.URL, v) {
+			return false
+		}
+		if v, ok := params.AppconfigBranch.Get(); ok && app.Spec.ReleaseTemplate.AppConfigBranch != v {
+			return false
+		}
+		if v, ok := params.NamePrefix.Get(); ok && !strings.HasPrefix(app.Name, v) {
+			return false
+		}
+		// Installation Access Tokenの場合はアクセスできるリポジトリのApplicationのみを返す
+		return authorizeRepository(ctx, app.Spec.ReleaseTemplate.Repo.URL) == nil
+	}
+}
+
+// sameRepository はaとbが同じリポジトリを指すかを返す
+// https形式とscp形式や､.gitの有無の違いは無視する
+func sameRepository(a, b string) bool {
+	keyA, okA := auth.RepositoryKey(a)
+	keyB, okB := auth.RepositoryKey(b)
+	if okA && okB {
+		return keyA == keyB
+	}
+	return a == b
+}
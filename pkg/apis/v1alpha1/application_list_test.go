@@ -0,0 +1,346 @@
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/authz"
+	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/k8sclient"
+	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// paginatedList はfakeのclientがサポートしないlimit/continueをAPIサーバーと同様に名前順で処理する
+// continueトークンには前のページの最後の名前を使う
+func paginatedList(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	appList, ok := list.(*tacokumov1alpha1.ApplicationList)
+	if !ok || listOpts.Limit == 0 {
+		return c.List(ctx, list, opts...)
+	}
+
+	limit, cont := int(listOpts.Limit), listOpts.Continue
+	listOpts.Limit, listOpts.Continue = 0, ""
+	if err := c.List(ctx, appList, &listOpts); err != nil {
+		return err
+	}
+	slices.SortFunc(appList.Items, func(a, b tacokumov1alpha1.Application) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	items := lo.Filter(appList.Items, func(item tacokumov1alpha1.Application, _ int) bool {
+		return item.Name > cont
+	})
+	appList.Continue = ""
+	if len(items) > limit {
+		items = items[:limit]
+		appList.Continue = items[len(items)-1].Name
+	}
+	appList.Items = items
+	return nil
+}
+
+// newTestListClient はapp-00からapp-(n-1)までのApplicationが存在するclientを生成する
+// 偶数番目はmainブランチとteam=aのラベル､奇数番目はreleaseブランチとteam=bのラベルを持ち､番号の逆順に作成日時が新しい
+func newTestListClient(t *testing.T, n int) client.Client {
+	t.Helper()
+
+	scheme, err := k8sclient.NewScheme()
+	require.NoError(t, err)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithInterceptorFuncs(interceptor.Funcs{List: paginatedList}).
+		Build()
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range n {
+		branch, team := "main", "a"
+		if i%2 == 1 {
+			branch, team = "release", "b"
+		}
+		name := fmt.Sprintf("app-%02d", i)
+		require.NoError(t, c.Create(t.Context(), &tacokumov1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "portal-namespace",
+				Labels:            map[string]string{"team": team},
+				CreationTimestamp: metav1.NewTime(base.Add(-time.Duration(i) * time.Hour)),
+			},
+			Spec: tacokumov1alpha1.ApplicationSpec{
+				ReleaseTemplate: tacokumov1alpha1.ReleaseSpec{
+					AppConfigBranch: branch,
+					Repo: tacokumov1alpha1.RepositoryRef{
+						URL: "https://github.com/tacokumo/" + name + ".git",
+					},
+				},
+			},
+		}))
+	}
+	return c
+}
+
+// listAll はnext_page_tokenを辿って全てのページの名前を取得する
+func listAll(t *testing.T, ctx context.Context, service *ApplicationService, params api.ListApplicationsParams) ([]string, int) {
+	t.Helper()
+
+	names := []string{}
+	pages := 0
+	for {
+		ret, err := service.ListApplications(ctx, params)
+		require.NoError(t, err)
+		pages++
+		for _, item := range ret.Items {
+			names = append(names, item.Name)
+		}
+		token, ok := ret.NextPageToken.Get()
+		if !ok {
+			return names, pages
+		}
+		params.PageToken = api.NewOptString(token)
+	}
+}
+
+func TestApplicationService_ListApplications(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		params        api.ListApplicationsParams
+		expected      []string
+		expectedPages int
+	}{
+		{
+			name:          "名前順に全件をページングして取得できること",
+			params:        api.ListApplicationsParams{Limit: api.NewOptInt(2)},
+			expected:      []string{"app-00", "app-01", "app-02", "app-03", "app-04"},
+			expectedPages: 3,
+		},
+		{
+			name: "フィルタに一致するApplicationのみlimit件ずつ返すこと",
+			params: api.ListApplicationsParams{
+				Limit:           api.NewOptInt(2),
+				AppconfigBranch: api.NewOptString("main"),
+			},
+			expected:      []string{"app-00", "app-02", "app-04"},
+			expectedPages: 2,
+		},
+		{
+			name: "ラベルセレクタで絞り込めること",
+			params: api.ListApplicationsParams{
+				LabelSelector: api.NewOptString("team=b"),
+			},
+			expected:      []string{"app-01", "app-03"},
+			expectedPages: 1,
+		},
+		{
+			name: "リポジトリURLは形式の違いを無視して比較すること",
+			params: api.ListApplicationsParams{
+				RepositoryURL: api.NewOptString("git@github.com:tacokumo/app-03.git"),
+			},
+			expected:      []string{"app-03"},
+			expectedPages: 1,
+		},
+		{
+			name: "名前の前方一致で絞り込めること",
+			params: api.ListApplicationsParams{
+				NamePrefix: api.NewOptString("app-0"),
+				Limit:      api.NewOptInt(10),
+			},
+			expected:      []string{"app-00", "app-01", "app-02", "app-03", "app-04"},
+			expectedPages: 1,
+		},
+		{
+			name: "名前の降順にページングできること",
+			params: api.ListApplicationsParams{
+				Limit: api.NewOptInt(2),
+				Sort:  api.NewOptListApplicationsSort(api.ListApplicationsSortMinusName),
+			},
+			expected:      []string{"app-04", "app-03", "app-02", "app-01", "app-00"},
+			expectedPages: 3,
+		},
+		{
+			name: "作成日時順にページングできること",
+			params: api.ListApplicationsParams{
+				Limit: api.NewOptInt(3),
+				Sort:  api.NewOptListApplicationsSort(api.ListApplicationsSortCreatedAt),
+			},
+			expected:      []string{"app-04", "app-03", "app-02", "app-01", "app-00"},
+			expectedPages: 2,
+		},
+		{
+			name: "フィルタとソートを組み合わせられること",
+			params: api.ListApplicationsParams{
+				Limit:         api.NewOptInt(1),
+				LabelSelector: api.NewOptString("team=a"),
+				Sort:          api.NewOptListApplicationsSort(api.ListApplicationsSortMinusCreatedAt),
+			},
+			expected:      []string{"app-00", "app-02", "app-04"},
+			expectedPages: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := &ApplicationService{
+				config: &config.Config{PortalName: "portal-namespace"},
+				client: newTestListClient(t, 5),
+			}
+			names, pages := listAll(t, withRole(t.Context(), authz.RoleViewer), service, tt.params)
+			assert.Equal(t, tt.expected, names)
+			assert.Equal(t, tt.expectedPages, pages)
+		})
+	}
+}
+
+func TestApplicationService_ListApplications_エラー(t *testing.T) {
+	t.Parallel()
+
+	service := &ApplicationService{
+		config: &config.Config{PortalName: "portal-namespace"},
+		client: newTestListClient(t, 5),
+	}
+	ctx := withRole(t.Context(), authz.RoleViewer)
+
+	ret, err := service.ListApplications(ctx, api.ListApplicationsParams{Limit: api.NewOptInt(2)})
+	require.NoError(t, err)
+	token := ret.NextPageToken.Or("")
+	require.NotEmpty(t, token)
+
+	tests := []struct {
+		name     string
+		params   api.ListApplicationsParams
+		expected error
+	}{
+		{
+			name:     "不正なpage_tokenの場合は400となること",
+			params:   api.ListApplicationsParams{PageToken: api.NewOptString("invalid")},
+			expected: errInvalidPageToken,
+		},
+		{
+			name: "page_tokenと異なるソート順の場合は400となること",
+			params: api.ListApplicationsParams{
+				PageToken: api.NewOptString(token),
+				Sort:      api.NewOptListApplicationsSort(api.ListApplicationsSortCreatedAt),
+			},
+			expected: errInvalidPageToken,
+		},
+		{
+			name: "page_tokenと異なるフィルタの場合は400となること",
+			params: api.ListApplicationsParams{
+				PageToken:  api.NewOptString(token),
+				NamePrefix: api.NewOptString("app-0"),
+			},
+			expected: errInvalidPageToken,
+		},
+		{
+			name:     "不正なラベルセレクタの場合は400となること",
+			params:   api.ListApplicationsParams{LabelSelector: api.NewOptString("team in (")},
+			expected: errInvalidLabelSelector,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := service.ListApplications(ctx, tt.params)
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestApplicationService_ListApplications_リポジトリ単位の認可(t *testing.T) {
+	t.Parallel()
+
+	service := &ApplicationService{
+		config: &config.Config{PortalName: "portal-namespace"},
+		client: newTestListClient(t, 5),
+	}
+	ctx := auth.WithIdentity(t.Context(), &auth.Identity{
+		UserID:       "installation:1",
+		Role:         authz.RoleViewer,
+		AuthMethod:   auth.AuthMethodInstallation,
+		Repositories: []string{"github.com/tacokumo/app-01", "github.com/tacokumo/app-04"},
+	})
+
+	names, _ := listAll(t, ctx, service, api.ListApplicationsParams{Limit: api.NewOptInt(1)})
+	assert.Equal(t, []string{"app-01", "app-04"}, names)
+}
+
+// cacheReader はinformerのキャッシュと同様にcontinueを受け付けないReader
+type cacheReader struct {
+	client.Reader
+}
+
+func (r cacheReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	if listOpts.Continue != "" || listOpts.Limit > 0 {
+		return errors.New("continue list option is not supported by the cache")
+	}
+	return r.Reader.List(ctx, list, opts...)
+}
+
+func TestApplicationService_ListApplications_キャッシュ(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		params   api.ListApplicationsParams
+		expected []string
+	}{
+		{
+			name:     "名前順にキャッシュからページングして取得できること",
+			params:   api.ListApplicationsParams{Limit: api.NewOptInt(2)},
+			expected: []string{"app-00", "app-01", "app-02", "app-03", "app-04"},
+		},
+		{
+			name: "作成日時順にキャッシュからページングして取得できること",
+			params: api.ListApplicationsParams{
+				Limit: api.NewOptInt(2),
+				Sort:  api.NewOptListApplicationsSort(api.ListApplicationsSortCreatedAt),
+			},
+			expected: []string{"app-04", "app-03", "app-02", "app-01", "app-00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme, err := k8sclient.NewScheme()
+			require.NoError(t, err)
+			// APIサーバーに問い合わせた場合は失敗させ､キャッシュから読み取っていることを確認する
+			apiServer := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithInterceptorFuncs(interceptor.Funcs{
+					List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+						return errors.New("unexpected list from the API server")
+					},
+				}).
+				Build()
+			service := &ApplicationService{
+				config: &config.Config{PortalName: "portal-namespace"},
+				client: apiServer,
+				reader: cacheReader{Reader: newTestListClient(t, 5)},
+			}
+			names, _ := listAll(t, withRole(t.Context(), authz.RoleViewer), service, tt.params)
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package v1alpha1
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/authz"
+	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var errReleaseNotFound = &ErrorWithCode{
+	Code:    http.StatusNotFound,
+	Message: "release not found",
+}
+
+// ListApplicationReleases はApplicationのステージごとのReleaseをstatus.releasesの順に返す
+// コントローラーはステージごとのReleaseをCreateOrUpdateで更新し続けるため､過去のReleaseは残らない
+func (s *ApplicationService) ListApplicationReleases(
+	ctx context.Context,
+	params api.ListApplicationReleasesParams,
+) ([]api.Release, error) {
+	if err := authorize(ctx, authz.RoleViewer); err != nil {
+		return nil, err
+	}
+	app, err := s.getForRead(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]api.Release, 0, len(app.Status.Releases))
+	for _, ref := range app.Status.Releases {
+		release, err := s.getRelease(ctx, ref)
+		// プロビジョニングのやり直しでステージが削除された場合は含めない
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, toAPIRelease(app, release))
+	}
+	return ret, nil
+}
+
+// GetApplicationRelease はApplicationのステージのReleaseを返す
+func (s *ApplicationService) GetApplicationRelease(
+	ctx context.Context,
+	params api.GetApplicationReleaseParams,
+) (*api.Release, error) {
+	if err := authorize(ctx, authz.RoleViewer); err != nil {
+		return nil, err
+	}
+	app, err := s.getForRead(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := s.findRelease(ctx, app, params.Release)
+	if err != nil {
+		return nil, err
+	}
+	return lo.ToPtr(toAPIRelease(app, release)), nil
+}
+
+// getForRead は参照するApplicationをキャッシュから取得し､呼び出し元が参照できることを確認する
+func (s *ApplicationService) getForRead(ctx context.Context, name string) (*tacokumov1alpha1.Application, error) {
+	app := tacokumov1alpha1.Application{}
+	if err := s.cachedReader().Get(ctx, types.NamespacedName{
+		Namespace: s.config.PortalName,
+		Name:      name,
+	}, &app); err != nil {
+		return nil, toApplicationError(err)
+	}
+	if err := authorizeRepository(ctx, app.Spec.ReleaseTemplate.Repo.URL); err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
+
+// findRelease はappのstatus.releasesからnameのReleaseを探す
+// 他のApplicationのReleaseを参照できないように､appのstatus.releasesに含まれない場合は404を返す
+func (s *ApplicationService) findRelease(ctx context.Context, app *tacokumov1alpha1.Application, name string) (*tacokumov1alpha1.Release, error) {
+	ref, ok := lo.Find(app.Status.Releases, func(ref corev1.ObjectReference) bool {
+		return ref.Name == name
+	})
+	if !ok {
+		return nil, errReleaseNotFound
+	}
+	release, err := s.getRelease(ctx, ref)
+	if apierrors.IsNotFound(err) {
+		return nil, errReleaseNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return release, nil
+}
+
+// getRelease はrefのReleaseをAPIサーバーから取得する
+// Releaseはキャッシュの対象ではなく､ポータルのnamespace以外のReleaseは参照しない
+func (s *ApplicationService) getRelease(ctx context.Context, ref corev1.ObjectReference) (*tacokumov1alpha1.Release, error) {
+	if ref.Namespace != "" && ref.Namespace != s.config.PortalName {
+		return nil, apierrors.NewNotFound(tacokumov1alpha1.GroupVersion.WithResource("releases").GroupResource(), ref.Name)
+	}
+	release := tacokumov1alpha1.Release{}
+	if err := s.client.Get(ctx, types.NamespacedName{
+		Namespace: s.config.PortalName,
+		Name:      ref.Name,
+	}, &release); err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
+
+// toAPIRelease はappのステージのReleaseをAPIのレスポンスに変換する
+// コントローラーはReleaseを<アプリケーション名>-<ステージ名>の名前で作成するため､名前からステージ名を求める
+func toAPIRelease(app *tacokumov1alpha1.Application, release *tacokumov1alpha1.Release) api.Release {
+	ret := api.Release{
+		ID:              string(release.UID),
+		Name:            release.Name,
+		Stage:           strings.TrimPrefix(release.Name, app.Name+"-"),
+		RepositoryURL:   release.Spec.Repo.URL,
+		AppconfigPath:   release.Spec.AppConfigPath,
+		AppconfigBranch: release.Spec.AppConfigBranch,
+		State:           release.Status.State,
+		Conditions:      toAPIConditions(release.Status.Conditions),
+		CreatedAt:       release.CreationTimestamp.Time,
+	}
+	if release.Spec.Commit != nil {
+		ret.CommitSha = api.NewOptString(*release.Spec.Commit)
+	}
+	updatedAt := time.Time{}
+	for _, c := range release.Status.Conditions {
+		if c.LastTransitionTime.After(updatedAt) {
+			updatedAt = c.LastTransitionTime.Time
+		}
+	}
+	if !updatedAt.IsZero() {
+		ret.UpdatedAt = api.NewOptDateTime(updatedAt)
+	}
+	return ret
+}
@@ -0,0 +1,144 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/authz"
+	"github.com/tacokumo/portal-api/pkg/k8sclient"
+	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// newTestReleaseClient はexample-appと､そのstatus.releasesが参照するステージごとのReleaseが存在するclientを生成する
+// example-app-stagingはコミットaaa､example-app-productionはコミットbbbのRelease
+// example-app-previewはstatus.releasesから参照されているが削除済みであり､other-app-productionは他のApplicationのRelease
+func newTestReleaseClient(t *testing.T) client.Client {
+	t.Helper()
+
+	scheme, err := k8sclient.NewScheme()
+	require.NoError(t, err)
+	transitioned := metav1.NewTime(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))
+	newRelease := func(name, commit string) *tacokumov1alpha1.Release {
+		return &tacokumov1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "portal-namespace"},
+			Spec: tacokumov1alpha1.ReleaseSpec{
+				Repo:            tacokumov1alpha1.RepositoryRef{URL: "https://github.com/tacokumo/example-app.git"},
+				AppConfigPath:   "apps/example-app",
+				AppConfigBranch: "main",
+				Commit:          lo.ToPtr(commit),
+			},
+			Status: tacokumov1alpha1.ReleaseStatus{
+				State: tacokumov1alpha1.ReleaseStateDeployed,
+				Conditions: []metav1.Condition{
+					{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Deployed", LastTransitionTime: transitioned},
+				},
+			},
+		}
+	}
+	ref := func(name string) corev1.ObjectReference {
+		return corev1.ObjectReference{Kind: "Release", Namespace: "portal-namespace", Name: name}
+	}
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&tacokumov1alpha1.Application{
+				ObjectMeta: metav1.ObjectMeta{Name: "example-app", Namespace: "portal-namespace"},
+				Spec: tacokumov1alpha1.ApplicationSpec{
+					ReleaseTemplate: tacokumov1alpha1.ReleaseSpec{
+						Repo:            tacokumov1alpha1.RepositoryRef{URL: "https://github.com/tacokumo/example-app.git"},
+						AppConfigPath:   "apps/example-app",
+						AppConfigBranch: "main",
+						EnvSecretName:   lo.ToPtr("example-app-secret"),
+					},
+				},
+				Status: tacokumov1alpha1.ApplicationStatus{
+					Releases: []corev1.ObjectReference{ref("example-app-staging"), ref("example-app-preview"), ref("example-app-production")},
+				},
+			},
+			newRelease("example-app-staging", "aaa"),
+			newRelease("example-app-production", "bbb"),
+			newRelease("other-app-production", "ccc"),
+		).
+		Build()
+}
+
+func TestApplicationService_ListApplicationReleases(t *testing.T) {
+	t.Parallel()
+
+	service := &ApplicationService{config: newTestConfig(), client: newTestReleaseClient(t)}
+
+	ret, err := service.ListApplicationReleases(withRole(t.Context(), authz.RoleViewer), api.ListApplicationReleasesParams{Name: "example-app"})
+	require.NoError(t, err)
+
+	// status.releasesの順に返し､削除済みのReleaseは含めないこと
+	require.Len(t, ret, 2)
+	assert.Equal(t, "example-app-staging", ret[0].Name)
+	assert.Equal(t, "staging", ret[0].Stage)
+	assert.Equal(t, api.NewOptString("aaa"), ret[0].CommitSha)
+	assert.Equal(t, "example-app-production", ret[1].Name)
+	assert.Equal(t, "production", ret[1].Stage)
+	assert.Equal(t, api.NewOptString("bbb"), ret[1].CommitSha)
+	assert.Equal(t, "main", ret[1].AppconfigBranch)
+	assert.Equal(t, tacokumov1alpha1.ReleaseStateDeployed, ret[1].State)
+	assert.Equal(t, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), ret[1].UpdatedAt.Value.UTC())
+}
+
+func TestApplicationService_GetApplicationRelease(t *testing.T) {
+	t.Parallel()
+
+	service := &ApplicationService{config: newTestConfig(), client: newTestReleaseClient(t)}
+
+	tests := []struct {
+		name     string
+		release  string
+		expected error
+	}{
+		{name: "ステージのReleaseを取得できること", release: "example-app-staging"},
+		{name: "削除済みのReleaseは404となること", release: "example-app-preview", expected: errReleaseNotFound},
+		{name: "他のApplicationのReleaseは404となること", release: "other-app-production", expected: errReleaseNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ret, err := service.GetApplicationRelease(withRole(t.Context(), authz.RoleViewer), api.GetApplicationReleaseParams{
+				Name:    "example-app",
+				Release: tt.release,
+			})
+			if tt.expected != nil {
+				assert.ErrorIs(t, err, tt.expected)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.release, ret.Name)
+			assert.Equal(t, "staging", ret.Stage)
+			assert.Equal(t, api.NewOptString("aaa"), ret.CommitSha)
+		})
+	}
+}
+
+func TestApplicationService_ListApplicationReleases_リポジトリ単位の認可(t *testing.T) {
+	t.Parallel()
+
+	service := &ApplicationService{config: newTestConfig(), client: newTestReleaseClient(t)}
+	ctx := auth.WithIdentity(t.Context(), &auth.Identity{
+		UserID:       "installation:1",
+		Role:         authz.RoleViewer,
+		AuthMethod:   auth.AuthMethodInstallation,
+		Repositories: []string{"github.com/tacokumo/other-app"},
+	})
+
+	_, err := service.ListApplicationReleases(ctx, api.ListApplicationReleasesParams{Name: "example-app"})
+	assert.ErrorIs(t, err, errForbidden)
+}
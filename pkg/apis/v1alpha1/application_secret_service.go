@@ -2,122 +2,438 @@ package v1alpha1
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
 
 	"github.com/samber/lo"
 	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/audit"
+	"github.com/tacokumo/portal-api/pkg/authz"
 	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/secret"
 	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+var (
+	errSecretStoreNotConfigured = &ErrorWithCode{
+		Code:    http.StatusServiceUnavailable,
+		Message: "secret store is not configured",
+	}
+	errSecretNotFound = &ErrorWithCode{
+		Code:    http.StatusNotFound,
+		Message: "secret not found",
+	}
+	errSecretKeyNotFound = &ErrorWithCode{
+		Code:    http.StatusNotFound,
+		Message: "secret key not found",
+	}
+	errSecretAlreadyExists = &ErrorWithCode{
+		Code:      http.StatusConflict,
+		ErrorCode: ErrorCodeAlreadyExists,
+		Message:   "secret already exists",
+	}
+	errSecretConflict = &ErrorWithCode{
+		Code:    http.StatusConflict,
+		Message: "secret was modified concurrently, please retry",
+	}
+)
+
+// ApplicationSecretService はApplicationが参照するSecretを管理する
+//
+// ADR003に従い､Secretの源泉はPostgreSQLに暗号化して保存し､
+// Kubernetes Secretは保存した値から同期して作成する
+// secretsがnilの場合はPostgreSQLが設定されていないため､SecretのAPIは全て503を返す
 type ApplicationSecretService struct {
-	config *config.Config
-	client client.Client
+	config  *config.Config
+	client  client.Client
+	secrets *secret.Vault
+	audits  *audit.Logger
 }
 
 func NewApplicationSecretService(
 	cfg *config.Config,
 	client client.Client,
+	secrets *secret.Vault,
+	audits *audit.Logger,
 ) *ApplicationSecretService {
 	return &ApplicationSecretService{
-		config: cfg,
-		client: client,
+		config:  cfg,
+		client:  client,
+		secrets: secrets,
+		audits:  audits,
 	}
 }
 
-func (s *ApplicationSecretService) CreateApplicationSecret(ctx context.Context, req *api.CreateSecretRequest, params api.CreateApplicationSecretParams) (*api.Secret, error) {
-	// TODO: Secretを暗号化/復号する鍵を生成して保存する
+func (s *ApplicationSecretService) CreateApplicationSecret(ctx context.Context, req *api.CreateSecretRequest, params api.CreateApplicationSecretParams) (_ *api.Secret, err error) {
+	defer func() { s.audits.Record(ctx, audit.ActionCreateSecret, s.auditTarget(params.Name), err) }()
+
+	if err := authorize(ctx, authz.RoleWriter); err != nil {
+		return nil, err
+	}
 
 	app := tacokumov1alpha1.Application{}
-	err := s.client.Get(ctx, client.ObjectKey{
+	err = s.client.Get(ctx, client.ObjectKey{
 		Namespace: s.config.PortalName,
 		Name:      params.Name,
 	}, &app)
 	if err != nil {
 		return nil, err
 	}
+	if err := authorizeRepository(ctx, app.Spec.ReleaseTemplate.Repo.URL); err != nil {
+		return nil, err
+	}
+	if s.secrets == nil {
+		return nil, errSecretStoreNotConfigured
+	}
+
+	name := secretName(params.Name)
+	if _, err := s.load(ctx, name); err == nil {
+		return nil, errSecretAlreadyExists
+	} else if !errors.Is(err, errSecretNotFound) {
+		return nil, err
+	}
 
 	secretData := lo.Reduce(req.Items, func(acc map[string]string, item api.SecretItem, _ int) map[string]string {
 		acc[item.Key] = item.Value
 		return acc
 	}, map[string]string{})
-	secret := corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: s.config.PortalName,
-			Name:      fmt.Sprintf("%s-secret", params.Name),
-		},
-		StringData: secretData,
+	created, err := s.secrets.Create(ctx, name, secretData)
+	if err != nil {
+		if errors.Is(err, secret.ErrSecretAlreadyExists) {
+			return nil, errSecretAlreadyExists
+		}
+		return nil, err
 	}
-
-	if err := s.client.Create(ctx, &secret); err != nil {
+	if err := s.sync(ctx, name); err != nil {
 		return nil, err
 	}
 
-	app.Spec.ReleaseTemplate.EnvSecretName = &secret.Name
+	app.Spec.ReleaseTemplate.EnvSecretName = &name
 	if err := s.client.Update(ctx, &app); err != nil {
 		return nil, err
 	}
-	return &api.Secret{
-		Items: lo.Map(req.Items, func(item api.SecretItem, _ int) api.SecretItem {
-			return api.SecretItem{
-				Key:   item.Key,
-				Value: "REDACTED",
-			}
-		}),
-	}, nil
+	return toAPISecret(created), nil
 }
 
 func (s *ApplicationSecretService) GetApplicationSecret(ctx context.Context, params api.GetApplicationSecretParams) (*api.Secret, error) {
-	key := types.NamespacedName{
-		Namespace: s.config.PortalName,
-		Name:      fmt.Sprintf("%s-secret", params.Name),
+	if err := authorize(ctx, authz.RoleViewer); err != nil {
+		return nil, err
 	}
-	secret := corev1.Secret{}
-	if err := s.client.Get(ctx, key, &secret); err != nil {
+	if err := s.authorizeApplication(ctx, params.Name); err != nil {
 		return nil, err
 	}
+	if s.secrets == nil {
+		return nil, errSecretStoreNotConfigured
+	}
 
-	return &api.Secret{
-		Items: lo.MapToSlice(secret.Data, func(key string, value []byte) api.SecretItem {
-			return api.SecretItem{
-				Key:   key,
-				Value: "REDACTED",
-			}
-		}),
+	stored, err := s.load(ctx, secretName(params.Name))
+	if err != nil {
+		return nil, err
+	}
+	return toAPISecret(stored), nil
+}
+
+// UpdateApplicationSecret はSecretをreq.Itemsで置き換え､req.Itemsに含まれないキーを削除する
+func (s *ApplicationSecretService) UpdateApplicationSecret(ctx context.Context, req *api.CreateSecretRequest, params api.UpdateApplicationSecretParams) (_ *api.SecretUpdate, err error) {
+	defer func() { s.audits.Record(ctx, audit.ActionUpdateSecret, s.auditTarget(params.Name), err) }()
+
+	return s.update(ctx, params.Name, req.Items, secretUpdateReplace)
+}
+
+// PatchApplicationSecret はreq.ItemsのキーのみSecretに追加または更新し､それ以外のキーは変更しない
+func (s *ApplicationSecretService) PatchApplicationSecret(ctx context.Context, req *api.CreateSecretRequest, params api.PatchApplicationSecretParams) (_ *api.SecretUpdate, err error) {
+	defer func() { s.audits.Record(ctx, audit.ActionUpdateSecret, s.auditTarget(params.Name), err) }()
+
+	return s.update(ctx, params.Name, req.Items, secretUpdateMerge)
+}
+
+func (s *ApplicationSecretService) update(ctx context.Context, appName string, items []api.SecretItem, mode secretUpdateMode) (*api.SecretUpdate, error) {
+	if err := authorize(ctx, authz.RoleWriter); err != nil {
+		return nil, err
+	}
+	if err := s.authorizeApplication(ctx, appName); err != nil {
+		return nil, err
+	}
+	if s.secrets == nil {
+		return nil, errSecretStoreNotConfigured
+	}
+
+	var changes []api.SecretItemChange
+	updated, err := s.modify(ctx, secretName(appName), func(data map[string]string) (map[string]string, error) {
+		var next map[string]string
+		next, changes = applySecretItems(data, items, mode)
+		return next, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.SecretUpdate{
+		ID:    secretID(updated),
+		Items: changes,
 	}, nil
 }
 
-func (s *ApplicationSecretService) UpdateApplicationSecret(ctx context.Context, req *api.CreateSecretRequest, params api.UpdateApplicationSecretParams) (*api.Secret, error) {
-	key := types.NamespacedName{
+// DeleteApplicationSecret はSecretを削除し､Applicationの参照を外す
+// 参照を外してから削除するため､削除に失敗した場合も再度呼び出せば削除できる
+func (s *ApplicationSecretService) DeleteApplicationSecret(ctx context.Context, params api.DeleteApplicationSecretParams) (err error) {
+	defer func() { s.audits.Record(ctx, audit.ActionDeleteSecret, s.auditTarget(params.Name), err) }()
+
+	if err := authorize(ctx, authz.RoleWriter); err != nil {
+		return err
+	}
+
+	app := tacokumov1alpha1.Application{}
+	err = s.client.Get(ctx, client.ObjectKey{
 		Namespace: s.config.PortalName,
-		Name:      fmt.Sprintf("%s-secret", params.Name),
+		Name:      params.Name,
+	}, &app)
+	if err != nil {
+		return err
+	}
+	if err := authorizeRepository(ctx, app.Spec.ReleaseTemplate.Repo.URL); err != nil {
+		return err
 	}
-	secret := corev1.Secret{}
-	if err := s.client.Get(ctx, key, &secret); err != nil {
+	if s.secrets == nil {
+		return errSecretStoreNotConfigured
+	}
+
+	name := secretName(params.Name)
+	if _, err := s.load(ctx, name); err != nil {
+		return err
+	}
+
+	if ref := app.Spec.ReleaseTemplate.EnvSecretName; ref != nil && *ref == name {
+		app.Spec.ReleaseTemplate.EnvSecretName = nil
+		if err := s.client.Update(ctx, &app); err != nil {
+			return err
+		}
+	}
+	// PostgreSQLに取り込む前のKubernetes SecretはPostgreSQLには存在しない
+	if err := s.secrets.Delete(ctx, name); err != nil && !errors.Is(err, secret.ErrSecretNotFound) {
+		return err
+	}
+	return s.syncer().Delete(ctx, name)
+}
+
+// DeleteApplicationSecretKey はSecretからkeyを削除し､削除後のSecretを返す
+func (s *ApplicationSecretService) DeleteApplicationSecretKey(ctx context.Context, params api.DeleteApplicationSecretKeyParams) (_ *api.Secret, err error) {
+	defer func() {
+		target := s.auditTarget(params.Name)
+		target.ID = params.Key
+		s.audits.Record(ctx, audit.ActionDeleteSecretKey, target, err)
+	}()
+
+	if err := authorize(ctx, authz.RoleWriter); err != nil {
+		return nil, err
+	}
+	if err := s.authorizeApplication(ctx, params.Name); err != nil {
+		return nil, err
+	}
+	if s.secrets == nil {
+		return nil, errSecretStoreNotConfigured
+	}
+
+	updated, err := s.modify(ctx, secretName(params.Name), func(data map[string]string) (map[string]string, error) {
+		if _, ok := data[params.Key]; !ok {
+			return nil, errSecretKeyNotFound
+		}
+		next := maps.Clone(data)
+		delete(next, params.Key)
+		return next, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	return toAPISecret(updated), nil
+}
+
+// modify はnameのSecretをapplyで変更してPostgreSQLに保存し､Kubernetes Secretに反映する
+//
+// 読み出してから保存するまでに他の更新があった場合は読み出しからやり直すため､applyは複数回呼び出されることがある
+// 再試行しても競合する場合はerrSecretConflictを返す
+func (s *ApplicationSecretService) modify(ctx context.Context, name string, apply func(data map[string]string) (map[string]string, error)) (*secret.Secret, error) {
+	var updated *secret.Secret
+	err := retry.OnError(retry.DefaultRetry, isSecretConflict, func() error {
+		stored, err := s.load(ctx, name)
+		if err != nil {
+			return err
+		}
+		data, err := apply(stored.Data)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case stored.ID == 0:
+			// PostgreSQLに取り込む前のKubernetes Secretは変更がない場合も取り込む
+			// 他の更新が先に取り込んだ場合はErrSecretAlreadyExistsとなり､PostgreSQLから読み出し直す
+			updated, err = s.secrets.Create(ctx, name, data)
+		case maps.Equal(stored.Data, data):
+			updated = stored
+		default:
+			updated, err = s.secrets.Replace(ctx, stored, data)
+		}
+		return err
+	})
+	if isSecretConflict(err) {
+		return nil, errSecretConflict
+	}
+	if err != nil {
+		return nil, err
+	}
+	// 変更がない場合も､手動で変更されたKubernetes Secretを元に戻すために反映する
+	if err := s.sync(ctx, name); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// isSecretConflict はSecretを読み出してから保存するまでに他の更新があったかどうかを返す
+func isSecretConflict(err error) bool {
+	return errors.Is(err, secret.ErrSecretConflict) || errors.Is(err, secret.ErrSecretAlreadyExists)
+}
+
+// secretUpdateMode はリクエストのitemsを既存のSecretに反映する方法
+type secretUpdateMode int
+
+const (
+	// secretUpdateReplace はSecretをitemsで置き換え､itemsに含まれないキーを削除する
+	secretUpdateReplace secretUpdateMode = iota
+	// secretUpdateMerge はitemsのキーのみ追加または更新し､それ以外のキーは変更しない
+	secretUpdateMerge
+)
+
+// applySecretItems はcurrentにitemsをmodeで反映した値と､currentと比べたキーごとの変更内容を返す
+// 変更内容は更新前後のいずれかに存在する全てのキーをキー順に返す｡itemsに同じキーが複数ある場合は後のものを使う
+func applySecretItems(current map[string]string, items []api.SecretItem, mode secretUpdateMode) (map[string]string, []api.SecretItemChange) {
+	next := make(map[string]string, len(current)+len(items))
+	if mode == secretUpdateMerge {
+		maps.Copy(next, current)
+	}
+	for _, item := range items {
+		next[item.Key] = item.Value
+	}
+
+	keys := lo.Union(lo.Keys(current), lo.Keys(next))
+	slices.Sort(keys)
+	changes := lo.Map(keys, func(key string, _ int) api.SecretItemChange {
+		before, existed := current[key]
+		after, exists := next[key]
+		status := api.SecretItemChangeStatusUnchanged
+		switch {
+		case !exists:
+			status = api.SecretItemChangeStatusRemoved
+		case !existed:
+			status = api.SecretItemChangeStatusAdded
+		case before != after:
+			status = api.SecretItemChangeStatusUpdated
+		}
+		return api.SecretItemChange{
+			Key:    key,
+			Status: status,
+		}
+	})
+	return next, changes
+}
 
-	if secret.StringData == nil {
-		secret.StringData = make(map[string]string)
+// load はnameのSecretをPostgreSQLから読み出す
+// PostgreSQLに保存する前に作成されたKubernetes Secretは､次の更新でPostgreSQLに取り込むためにその値を返す
+func (s *ApplicationSecretService) load(ctx context.Context, name string) (*secret.Secret, error) {
+	stored, err := s.secrets.Get(ctx, name)
+	if err == nil {
+		return stored, nil
 	}
-	for _, item := range req.Items {
-		secret.StringData[item.Key] = item.Value
+	if !errors.Is(err, secret.ErrSecretNotFound) {
+		return nil, err
 	}
 
-	if err := s.client.Update(ctx, &secret); err != nil {
+	legacy := corev1.Secret{}
+	if err := s.client.Get(ctx, types.NamespacedName{
+		Namespace: s.config.PortalName,
+		Name:      name,
+	}, &legacy); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, errSecretNotFound
+		}
 		return nil, err
 	}
+	data := make(map[string]string, len(legacy.Data)+len(legacy.StringData))
+	for k, v := range legacy.Data {
+		data[k] = string(v)
+	}
+	for k, v := range legacy.StringData {
+		data[k] = v
+	}
+	return &secret.Secret{Name: name, Data: data}, nil
+}
+
+// sync はPostgreSQLに保存したnameのSecretをKubernetes Secretに反映する
+func (s *ApplicationSecretService) sync(ctx context.Context, name string) error {
+	return s.syncer().Sync(ctx, name)
+}
 
+func (s *ApplicationSecretService) syncer() *secret.Syncer {
+	return secret.NewSyncer(s.secrets, s.client, s.config.PortalName)
+}
+
+// auditTarget はnameのApplicationのSecretを監査ログの対象にする
+func (s *ApplicationSecretService) auditTarget(name string) audit.Target {
+	return audit.Target{
+		Namespace:   s.config.PortalName,
+		Application: name,
+		Secret:      secretName(name),
+	}
+}
+
+// authorizeApplication は呼び出し元がnameのApplicationのリポジトリを操作できることを確認する
+// リポジトリで限定されていない呼び出し元の場合はApplicationを取得しない
+func (s *ApplicationSecretService) authorizeApplication(ctx context.Context, name string) error {
+	if !authz.IsRepositoryScoped(ctx) {
+		return nil
+	}
+	app := tacokumov1alpha1.Application{}
+	if err := s.client.Get(ctx, client.ObjectKey{
+		Namespace: s.config.PortalName,
+		Name:      name,
+	}, &app); err != nil {
+		return err
+	}
+	return authorizeRepository(ctx, app.Spec.ReleaseTemplate.Repo.URL)
+}
+
+// secretName はnameのApplicationが参照するSecretの名前
+func secretName(name string) string {
+	return fmt.Sprintf("%s-secret", name)
+}
+
+// secretID はPostgreSQLのsecrets.idを返す｡取り込み前のKubernetes Secretの場合は空文字列を返す
+func secretID(s *secret.Secret) string {
+	if s.ID == 0 {
+		return ""
+	}
+	return strconv.FormatInt(s.ID, 10)
+}
+
+// toAPISecret はSecretのキーをキー順に返し､値は返さない
+func toAPISecret(s *secret.Secret) *api.Secret {
+	keys := lo.Keys(s.Data)
+	slices.Sort(keys)
 	return &api.Secret{
-		Items: lo.Map(req.Items, func(item api.SecretItem, _ int) api.SecretItem {
+		ID: secretID(s),
+		Items: lo.Map(keys, func(key string, _ int) api.SecretItem {
 			return api.SecretItem{
-				Key:   item.Key,
+				Key:   key,
 				Value: "REDACTED",
 			}
 		}),
-	}, nil
+	}
 }
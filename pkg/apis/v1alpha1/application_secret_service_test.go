@@ -1,19 +1,37 @@
 package v1alpha1
 
 import (
+	"bytes"
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/audit"
+	"github.com/tacokumo/portal-api/pkg/authz"
 	"github.com/tacokumo/portal-api/pkg/config"
 	"github.com/tacokumo/portal-api/pkg/k8sclient"
+	"github.com/tacokumo/portal-api/pkg/secret"
 	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
+// newTestVault はプロセス内のStoreに保存するテスト用のVaultを生成する
+func newTestVault(t *testing.T) *secret.Vault {
+	t.Helper()
+
+	store := secret.NewMemoryStore()
+	keys, err := secret.NewLocalKeyManager(store, bytes.Repeat([]byte{0x42}, 32))
+	require.NoError(t, err)
+	return secret.NewVault(store, keys)
+}
+
 func TestApplicationSecretService_CreateApplicationSecret(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -135,10 +153,11 @@ func TestApplicationSecretService_CreateApplicationSecret(t *testing.T) {
 			t.Parallel()
 
 			service := &ApplicationSecretService{
-				config: tt.config,
-				client: tt.clientFn(),
+				config:  tt.config,
+				client:  tt.clientFn(),
+				secrets: newTestVault(t),
 			}
-			ret, err := service.CreateApplicationSecret(t.Context(), tt.req, tt.params)
+			ret, err := service.CreateApplicationSecret(withRole(t.Context(), authz.RoleWriter), tt.req, tt.params)
 			if tt.isError {
 				assert.Error(t, err)
 				return
@@ -211,10 +230,11 @@ func TestApplicationSecretService_GetApplicationSecret(t *testing.T) {
 			t.Parallel()
 
 			service := &ApplicationSecretService{
-				config: tt.config,
-				client: tt.clientFn(),
+				config:  tt.config,
+				client:  tt.clientFn(),
+				secrets: newTestVault(t),
 			}
-			ret, err := service.GetApplicationSecret(t.Context(), tt.params)
+			ret, err := service.GetApplicationSecret(withRole(t.Context(), authz.RoleViewer), tt.params)
 			if tt.isError {
 				assert.Error(t, err)
 				return
@@ -232,64 +252,68 @@ func TestApplicationSecretService_GetApplicationSecret(t *testing.T) {
 
 func TestApplicationSecretService_UpdateApplicationSecret(t *testing.T) {
 	tests := []struct {
-		name     string
-		config   *config.Config
-		clientFn func() client.Client
-		req      *api.CreateSecretRequest
-		params   api.UpdateApplicationSecretParams
-		isError  bool
+		name            string
+		items           []api.SecretItem
+		patch           bool
+		expectedData    map[string]string
+		expectedChanges []api.SecretItemChange
 	}{
 		{
-			name: "正常に更新できるケース",
-			config: &config.Config{
-				PortalName: "portal-namespace",
+			name: "PUTはitemsで置き換え､含まれないキーを削除すること",
+			items: []api.SecretItem{
+				{Key: "DB_PASSWORD", Value: "new_secret"},
+				{Key: "NEW_KEY", Value: "new_value"},
+				{Key: "UNCHANGED", Value: "same"},
 			},
-			clientFn: func() client.Client {
-				scheme, err := k8sclient.NewScheme()
-				assert.NoError(t, err)
-				c := fake.NewClientBuilder().WithScheme(scheme).Build()
-				err = c.Create(t.Context(), &corev1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "example-app-secret",
-						Namespace: "portal-namespace",
-					},
-					Data: map[string][]byte{
-						"DB_PASSWORD": []byte("old_secret"),
-					},
-				})
-				assert.NoError(t, err)
-				return c
+			expectedData: map[string]string{
+				"DB_PASSWORD": "new_secret",
+				"NEW_KEY":     "new_value",
+				"UNCHANGED":   "same",
 			},
-			req: &api.CreateSecretRequest{
-				Items: []api.SecretItem{
-					{Key: "DB_PASSWORD", Value: "new_secret"},
-					{Key: "NEW_KEY", Value: "new_value"},
-				},
+			expectedChanges: []api.SecretItemChange{
+				{Key: "API_KEY", Status: api.SecretItemChangeStatusRemoved},
+				{Key: "DB_PASSWORD", Status: api.SecretItemChangeStatusUpdated},
+				{Key: "NEW_KEY", Status: api.SecretItemChangeStatusAdded},
+				{Key: "UNCHANGED", Status: api.SecretItemChangeStatusUnchanged},
 			},
-			params: api.UpdateApplicationSecretParams{
-				Name: "example-app",
-			},
-			isError: false,
 		},
 		{
-			name: "存在しないSecretを更新しようとした場合のエラーケース",
-			config: &config.Config{
-				PortalName: "portal-namespace",
+			name: "PATCHはitemsのキーのみ追加または更新すること",
+			items: []api.SecretItem{
+				{Key: "DB_PASSWORD", Value: "new_secret"},
+				{Key: "NEW_KEY", Value: "new_value"},
 			},
-			clientFn: func() client.Client {
-				scheme, err := k8sclient.NewScheme()
-				assert.NoError(t, err)
-				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			patch: true,
+			expectedData: map[string]string{
+				"API_KEY":     "apikey456",
+				"DB_PASSWORD": "new_secret",
+				"NEW_KEY":     "new_value",
+				"UNCHANGED":   "same",
 			},
-			req: &api.CreateSecretRequest{
-				Items: []api.SecretItem{
-					{Key: "DB_PASSWORD", Value: "new_secret"},
-				},
+			expectedChanges: []api.SecretItemChange{
+				{Key: "API_KEY", Status: api.SecretItemChangeStatusUnchanged},
+				{Key: "DB_PASSWORD", Status: api.SecretItemChangeStatusUpdated},
+				{Key: "NEW_KEY", Status: api.SecretItemChangeStatusAdded},
+				{Key: "UNCHANGED", Status: api.SecretItemChangeStatusUnchanged},
 			},
-			params: api.UpdateApplicationSecretParams{
-				Name: "non-existent-app",
+		},
+		{
+			name: "同じキーが複数ある場合は後の値を使うこと",
+			items: []api.SecretItem{
+				{Key: "DB_PASSWORD", Value: "new_secret"},
+				{Key: "DB_PASSWORD", Value: "secret123"},
+			},
+			patch: true,
+			expectedData: map[string]string{
+				"API_KEY":     "apikey456",
+				"DB_PASSWORD": "secret123",
+				"UNCHANGED":   "same",
+			},
+			expectedChanges: []api.SecretItemChange{
+				{Key: "API_KEY", Status: api.SecretItemChangeStatusUnchanged},
+				{Key: "DB_PASSWORD", Status: api.SecretItemChangeStatusUnchanged},
+				{Key: "UNCHANGED", Status: api.SecretItemChangeStatusUnchanged},
 			},
-			isError: true,
 		},
 	}
 
@@ -297,22 +321,319 @@ func TestApplicationSecretService_UpdateApplicationSecret(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			service := &ApplicationSecretService{
-				config: tt.config,
-				client: tt.clientFn(),
+			ctx := withRole(t.Context(), authz.RoleWriter)
+			c, _ := newTestApplicationClient(t)
+			vault := newTestVault(t)
+			created, err := vault.Create(t.Context(), "example-app-secret", map[string]string{
+				"API_KEY":     "apikey456",
+				"DB_PASSWORD": "secret123",
+				"UNCHANGED":   "same",
+			})
+			require.NoError(t, err)
+			service := NewApplicationSecretService(newTestConfig(), c, vault, nil)
+
+			req := &api.CreateSecretRequest{Items: tt.items}
+			var ret *api.SecretUpdate
+			if tt.patch {
+				ret, err = service.PatchApplicationSecret(ctx, req, api.PatchApplicationSecretParams{Name: "example-app"})
+			} else {
+				ret, err = service.UpdateApplicationSecret(ctx, req, api.UpdateApplicationSecretParams{Name: "example-app"})
 			}
-			ret, err := service.UpdateApplicationSecret(t.Context(), tt.req, tt.params)
-			if tt.isError {
-				assert.Error(t, err)
-				return
+			require.NoError(t, err)
+			assert.Equal(t, secretID(created), ret.ID)
+			assert.Equal(t, tt.expectedChanges, ret.Items)
+
+			stored, err := vault.Get(t.Context(), "example-app-secret")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedData, stored.Data)
+			projected := corev1.Secret{}
+			require.NoError(t, c.Get(t.Context(), types.NamespacedName{Namespace: "portal-namespace", Name: "example-app-secret"}, &projected))
+			assert.Len(t, projected.Data, len(tt.expectedData))
+			for k, v := range tt.expectedData {
+				assert.Equal(t, v, string(projected.Data[k]))
 			}
+		})
+	}
 
-			assert.NoError(t, err)
-			assert.NotNil(t, ret)
-			assert.Len(t, ret.Items, len(tt.req.Items))
-			for _, item := range ret.Items {
-				assert.Equal(t, "REDACTED", item.Value)
+	t.Run("存在しないSecretを更新しようとした場合は404となること", func(t *testing.T) {
+		t.Parallel()
+
+		scheme, err := k8sclient.NewScheme()
+		require.NoError(t, err)
+		service := NewApplicationSecretService(newTestConfig(), fake.NewClientBuilder().WithScheme(scheme).Build(), newTestVault(t), nil)
+
+		_, err = service.UpdateApplicationSecret(withRole(t.Context(), authz.RoleWriter), &api.CreateSecretRequest{
+			Items: []api.SecretItem{{Key: "DB_PASSWORD", Value: "new_secret"}},
+		}, api.UpdateApplicationSecretParams{Name: "non-existent-app"})
+		assert.ErrorIs(t, err, errSecretNotFound)
+	})
+}
+
+// conflictingStore は最初のReplaceSecretの直前にbeforeReplaceを呼び出し､他の更新と競合させる
+type conflictingStore struct {
+	secret.Store
+	beforeReplace func()
+	replaced      int
+}
+
+func (s *conflictingStore) ReplaceSecret(ctx context.Context, current, next *secret.EncryptedSecret) (bool, error) {
+	if s.replaced == 0 {
+		s.beforeReplace()
+	}
+	s.replaced++
+	return s.Store.ReplaceSecret(ctx, current, next)
+}
+
+func TestApplicationSecretService_PatchApplicationSecret_競合した場合は再試行すること(t *testing.T) {
+	t.Parallel()
+
+	c, _ := newTestApplicationClient(t)
+	memory := secret.NewMemoryStore()
+	keys, err := secret.NewLocalKeyManager(memory, bytes.Repeat([]byte{0x42}, 32))
+	require.NoError(t, err)
+	other := secret.NewVault(memory, keys)
+	_, err = other.Create(t.Context(), "example-app-secret", map[string]string{"DB_PASSWORD": "secret123"})
+	require.NoError(t, err)
+	store := &conflictingStore{
+		Store: memory,
+		beforeReplace: func() {
+			_, err := other.Put(t.Context(), "example-app-secret", map[string]string{"DB_PASSWORD": "secret123", "OTHER": "other"})
+			require.NoError(t, err)
+		},
+	}
+	vault := secret.NewVault(store, keys)
+	service := NewApplicationSecretService(newTestConfig(), c, vault, nil)
+
+	ret, err := service.PatchApplicationSecret(withRole(t.Context(), authz.RoleWriter), &api.CreateSecretRequest{
+		Items: []api.SecretItem{{Key: "NEW_KEY", Value: "new_value"}},
+	}, api.PatchApplicationSecretParams{Name: "example-app"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, store.replaced)
+	// 変更内容は再試行時に読み出した値と比べること
+	assert.Equal(t, []api.SecretItemChange{
+		{Key: "DB_PASSWORD", Status: api.SecretItemChangeStatusUnchanged},
+		{Key: "NEW_KEY", Status: api.SecretItemChangeStatusAdded},
+		{Key: "OTHER", Status: api.SecretItemChangeStatusUnchanged},
+	}, ret.Items)
+
+	// 他の更新を上書きしないこと
+	stored, err := vault.Get(t.Context(), "example-app-secret")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"DB_PASSWORD": "secret123", "NEW_KEY": "new_value", "OTHER": "other"}, stored.Data)
+}
+
+func TestApplicationSecretService_UpdateApplicationSecret_監査ログ(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := k8sclient.NewScheme()
+	require.NoError(t, err)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	require.NoError(t, c.Create(t.Context(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example-app-secret",
+			Namespace: "portal-namespace",
+		},
+	}))
+	audits, recorder := newTestAuditLogger()
+	service := NewApplicationSecretService(&config.Config{PortalName: "portal-namespace"}, c, newTestVault(t), audits)
+
+	_, err = service.UpdateApplicationSecret(withRole(t.Context(), authz.RoleWriter), &api.CreateSecretRequest{
+		Items: []api.SecretItem{{Key: "DB_PASSWORD", Value: "new_secret"}},
+	}, api.UpdateApplicationSecretParams{Name: "example-app"})
+	require.NoError(t, err)
+
+	events := recorder.Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, audit.ActionUpdateSecret, events[0].Action)
+	assert.Equal(t, audit.OutcomeSuccess, events[0].Outcome)
+	assert.Equal(t, audit.Target{
+		Namespace:   "portal-namespace",
+		Application: "example-app",
+		Secret:      "example-app-secret",
+	}, events[0].Target)
+	assert.NotContains(t, events[0].Reason, "new_secret")
+}
+
+func TestApplicationSecretService_PostgreSQLを源泉とすること(t *testing.T) {
+	t.Parallel()
+
+	ctx := withRole(t.Context(), authz.RoleWriter)
+	c, _ := newTestApplicationClient(t)
+	require.NoError(t, c.Delete(t.Context(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-app-secret", Namespace: "portal-namespace"},
+	}))
+	vault := newTestVault(t)
+	audits, _ := newTestAuditLogger()
+	service := NewApplicationSecretService(newTestConfig(), c, vault, audits)
+
+	created, err := service.CreateApplicationSecret(ctx, &api.CreateSecretRequest{
+		Items: []api.SecretItem{{Key: "DB_PASSWORD", Value: "secret123"}},
+	}, api.CreateApplicationSecretParams{Name: "example-app"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+
+	stored, err := vault.Get(t.Context(), "example-app-secret")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"DB_PASSWORD": "secret123"}, stored.Data)
+
+	// Kubernetes Secretを削除しても､PostgreSQLから取得して再作成できること
+	require.NoError(t, c.Delete(t.Context(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-app-secret", Namespace: "portal-namespace"},
+	}))
+	ret, err := service.GetApplicationSecret(ctx, api.GetApplicationSecretParams{Name: "example-app"})
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, ret.ID)
+	assert.Equal(t, []api.SecretItem{{Key: "DB_PASSWORD", Value: "REDACTED"}}, ret.Items)
+
+	_, err = service.PatchApplicationSecret(ctx, &api.CreateSecretRequest{
+		Items: []api.SecretItem{{Key: "API_KEY", Value: "apikey456"}},
+	}, api.PatchApplicationSecretParams{Name: "example-app"})
+	require.NoError(t, err)
+
+	projected := corev1.Secret{}
+	require.NoError(t, c.Get(t.Context(), types.NamespacedName{Namespace: "portal-namespace", Name: "example-app-secret"}, &projected))
+	assert.Equal(t, map[string][]byte{
+		"DB_PASSWORD": []byte("secret123"),
+		"API_KEY":     []byte("apikey456"),
+	}, projected.Data)
+}
+
+func TestApplicationSecretService_PatchApplicationSecret_既存のKubernetes_Secretを取り込むこと(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := k8sclient.NewScheme()
+	require.NoError(t, err)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-app-secret", Namespace: "portal-namespace"},
+		Data:       map[string][]byte{"DB_PASSWORD": []byte("old_secret")},
+	}).Build()
+	vault := newTestVault(t)
+	audits, _ := newTestAuditLogger()
+	service := NewApplicationSecretService(newTestConfig(), c, vault, audits)
+
+	ret, err := service.PatchApplicationSecret(withRole(t.Context(), authz.RoleWriter), &api.CreateSecretRequest{
+		Items: []api.SecretItem{{Key: "API_KEY", Value: "apikey456"}},
+	}, api.PatchApplicationSecretParams{Name: "example-app"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, ret.ID)
+	assert.Equal(t, []api.SecretItemChange{
+		{Key: "API_KEY", Status: api.SecretItemChangeStatusAdded},
+		{Key: "DB_PASSWORD", Status: api.SecretItemChangeStatusUnchanged},
+	}, ret.Items)
+
+	stored, err := vault.Get(t.Context(), "example-app-secret")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"DB_PASSWORD": "old_secret", "API_KEY": "apikey456"}, stored.Data)
+}
+
+func TestApplicationSecretService_PostgreSQLが設定されていない場合は503となること(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := k8sclient.NewScheme()
+	require.NoError(t, err)
+	service := NewApplicationSecretService(newTestConfig(), fake.NewClientBuilder().WithScheme(scheme).Build(), nil, nil)
+
+	_, err = service.GetApplicationSecret(withRole(t.Context(), authz.RoleViewer), api.GetApplicationSecretParams{Name: "example-app"})
+	assert.ErrorIs(t, err, errSecretStoreNotConfigured)
+}
+
+func TestApplicationSecretService_DeleteApplicationSecretKey(t *testing.T) {
+	tests := []struct {
+		name         string
+		key          string
+		expectedErr  error
+		expectedData map[string]string
+	}{
+		{
+			name:         "キーを削除し､残りのキーを返すこと",
+			key:          "API_KEY",
+			expectedData: map[string]string{"DB_PASSWORD": "secret123"},
+		},
+		{
+			name:        "存在しないキーは404となること",
+			key:         "MISSING",
+			expectedErr: errSecretKeyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, _ := newTestApplicationClient(t)
+			vault := newTestVault(t)
+			_, err := vault.Create(t.Context(), "example-app-secret", map[string]string{"DB_PASSWORD": "secret123", "API_KEY": "apikey456"})
+			require.NoError(t, err)
+			audits, recorder := newTestAuditLogger()
+			service := NewApplicationSecretService(newTestConfig(), c, vault, audits)
+
+			ret, err := service.DeleteApplicationSecretKey(withRole(t.Context(), authz.RoleWriter), api.DeleteApplicationSecretKeyParams{Name: "example-app", Key: tt.key})
+			events := recorder.Events()
+			require.Len(t, events, 1)
+			assert.Equal(t, audit.ActionDeleteSecretKey, events[0].Action)
+			assert.Equal(t, tt.key, events[0].Target.ID)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
 			}
+			require.NoError(t, err)
+			assert.Equal(t, []api.SecretItem{{Key: "DB_PASSWORD", Value: "REDACTED"}}, ret.Items)
+
+			stored, err := vault.Get(t.Context(), "example-app-secret")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedData, stored.Data)
+			projected := corev1.Secret{}
+			require.NoError(t, c.Get(t.Context(), types.NamespacedName{Namespace: "portal-namespace", Name: "example-app-secret"}, &projected))
+			assert.Equal(t, map[string][]byte{"DB_PASSWORD": []byte("secret123")}, projected.Data)
 		})
 	}
 }
+
+func TestApplicationSecretService_DeleteApplicationSecret(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Secretを削除し､Applicationの参照を外すこと", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := withRole(t.Context(), authz.RoleWriter)
+		c, _ := newTestApplicationClient(t)
+		require.NoError(t, c.Delete(t.Context(), &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "example-app-secret", Namespace: "portal-namespace"},
+		}))
+		vault := newTestVault(t)
+		audits, recorder := newTestAuditLogger()
+		service := NewApplicationSecretService(newTestConfig(), c, vault, audits)
+		_, err := service.CreateApplicationSecret(ctx, &api.CreateSecretRequest{
+			Items: []api.SecretItem{{Key: "DB_PASSWORD", Value: "secret123"}},
+		}, api.CreateApplicationSecretParams{Name: "example-app"})
+		require.NoError(t, err)
+
+		require.NoError(t, service.DeleteApplicationSecret(ctx, api.DeleteApplicationSecretParams{Name: "example-app"}))
+
+		_, err = vault.Get(t.Context(), "example-app-secret")
+		assert.ErrorIs(t, err, secret.ErrSecretNotFound)
+		err = c.Get(t.Context(), types.NamespacedName{Namespace: "portal-namespace", Name: "example-app-secret"}, &corev1.Secret{})
+		assert.True(t, apierrors.IsNotFound(err))
+		app := tacokumov1alpha1.Application{}
+		require.NoError(t, c.Get(t.Context(), types.NamespacedName{Namespace: "portal-namespace", Name: "example-app"}, &app))
+		assert.Nil(t, app.Spec.ReleaseTemplate.EnvSecretName)
+		assert.Equal(t, audit.ActionDeleteSecret, recorder.Events()[1].Action)
+
+		// 削除済みの場合は404となること
+		_, err = service.GetApplicationSecret(ctx, api.GetApplicationSecretParams{Name: "example-app"})
+		assert.ErrorIs(t, err, errSecretNotFound)
+		err = service.DeleteApplicationSecret(ctx, api.DeleteApplicationSecretParams{Name: "example-app"})
+		assert.ErrorIs(t, err, errSecretNotFound)
+	})
+
+	t.Run("PostgreSQLに取り込む前のKubernetes Secretも削除できること", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newTestApplicationClient(t)
+		service := NewApplicationSecretService(newTestConfig(), c, newTestVault(t), nil)
+
+		require.NoError(t, service.DeleteApplicationSecret(withRole(t.Context(), authz.RoleWriter), api.DeleteApplicationSecretParams{Name: "example-app"}))
+		err := c.Get(t.Context(), types.NamespacedName{Namespace: "portal-namespace", Name: "example-app-secret"}, &corev1.Secret{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+}
@@ -2,55 +2,95 @@ package v1alpha1
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/audit"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/authz"
 	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/secret"
 	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// readyPollInterval はApplicationがReadyになるのを待つ間に状態を取得する間隔
+const readyPollInterval = 2 * time.Second
+
 type ApplicationService struct {
 	config *config.Config
+	// client は書き込みと､最新の状態が必要な読み取りに使う
 	client client.Client
+	// reader は参照系のAPIの読み取りに使うinformerのキャッシュ｡nilの場合はclientから読み取る
+	reader client.Reader
+	// secrets はApplicationの削除時にPostgreSQLのSecretも削除するためのもの｡nilの場合はKubernetes Secretのみ削除する
+	secrets *secret.Vault
+	audits  *audit.Logger
+	// revocations はWatchApplicationsの接続中にアクセストークンが失効したことを確認するためのもの
+	// nilの場合は接続中の失効を確認しない
+	revocations auth.RevocationList
+	// pollInterval はテストで待ち時間を短くするためのもの｡ゼロの場合はreadyPollIntervalを使う
+	pollInterval time.Duration
+	// heartbeatInterval はテストで待ち時間を短くするためのもの｡ゼロの場合はwatchHeartbeatIntervalを使う
+	heartbeatInterval time.Duration
+}
+
+// cachedReader は参照系のAPIで使う読み取り先を返す
+// 作成や更新の直後の状態はキャッシュに反映されていない場合があるため､更新のための読み取りにはclientを使う
+func (s *ApplicationService) cachedReader() client.Reader {
+	if s.reader == nil {
+		return s.client
+	}
+	return s.reader
 }
 
 func (s *ApplicationService) GetApplication(
 	ctx context.Context,
 	params api.GetApplicationParams,
 ) (*api.Application, error) {
+	if err := authorize(ctx, authz.RoleViewer); err != nil {
+		return nil, err
+	}
+
 	key := types.NamespacedName{
 		Namespace: s.config.PortalName,
 		Name:      params.Name,
 	}
 	app := tacokumov1alpha1.Application{}
-	if err := s.client.Get(ctx, key, &app); err != nil {
+	if err := s.cachedReader().Get(ctx, key, &app); err != nil {
+		return nil, err
+	}
+	if err := authorizeRepository(ctx, app.Spec.ReleaseTemplate.Repo.URL); err != nil {
 		return nil, err
 	}
 
-	return &api.Application{
-		Name:            app.Name,
-		AppconfigPath:   app.Spec.ReleaseTemplate.AppConfigPath,
-		RepositoryURL:   app.Spec.ReleaseTemplate.Repo.URL,
-		AppconfigBranch: app.Spec.ReleaseTemplate.AppConfigBranch,
-	}, nil
+	return lo.ToPtr(toAPIApplication(&app)), nil
 }
 
 func (s *ApplicationService) GetApplications(ctx context.Context) ([]api.Application, error) {
+	if err := authorize(ctx, authz.RoleViewer); err != nil {
+		return nil, err
+	}
+
 	appList := tacokumov1alpha1.ApplicationList{}
-	if err := s.client.List(ctx, &appList); err != nil {
+	if err := s.cachedReader().List(ctx, &appList, client.InNamespace(s.config.PortalName)); err != nil {
 		return nil, err
 	}
 
-	apps := lo.Map(appList.Items, func(item tacokumov1alpha1.Application, _ int) api.Application {
-		return api.Application{
-			Name:            item.Name,
-			AppconfigPath:   item.Spec.ReleaseTemplate.AppConfigPath,
-			RepositoryURL:   item.Spec.ReleaseTemplate.Repo.URL,
-			AppconfigBranch: item.Spec.ReleaseTemplate.AppConfigBranch,
-		}
+	// Installation Access Tokenの場合はアクセスできるリポジトリのApplicationのみを返す
+	items := lo.Filter(appList.Items, func(item tacokumov1alpha1.Application, _ int) bool {
+		return authorizeRepository(ctx, item.Spec.ReleaseTemplate.Repo.URL) == nil
+	})
+	apps := lo.Map(items, func(item tacokumov1alpha1.Application, _ int) api.Application {
+		return toAPIApplication(&item)
 	})
 	return apps, nil
 }
@@ -58,16 +98,39 @@ func (s *ApplicationService) GetApplications(ctx context.Context) ([]api.Applica
 func (s *ApplicationService) CreateApplication(
 	ctx context.Context,
 	req *api.CreateApplicationRequest,
-) (*api.Application, error) {
+	params api.CreateApplicationParams,
+) (_ *api.Application, err error) {
+	defer func() {
+		s.audits.Record(ctx, audit.ActionCreateApplication, s.auditTarget(req.Name), err)
+	}()
+
+	if err := authorize(ctx, authz.RoleWriter); err != nil {
+		return nil, err
+	}
+	violations := fieldViolations{}
+	validateApplicationName(&violations, req.Name)
+	validateRepositoryURL(&violations, &req.RepositoryURL, s.config.Application.AllowedGitHosts)
+	validateAppconfigPath(&violations, req.AppconfigPath)
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+	repoURL := req.RepositoryURL.String()
+	if err := authorizeRepository(ctx, repoURL); err != nil {
+		return nil, err
+	}
+
 	app := tacokumov1alpha1.Application{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      req.Name,
 			Namespace: s.config.PortalName,
+			Annotations: map[string]string{
+				annotationDisplayID: newDisplayID(),
+			},
 		},
 		Spec: tacokumov1alpha1.ApplicationSpec{
 			ReleaseTemplate: tacokumov1alpha1.ReleaseSpec{
 				Repo: tacokumov1alpha1.RepositoryRef{
-					URL: req.RepositoryURL,
+					URL: repoURL,
 				},
 				AppConfigPath:   req.AppconfigPath,
 				AppConfigBranch: req.AppconfigBranch,
@@ -75,13 +138,240 @@ func (s *ApplicationService) CreateApplication(
 		},
 	}
 
+	setDescription(&app, req.Description.Value)
+
 	if err := s.client.Create(ctx, &app); err != nil {
 		return nil, err
 	}
-	return &api.Application{
-		Name:            app.Name,
-		AppconfigPath:   app.Spec.ReleaseTemplate.AppConfigPath,
-		RepositoryURL:   app.Spec.ReleaseTemplate.Repo.URL,
-		AppconfigBranch: app.Spec.ReleaseTemplate.AppConfigBranch,
-	}, nil
+	if params.Wait.Or("") == api.CreateApplicationWaitReady {
+		timeout := time.Duration(params.Timeout.Or(60)) * time.Second
+		if err := s.waitForReady(ctx, &app, timeout); err != nil {
+			return nil, err
+		}
+	}
+	return lo.ToPtr(toAPIApplication(&app)), nil
+}
+
+// waitForReady はappがReadyになるか､調整に失敗するか､timeoutが経過するまで待つ
+// appは最後に取得した状態に更新されるため､タイムアウトした場合も呼び出し元はその時点の状態を返せる
+func (s *ApplicationService) waitForReady(ctx context.Context, app *tacokumov1alpha1.Application, timeout time.Duration) error {
+	interval := s.pollInterval
+	if interval == 0 {
+		interval = readyPollInterval
+	}
+	key := client.ObjectKeyFromObject(app)
+	err := wait.PollUntilContextTimeout(ctx, interval, timeout, true, func(ctx context.Context) (bool, error) {
+		latest := tacokumov1alpha1.Application{}
+		if err := s.client.Get(ctx, key, &latest); err != nil {
+			return false, toApplicationError(err)
+		}
+		*app = latest
+		return isApplicationReady(app) || isApplicationFailed(app), nil
+	})
+	if err != nil && !wait.Interrupted(err) {
+		return err
+	}
+	return nil
+}
+
+var (
+	errApplicationNotFound = &ErrorWithCode{
+		Code:    http.StatusNotFound,
+		Message: "application not found",
+	}
+	errApplicationConflict = &ErrorWithCode{
+		Code:    http.StatusConflict,
+		Message: "application has been modified; get the latest resource_version and retry",
+	}
+	errResourceVersionRequired = &ErrorWithCode{
+		Code:    http.StatusBadRequest,
+		Message: "resource_version is required",
+	}
+)
+
+// toApplicationError はApplicationの取得･更新･削除のエラーをHTTPステータスに対応付ける
+func toApplicationError(err error) error {
+	switch {
+	case apierrors.IsNotFound(err):
+		return errApplicationNotFound
+	case apierrors.IsConflict(err):
+		return errApplicationConflict
+	default:
+		return err
+	}
+}
+
+// UpdateApplication はApplicationのリリース設定を置き換える
+// resource_versionが最新でない場合は他の更新を上書きしないように409を返す
+func (s *ApplicationService) UpdateApplication(
+	ctx context.Context,
+	req *api.UpdateApplicationRequest,
+	params api.UpdateApplicationParams,
+) (_ *api.Application, err error) {
+	defer func() {
+		s.audits.Record(ctx, audit.ActionUpdateApplication, s.auditTarget(params.Name), err)
+	}()
+
+	if err := authorize(ctx, authz.RoleWriter); err != nil {
+		return nil, err
+	}
+	// 空のresource_versionは無条件の上書きになるため受け付けない
+	if req.ResourceVersion == "" {
+		return nil, errResourceVersionRequired
+	}
+	violations := fieldViolations{}
+	validateRepositoryURL(&violations, &req.RepositoryURL, s.config.Application.AllowedGitHosts)
+	validateAppconfigPath(&violations, req.AppconfigPath)
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	app, err := s.getForUpdate(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+	repoURL := req.RepositoryURL.String()
+	if err := authorizeRepository(ctx, repoURL); err != nil {
+		return nil, err
+	}
+
+	app.ResourceVersion = req.ResourceVersion
+	setDescription(app, req.Description.Value)
+	app.Spec.ReleaseTemplate.Repo.URL = repoURL
+	app.Spec.ReleaseTemplate.AppConfigPath = req.AppconfigPath
+	app.Spec.ReleaseTemplate.AppConfigBranch = req.AppconfigBranch
+	return s.update(ctx, app)
+}
+
+// PatchApplication はJSON Merge Patchで指定されたフィールドのみを更新する
+// resource_versionが指定されていない場合は取得した最新のApplicationに対して更新する
+func (s *ApplicationService) PatchApplication(
+	ctx context.Context,
+	req *api.PatchApplicationRequest,
+	params api.PatchApplicationParams,
+) (_ *api.Application, err error) {
+	defer func() {
+		s.audits.Record(ctx, audit.ActionUpdateApplication, s.auditTarget(params.Name), err)
+	}()
+
+	if err := authorize(ctx, authz.RoleWriter); err != nil {
+		return nil, err
+	}
+	violations := fieldViolations{}
+	if v, ok := req.RepositoryURL.Get(); ok {
+		validateRepositoryURL(&violations, &v, s.config.Application.AllowedGitHosts)
+	}
+	if v, ok := req.AppconfigPath.Get(); ok {
+		validateAppconfigPath(&violations, v)
+	}
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	app, err := s.getForUpdate(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := req.ResourceVersion.Get(); ok {
+		app.ResourceVersion = v
+	}
+	if req.Description.IsSet() {
+		// JSON Merge Patchではnullはフィールドの削除を表す
+		setDescription(app, req.Description.Or(""))
+	}
+	if v, ok := req.RepositoryURL.Get(); ok {
+		repoURL := v.String()
+		if err := authorizeRepository(ctx, repoURL); err != nil {
+			return nil, err
+		}
+		app.Spec.ReleaseTemplate.Repo.URL = repoURL
+	}
+	if v, ok := req.AppconfigPath.Get(); ok {
+		app.Spec.ReleaseTemplate.AppConfigPath = v
+	}
+	if v, ok := req.AppconfigBranch.Get(); ok {
+		app.Spec.ReleaseTemplate.AppConfigBranch = v
+	}
+	return s.update(ctx, app)
+}
+
+// DeleteApplication はApplicationと､ApplicationSecretServiceが作成した<name>-secretを削除する
+// resource_versionが最新でない場合は何も削除せずに409を返す
+func (s *ApplicationService) DeleteApplication(ctx context.Context, params api.DeleteApplicationParams) (err error) {
+	defer func() {
+		s.audits.Record(ctx, audit.ActionDeleteApplication, s.auditTarget(params.Name), err)
+	}()
+
+	if err := authorize(ctx, authz.RoleWriter); err != nil {
+		return err
+	}
+
+	// 空のresource_versionは無条件の削除になるため受け付けない
+	if params.ResourceVersion == "" {
+		return errResourceVersionRequired
+	}
+
+	app, err := s.getForUpdate(ctx, params.Name)
+	if err != nil {
+		return err
+	}
+	if params.ResourceVersion != app.ResourceVersion {
+		return errApplicationConflict
+	}
+
+	// Applicationを先に削除すると､Secretの削除に失敗した場合に再試行しても404となりSecretが残るため､
+	// Secretを先に削除して､途中で失敗しても同じリクエストの再試行で残りを削除できるようにする
+	// PostgreSQLに残っているとKubernetes Secretへの定期的な同期で作り直されるため､Kubernetes Secretより先に削除する
+	if s.secrets != nil {
+		if err := s.secrets.Delete(ctx, secretName(params.Name)); err != nil && !errors.Is(err, secret.ErrSecretNotFound) {
+			return err
+		}
+	}
+	envSecret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: s.config.PortalName,
+			Name:      secretName(params.Name),
+		},
+	}
+	if err := s.client.Delete(ctx, &envSecret); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	// 取得から削除までの間の更新もAPIサーバーで検出する
+	if err := s.client.Delete(ctx, app, client.Preconditions{ResourceVersion: &params.ResourceVersion}); err != nil {
+		return toApplicationError(err)
+	}
+	return nil
+}
+
+// getForUpdate は更新･削除するApplicationを取得し､呼び出し元が操作できることを確認する
+func (s *ApplicationService) getForUpdate(ctx context.Context, name string) (*tacokumov1alpha1.Application, error) {
+	app := tacokumov1alpha1.Application{}
+	if err := s.client.Get(ctx, types.NamespacedName{
+		Namespace: s.config.PortalName,
+		Name:      name,
+	}, &app); err != nil {
+		return nil, toApplicationError(err)
+	}
+	if err := authorizeRepository(ctx, app.Spec.ReleaseTemplate.Repo.URL); err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
+
+func (s *ApplicationService) update(ctx context.Context, app *tacokumov1alpha1.Application) (*api.Application, error) {
+	// Portal APIの外で作成されたApplicationも､更新時に表示用IDを固定する
+	ensureDisplayID(app)
+	if err := s.client.Update(ctx, app); err != nil {
+		return nil, toApplicationError(err)
+	}
+	return lo.ToPtr(toAPIApplication(app)), nil
+}
+
+func (s *ApplicationService) auditTarget(name string) audit.Target {
+	return audit.Target{
+		Namespace:   s.config.PortalName,
+		Application: name,
+	}
 }
@@ -1,16 +1,27 @@
 package v1alpha1
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/audit"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/authz"
 	"github.com/tacokumo/portal-api/pkg/config"
 	"github.com/tacokumo/portal-api/pkg/k8sclient"
+	"github.com/tacokumo/portal-api/pkg/secret"
 	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 func TestApplicationService_GetApplication(t *testing.T) {
@@ -22,10 +33,8 @@ func TestApplicationService_GetApplication(t *testing.T) {
 		isError  bool
 	}{
 		{
-			name: "存在するApplicationを取得できること",
-			config: &config.Config{
-				PortalName: "portal-namespace",
-			},
+			name:   "存在するApplicationを取得できること",
+			config: newTestConfig(),
 			params: api.GetApplicationParams{
 				Name: "example-app",
 			},
@@ -54,10 +63,8 @@ func TestApplicationService_GetApplication(t *testing.T) {
 			},
 		},
 		{
-			name: "存在しないApplicationを取得しようとした場合、エラーとなること",
-			config: &config.Config{
-				PortalName: "portal-namespace",
-			},
+			name:   "存在しないApplicationを取得しようとした場合、エラーとなること",
+			config: newTestConfig(),
 			params: api.GetApplicationParams{
 				Name: "non-existent-app",
 			},
@@ -78,7 +85,7 @@ func TestApplicationService_GetApplication(t *testing.T) {
 				config: tt.config,
 				client: tt.clientFn(),
 			}
-			ret, err := service.GetApplication(t.Context(), tt.params)
+			ret, err := service.GetApplication(withRole(t.Context(), authz.RoleViewer), tt.params)
 			if tt.isError {
 				assert.Error(t, err)
 				return
@@ -98,10 +105,8 @@ func TestApplicationService_GetApplications(t *testing.T) {
 		expected int
 	}{
 		{
-			name: "空の一覧を取得するケース",
-			config: &config.Config{
-				PortalName: "portal-namespace",
-			},
+			name:   "空の一覧を取得するケース",
+			config: newTestConfig(),
 			clientFn: func() client.Client {
 				scheme, err := k8sclient.NewScheme()
 				assert.NoError(t, err)
@@ -110,10 +115,8 @@ func TestApplicationService_GetApplications(t *testing.T) {
 			expected: 0,
 		},
 		{
-			name: "複数のApplicationが存在するケース",
-			config: &config.Config{
-				PortalName: "portal-namespace",
-			},
+			name:   "複数のApplicationが存在するケース",
+			config: newTestConfig(),
 			clientFn: func() client.Client {
 				scheme, err := k8sclient.NewScheme()
 				assert.NoError(t, err)
@@ -169,13 +172,51 @@ func TestApplicationService_GetApplications(t *testing.T) {
 				config: tt.config,
 				client: tt.clientFn(),
 			}
-			ret, err := service.GetApplications(t.Context())
+			ret, err := service.GetApplications(withRole(t.Context(), authz.RoleViewer))
 			assert.NoError(t, err)
 			assert.Len(t, ret, tt.expected)
 		})
 	}
 }
 
+func TestApplicationService_キャッシュからの読み取り(t *testing.T) {
+	t.Parallel()
+
+	// readerにのみexample-appが存在し､clientには存在しない
+	reader, _ := newTestApplicationClient(t)
+	scheme, err := k8sclient.NewScheme()
+	require.NoError(t, err)
+	service := &ApplicationService{
+		config: newTestConfig(),
+		client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		reader: reader,
+	}
+	ctx := withRole(t.Context(), authz.RoleWriter)
+
+	t.Run("GetApplicationはreaderから読み取ること", func(t *testing.T) {
+		t.Parallel()
+
+		ret, err := service.GetApplication(ctx, api.GetApplicationParams{Name: "example-app"})
+		require.NoError(t, err)
+		assert.Equal(t, "example-app", ret.Name)
+	})
+
+	t.Run("GetApplicationsはreaderから読み取ること", func(t *testing.T) {
+		t.Parallel()
+
+		ret, err := service.GetApplications(ctx)
+		require.NoError(t, err)
+		assert.Len(t, ret, 1)
+	})
+
+	t.Run("更新のための読み取りはclientから行うこと", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := service.PatchApplication(ctx, &api.PatchApplicationRequest{}, api.PatchApplicationParams{Name: "example-app"})
+		assert.ErrorIs(t, err, errApplicationNotFound)
+	})
+}
+
 func TestApplicationService_CreateApplication(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -185,10 +226,8 @@ func TestApplicationService_CreateApplication(t *testing.T) {
 		isError  bool
 	}{
 		{
-			name: "正常に作成できるケース",
-			config: &config.Config{
-				PortalName: "portal-namespace",
-			},
+			name:   "正常に作成できるケース",
+			config: newTestConfig(),
 			clientFn: func() client.Client {
 				scheme, err := k8sclient.NewScheme()
 				assert.NoError(t, err)
@@ -197,16 +236,14 @@ func TestApplicationService_CreateApplication(t *testing.T) {
 			req: &api.CreateApplicationRequest{
 				Name:            "new-app",
 				AppconfigPath:   "apps/new-app",
-				RepositoryURL:   "https://github.com/tacokumo/new-app.git",
+				RepositoryURL:   repositoryURL(t, "https://github.com/tacokumo/new-app.git"),
 				AppconfigBranch: "main",
 			},
 			isError: false,
 		},
 		{
-			name: "既に同名のApplicationが存在する場合のエラーケース",
-			config: &config.Config{
-				PortalName: "portal-namespace",
-			},
+			name:   "既に同名のApplicationが存在する場合のエラーケース",
+			config: newTestConfig(),
 			clientFn: func() client.Client {
 				scheme, err := k8sclient.NewScheme()
 				assert.NoError(t, err)
@@ -232,7 +269,7 @@ func TestApplicationService_CreateApplication(t *testing.T) {
 			req: &api.CreateApplicationRequest{
 				Name:            "existing-app",
 				AppconfigPath:   "apps/existing-app",
-				RepositoryURL:   "https://github.com/tacokumo/existing-app.git",
+				RepositoryURL:   repositoryURL(t, "https://github.com/tacokumo/existing-app.git"),
 				AppconfigBranch: "main",
 			},
 			isError: true,
@@ -247,7 +284,7 @@ func TestApplicationService_CreateApplication(t *testing.T) {
 				config: tt.config,
 				client: tt.clientFn(),
 			}
-			ret, err := service.CreateApplication(t.Context(), tt.req)
+			ret, err := service.CreateApplication(withRole(t.Context(), authz.RoleWriter), tt.req, api.CreateApplicationParams{})
 			if tt.isError {
 				assert.Error(t, err)
 				return
@@ -257,8 +294,472 @@ func TestApplicationService_CreateApplication(t *testing.T) {
 			assert.NotNil(t, ret)
 			assert.Equal(t, tt.req.Name, ret.Name)
 			assert.Equal(t, tt.req.AppconfigPath, ret.AppconfigPath)
-			assert.Equal(t, tt.req.RepositoryURL, ret.RepositoryURL)
+			assert.Equal(t, tt.req.RepositoryURL.String(), ret.RepositoryURL)
 			assert.Equal(t, tt.req.AppconfigBranch, ret.AppconfigBranch)
+			assert.Regexp(t, `^app-[2-9a-z]{8}$`, ret.DisplayID)
+		})
+	}
+}
+
+func TestApplicationService_CreateApplication_説明(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := k8sclient.NewScheme()
+	require.NoError(t, err)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	service := &ApplicationService{config: newTestConfig(), client: c}
+
+	ret, err := service.CreateApplication(withRole(t.Context(), authz.RoleWriter), &api.CreateApplicationRequest{
+		Name:          "new-app",
+		RepositoryURL: repositoryURL(t, "https://github.com/tacokumo/new-app.git"),
+		Description:   api.NewOptString("tacokumo bot"),
+	}, api.CreateApplicationParams{})
+	require.NoError(t, err)
+	assert.Equal(t, api.NewOptString("tacokumo bot"), ret.Description)
+
+	got, err := service.GetApplication(withRole(t.Context(), authz.RoleViewer), api.GetApplicationParams{Name: "new-app"})
+	require.NoError(t, err)
+	assert.Equal(t, *ret, *got)
+}
+
+func TestApplicationService_CreateApplication_Ready待ち(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		status    *tacokumov1alpha1.ApplicationStatus
+		timeout   int
+		wantReady bool
+		wantError string
+	}{
+		{
+			name: "Runningになった状態を返すこと",
+			status: &tacokumov1alpha1.ApplicationStatus{
+				State: tacokumov1alpha1.ApplicationStateRunning,
+			},
+			timeout:   30,
+			wantReady: true,
+		},
+		{
+			name: "調整に失敗した場合はタイムアウトを待たずに返すこと",
+			status: &tacokumov1alpha1.ApplicationStatus{
+				State: tacokumov1alpha1.ApplicationStateError,
+				Conditions: []metav1.Condition{{
+					Type:               tacokumov1alpha1.ConditionTypeReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             tacokumov1alpha1.ReasonReconcileError,
+					Message:            "appconfig.yaml not found",
+					LastTransitionTime: metav1.Now(),
+				}},
+			},
+			timeout:   30,
+			wantError: "appconfig.yaml not found",
+		},
+		{
+			name:    "タイムアウトした場合はその時点の状態を返すこと",
+			timeout: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme, err := k8sclient.NewScheme()
+			require.NoError(t, err)
+			c := fake.NewClientBuilder().WithScheme(scheme).Build()
+			service := &ApplicationService{
+				config:       newTestConfig(),
+				client:       c,
+				pollInterval: 10 * time.Millisecond,
+			}
+
+			if tt.status != nil {
+				// コントローラーの代わりにApplicationの作成後に状態を更新する
+				go func() {
+					key := client.ObjectKey{Namespace: "portal-namespace", Name: "new-app"}
+					assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+						app := tacokumov1alpha1.Application{}
+						require.NoError(collect, c.Get(t.Context(), key, &app))
+						app.Status = *tt.status
+						for i := range app.Status.Conditions {
+							app.Status.Conditions[i].ObservedGeneration = app.Generation
+						}
+						require.NoError(collect, c.Update(t.Context(), &app))
+					}, 5*time.Second, 10*time.Millisecond)
+				}()
+			}
+
+			start := time.Now()
+			ret, err := service.CreateApplication(withRole(t.Context(), authz.RoleWriter), &api.CreateApplicationRequest{
+				Name:          "new-app",
+				RepositoryURL: repositoryURL(t, "https://github.com/tacokumo/new-app.git"),
+			}, api.CreateApplicationParams{
+				Wait:    api.NewOptCreateApplicationWait(api.CreateApplicationWaitReady),
+				Timeout: api.NewOptInt(tt.timeout),
+			})
+			require.NoError(t, err)
+			assert.Less(t, time.Since(start), 10*time.Second)
+			assert.Equal(t, tt.wantReady, ret.Status.Ready)
+			assert.Equal(t, tt.wantError, ret.Status.LastError.Or(""))
 		})
 	}
 }
+
+func TestApplicationService_CreateApplication_監査ログ(t *testing.T) {
+	tests := []struct {
+		name            string
+		role            string
+		expectedOutcome audit.Outcome
+	}{
+		{
+			name:            "作成に成功した場合は成功として記録されること",
+			role:            authz.RoleWriter,
+			expectedOutcome: audit.OutcomeSuccess,
+		},
+		{
+			name:            "権限が不足している場合も失敗として記録されること",
+			role:            authz.RoleViewer,
+			expectedOutcome: audit.OutcomeFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme, err := k8sclient.NewScheme()
+			require.NoError(t, err)
+			audits, recorder := newTestAuditLogger()
+			service := &ApplicationService{
+				config: newTestConfig(),
+				client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				audits: audits,
+			}
+
+			ctx := audit.WithRequestID(withRole(t.Context(), tt.role), "request-id")
+			_, _ = service.CreateApplication(ctx, &api.CreateApplicationRequest{
+				Name:            "new-app",
+				AppconfigPath:   "apps/new-app",
+				RepositoryURL:   repositoryURL(t, "https://github.com/tacokumo/new-app.git"),
+				AppconfigBranch: "main",
+			}, api.CreateApplicationParams{})
+
+			events := recorder.Events()
+			require.Len(t, events, 1)
+			assert.Equal(t, audit.ActionCreateApplication, events[0].Action)
+			assert.Equal(t, tt.expectedOutcome, events[0].Outcome)
+			assert.Equal(t, "request-id", events[0].RequestID)
+			assert.Equal(t, "42", events[0].Actor.UserID)
+			assert.Equal(t, tt.role, events[0].Actor.Role)
+			assert.Equal(t, audit.Target{Namespace: "portal-namespace", Application: "new-app"}, events[0].Target)
+		})
+	}
+}
+
+// newTestApplicationClient はexample-appとそのSecretが存在するclientを生成する
+func newTestApplicationClient(t *testing.T) (client.Client, string) {
+	t.Helper()
+
+	scheme, err := k8sclient.NewScheme()
+	require.NoError(t, err)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	app := &tacokumov1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example-app",
+			Namespace: "portal-namespace",
+		},
+		Spec: tacokumov1alpha1.ApplicationSpec{
+			ReleaseTemplate: tacokumov1alpha1.ReleaseSpec{
+				AppConfigPath:   "apps/example-app",
+				AppConfigBranch: "main",
+				Repo: tacokumov1alpha1.RepositoryRef{
+					URL: "https://github.com/tacokumo/example-app.git",
+				},
+			},
+		},
+	}
+	require.NoError(t, c.Create(t.Context(), app))
+	require.NoError(t, c.Create(t.Context(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example-app-secret",
+			Namespace: "portal-namespace",
+		},
+	}))
+	return c, app.ResourceVersion
+}
+
+func TestApplicationService_UpdateApplication(t *testing.T) {
+	t.Parallel()
+
+	t.Run("リリース設定を置き換えられること", func(t *testing.T) {
+		t.Parallel()
+
+		c, resourceVersion := newTestApplicationClient(t)
+		service := &ApplicationService{config: newTestConfig(), client: c}
+
+		ret, err := service.UpdateApplication(withRole(t.Context(), authz.RoleWriter), &api.UpdateApplicationRequest{
+			RepositoryURL:   repositoryURL(t, "https://github.com/tacokumo/renamed-app.git"),
+			AppconfigPath:   "apps/renamed-app",
+			AppconfigBranch: "release",
+			ResourceVersion: resourceVersion,
+		}, api.UpdateApplicationParams{Name: "example-app"})
+		require.NoError(t, err)
+		assert.Equal(t, "https://github.com/tacokumo/renamed-app.git", ret.RepositoryURL)
+		assert.Equal(t, "apps/renamed-app", ret.AppconfigPath)
+		assert.Equal(t, "release", ret.AppconfigBranch)
+		assert.NotEqual(t, resourceVersion, ret.ResourceVersion)
+
+		app := tacokumov1alpha1.Application{}
+		require.NoError(t, c.Get(t.Context(), client.ObjectKey{Namespace: "portal-namespace", Name: "example-app"}, &app))
+		assert.Equal(t, "release", app.Spec.ReleaseTemplate.AppConfigBranch)
+	})
+
+	tests := []struct {
+		name            string
+		appName         string
+		resourceVersion string
+		expected        error
+	}{
+		{
+			name:            "resource_versionが古い場合は409となること",
+			appName:         "example-app",
+			resourceVersion: "0",
+			expected:        errApplicationConflict,
+		},
+		{
+			name:     "resource_versionが空の場合は400となること",
+			appName:  "example-app",
+			expected: errResourceVersionRequired,
+		},
+		{
+			name:            "存在しないApplicationの場合は404となること",
+			appName:         "non-existent-app",
+			resourceVersion: "1",
+			expected:        errApplicationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, _ := newTestApplicationClient(t)
+			service := &ApplicationService{config: newTestConfig(), client: c}
+
+			_, err := service.UpdateApplication(withRole(t.Context(), authz.RoleWriter), &api.UpdateApplicationRequest{
+				RepositoryURL:   repositoryURL(t, "https://github.com/tacokumo/example-app.git"),
+				AppconfigPath:   "apps/example-app",
+				AppconfigBranch: "release",
+				ResourceVersion: tt.resourceVersion,
+			}, api.UpdateApplicationParams{Name: tt.appName})
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestApplicationService_PatchApplication(t *testing.T) {
+	t.Parallel()
+
+	t.Run("指定したフィールドのみ更新されること", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newTestApplicationClient(t)
+		service := &ApplicationService{config: newTestConfig(), client: c}
+
+		ret, err := service.PatchApplication(withRole(t.Context(), authz.RoleWriter), &api.PatchApplicationRequest{
+			AppconfigBranch: api.NewOptString("release"),
+		}, api.PatchApplicationParams{Name: "example-app"})
+		require.NoError(t, err)
+		assert.Equal(t, "release", ret.AppconfigBranch)
+		assert.Equal(t, "apps/example-app", ret.AppconfigPath)
+		assert.Equal(t, "https://github.com/tacokumo/example-app.git", ret.RepositoryURL)
+	})
+
+	t.Run("説明を設定･削除できること", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newTestApplicationClient(t)
+		service := &ApplicationService{config: newTestConfig(), client: c}
+		ctx := withRole(t.Context(), authz.RoleWriter)
+		params := api.PatchApplicationParams{Name: "example-app"}
+
+		ret, err := service.PatchApplication(ctx, &api.PatchApplicationRequest{
+			Description: api.NewOptNilString("tacokumo bot"),
+		}, params)
+		require.NoError(t, err)
+		assert.Equal(t, api.NewOptString("tacokumo bot"), ret.Description)
+		displayID := ret.DisplayID
+
+		// 説明を指定しない場合は変更されない
+		ret, err = service.PatchApplication(ctx, &api.PatchApplicationRequest{
+			AppconfigBranch: api.NewOptString("release"),
+		}, params)
+		require.NoError(t, err)
+		assert.Equal(t, api.NewOptString("tacokumo bot"), ret.Description)
+
+		// nullの場合は削除される
+		ret, err = service.PatchApplication(ctx, &api.PatchApplicationRequest{
+			Description: api.OptNilString{Set: true, Null: true},
+		}, params)
+		require.NoError(t, err)
+		assert.False(t, ret.Description.IsSet())
+		assert.Equal(t, displayID, ret.DisplayID)
+	})
+
+	t.Run("resource_versionが古い場合は409となること", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newTestApplicationClient(t)
+		service := &ApplicationService{config: newTestConfig(), client: c}
+
+		_, err := service.PatchApplication(withRole(t.Context(), authz.RoleWriter), &api.PatchApplicationRequest{
+			AppconfigBranch: api.NewOptString("release"),
+			ResourceVersion: api.NewOptString("0"),
+		}, api.PatchApplicationParams{Name: "example-app"})
+		assert.ErrorIs(t, err, errApplicationConflict)
+	})
+
+	t.Run("アクセスできないリポジトリには変更できないこと", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newTestApplicationClient(t)
+		service := &ApplicationService{config: newTestConfig(), client: c}
+		ctx := auth.WithIdentity(t.Context(), &auth.Identity{
+			UserID:       "installation:1",
+			Role:         authz.RoleWriter,
+			AuthMethod:   auth.AuthMethodInstallation,
+			Repositories: []string{"github.com/tacokumo/example-app"},
+		})
+
+		_, err := service.PatchApplication(ctx, &api.PatchApplicationRequest{
+			RepositoryURL: api.NewOptURI(repositoryURL(t, "https://github.com/tacokumo/other-app.git")),
+		}, api.PatchApplicationParams{Name: "example-app"})
+		assert.ErrorIs(t, err, errForbidden)
+	})
+}
+
+func TestApplicationService_DeleteApplication(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ApplicationとSecretが削除されること", func(t *testing.T) {
+		t.Parallel()
+
+		c, resourceVersion := newTestApplicationClient(t)
+		service := &ApplicationService{config: newTestConfig(), client: c}
+
+		err := service.DeleteApplication(withRole(t.Context(), authz.RoleWriter), api.DeleteApplicationParams{
+			Name:            "example-app",
+			ResourceVersion: resourceVersion,
+		})
+		require.NoError(t, err)
+
+		err = c.Get(t.Context(), client.ObjectKey{Namespace: "portal-namespace", Name: "example-app"}, &tacokumov1alpha1.Application{})
+		assert.True(t, apierrors.IsNotFound(err))
+		err = c.Get(t.Context(), client.ObjectKey{Namespace: "portal-namespace", Name: "example-app-secret"}, &corev1.Secret{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("PostgreSQLのSecretも削除されること", func(t *testing.T) {
+		t.Parallel()
+
+		c, resourceVersion := newTestApplicationClient(t)
+		vault := newTestVault(t)
+		_, err := vault.Create(t.Context(), "example-app-secret", map[string]string{"DB_PASSWORD": "secret123"})
+		require.NoError(t, err)
+		service := &ApplicationService{config: newTestConfig(), client: c, secrets: vault}
+
+		err = service.DeleteApplication(withRole(t.Context(), authz.RoleWriter), api.DeleteApplicationParams{
+			Name:            "example-app",
+			ResourceVersion: resourceVersion,
+		})
+		require.NoError(t, err)
+
+		_, err = vault.Get(t.Context(), "example-app-secret")
+		assert.ErrorIs(t, err, secret.ErrSecretNotFound)
+	})
+
+	t.Run("Secretが存在しない場合も削除できること", func(t *testing.T) {
+		t.Parallel()
+
+		c, resourceVersion := newTestApplicationClient(t)
+		require.NoError(t, c.Delete(t.Context(), &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "example-app-secret", Namespace: "portal-namespace"},
+		}))
+		service := &ApplicationService{config: newTestConfig(), client: c}
+
+		err := service.DeleteApplication(withRole(t.Context(), authz.RoleWriter), api.DeleteApplicationParams{
+			Name:            "example-app",
+			ResourceVersion: resourceVersion,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("resource_versionが古い場合は409となり何も削除されないこと", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newTestApplicationClient(t)
+		service := &ApplicationService{config: newTestConfig(), client: c}
+
+		err := service.DeleteApplication(withRole(t.Context(), authz.RoleWriter), api.DeleteApplicationParams{
+			Name:            "example-app",
+			ResourceVersion: "0",
+		})
+		assert.ErrorIs(t, err, errApplicationConflict)
+
+		assert.NoError(t, c.Get(t.Context(), client.ObjectKey{Namespace: "portal-namespace", Name: "example-app"}, &tacokumov1alpha1.Application{}))
+		assert.NoError(t, c.Get(t.Context(), client.ObjectKey{Namespace: "portal-namespace", Name: "example-app-secret"}, &corev1.Secret{}))
+	})
+
+	t.Run("存在しないApplicationの場合は404となること", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newTestApplicationClient(t)
+		service := &ApplicationService{config: newTestConfig(), client: c}
+
+		err := service.DeleteApplication(withRole(t.Context(), authz.RoleWriter), api.DeleteApplicationParams{
+			Name:            "non-existent-app",
+			ResourceVersion: "1",
+		})
+		assert.ErrorIs(t, err, errApplicationNotFound)
+	})
+
+	t.Run("resource_versionが空の場合は400となり何も削除されないこと", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newTestApplicationClient(t)
+		service := &ApplicationService{config: newTestConfig(), client: c}
+
+		err := service.DeleteApplication(withRole(t.Context(), authz.RoleWriter), api.DeleteApplicationParams{Name: "example-app"})
+		assert.ErrorIs(t, err, errResourceVersionRequired)
+
+		assert.NoError(t, c.Get(t.Context(), client.ObjectKey{Namespace: "portal-namespace", Name: "example-app"}, &tacokumov1alpha1.Application{}))
+		assert.NoError(t, c.Get(t.Context(), client.ObjectKey{Namespace: "portal-namespace", Name: "example-app-secret"}, &corev1.Secret{}))
+	})
+
+	t.Run("Applicationの削除に失敗してもSecretは削除済みで再試行できること", func(t *testing.T) {
+		t.Parallel()
+
+		c, resourceVersion := newTestApplicationClient(t)
+		failing := interceptor.NewClient(c.(client.WithWatch), interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				if _, ok := obj.(*tacokumov1alpha1.Application); ok {
+					return errors.New("connection refused")
+				}
+				return c.Delete(ctx, obj, opts...)
+			},
+		})
+		service := &ApplicationService{config: newTestConfig(), client: failing}
+
+		params := api.DeleteApplicationParams{Name: "example-app", ResourceVersion: resourceVersion}
+		err := service.DeleteApplication(withRole(t.Context(), authz.RoleWriter), params)
+		assert.Error(t, err)
+		err = c.Get(t.Context(), client.ObjectKey{Namespace: "portal-namespace", Name: "example-app-secret"}, &corev1.Secret{})
+		assert.True(t, apierrors.IsNotFound(err))
+
+		service.client = c
+		assert.NoError(t, service.DeleteApplication(withRole(t.Context(), authz.RoleWriter), params))
+		err = c.Get(t.Context(), client.ObjectKey{Namespace: "portal-namespace", Name: "example-app"}, &tacokumov1alpha1.Application{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+}
@@ -0,0 +1,235 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestToAPIApplication(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		app      *tacokumov1alpha1.Application
+		expected api.Application
+	}{
+		{
+			name: "アノテーションから表示用IDと説明を返すこと",
+			app: &tacokumov1alpha1.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "example-app",
+					UID:             "0f8fad5b-d9cb-469f-a165-70867728950e",
+					ResourceVersion: "10",
+					Annotations: map[string]string{
+						annotationDisplayID:   "app-abcdefgh",
+						annotationDescription: "tacokumo bot",
+					},
+				},
+				Spec: tacokumov1alpha1.ApplicationSpec{
+					ReleaseTemplate: tacokumov1alpha1.ReleaseSpec{
+						AppConfigPath:   "apps/example-app",
+						AppConfigBranch: "main",
+						Repo: tacokumov1alpha1.RepositoryRef{
+							URL: "https://github.com/tacokumo/example-app.git",
+						},
+					},
+				},
+			},
+			expected: api.Application{
+				ID:              "0f8fad5b-d9cb-469f-a165-70867728950e",
+				Name:            "example-app",
+				DisplayID:       "app-abcdefgh",
+				Description:     api.NewOptString("tacokumo bot"),
+				RepositoryURL:   "https://github.com/tacokumo/example-app.git",
+				AppconfigPath:   "apps/example-app",
+				AppconfigBranch: "main",
+				ResourceVersion: "10",
+				Status:          api.ApplicationStatus{CurrentReleases: []string{}, Conditions: []api.Condition{}},
+			},
+		},
+		{
+			name: "アノテーションがない場合は表示用IDをUIDから導出すること",
+			app: &tacokumov1alpha1.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "example-app",
+					UID:             "0f8fad5b-d9cb-469f-a165-70867728950e",
+					ResourceVersion: "10",
+				},
+			},
+			expected: api.Application{
+				ID:              "0f8fad5b-d9cb-469f-a165-70867728950e",
+				Name:            "example-app",
+				DisplayID:       "app-0f8fad5b",
+				ResourceVersion: "10",
+				Status:          api.ApplicationStatus{CurrentReleases: []string{}, Conditions: []api.Condition{}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, toAPIApplication(tt.app))
+		})
+	}
+}
+
+func TestToAPIApplicationStatus(t *testing.T) {
+	t.Parallel()
+
+	transitionTime := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	readyCondition := func(status metav1.ConditionStatus, generation int64, reason, message string) metav1.Condition {
+		return metav1.Condition{
+			Type:               tacokumov1alpha1.ConditionTypeReady,
+			Status:             status,
+			ObservedGeneration: generation,
+			Reason:             reason,
+			Message:            message,
+			LastTransitionTime: transitionTime,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		generation int64
+		status     tacokumov1alpha1.ApplicationStatus
+		expected   api.ApplicationStatus
+	}{
+		{
+			name:       "コントローラーが調整していない場合は空の状態となること",
+			generation: 1,
+			expected:   api.ApplicationStatus{CurrentReleases: []string{}, Conditions: []api.Condition{}},
+		},
+		{
+			name:       "作成時の世代がRunningの場合はreadyとなること",
+			generation: 1,
+			status: tacokumov1alpha1.ApplicationStatus{
+				State: tacokumov1alpha1.ApplicationStateRunning,
+				Releases: []corev1.ObjectReference{
+					{Name: "example-app-staging"},
+					{Name: "example-app-production"},
+				},
+			},
+			expected: api.ApplicationStatus{
+				State:              tacokumov1alpha1.ApplicationStateRunning,
+				Ready:              true,
+				ObservedGeneration: 1,
+				CurrentReleases:    []string{"example-app-staging", "example-app-production"},
+				Conditions:         []api.Condition{},
+			},
+		},
+		{
+			name:       "Runningになる前はreadyとならないこと",
+			generation: 1,
+			status: tacokumov1alpha1.ApplicationStatus{
+				State: tacokumov1alpha1.ApplicationStateWaiting,
+			},
+			expected: api.ApplicationStatus{
+				State:              tacokumov1alpha1.ApplicationStateWaiting,
+				ObservedGeneration: 1,
+				CurrentReleases:    []string{},
+				Conditions:         []api.Condition{},
+			},
+		},
+		{
+			name:       "Runningになった後に更新された場合はreadyとならないこと",
+			generation: 2,
+			status: tacokumov1alpha1.ApplicationStatus{
+				State: tacokumov1alpha1.ApplicationStateRunning,
+			},
+			expected: api.ApplicationStatus{
+				State:              tacokumov1alpha1.ApplicationStateRunning,
+				ObservedGeneration: 1,
+				CurrentReleases:    []string{},
+				Conditions:         []api.Condition{},
+			},
+		},
+		{
+			name:       "調整に失敗している場合はエラーメッセージを返すこと",
+			generation: 1,
+			status: tacokumov1alpha1.ApplicationStatus{
+				State: tacokumov1alpha1.ApplicationStateError,
+				Conditions: []metav1.Condition{
+					readyCondition(metav1.ConditionFalse, 1, tacokumov1alpha1.ReasonReconcileError, "appconfig.yaml not found"),
+				},
+			},
+			expected: api.ApplicationStatus{
+				State:              tacokumov1alpha1.ApplicationStateError,
+				ObservedGeneration: 1,
+				CurrentReleases:    []string{},
+				LastError:          api.NewOptString("appconfig.yaml not found"),
+				Conditions: []api.Condition{
+					{
+						Type:               tacokumov1alpha1.ConditionTypeReady,
+						Status:             "False",
+						ObservedGeneration: 1,
+						Reason:             tacokumov1alpha1.ReasonReconcileError,
+						Message:            "appconfig.yaml not found",
+						LastTransitionTime: transitionTime.Time,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := &tacokumov1alpha1.Application{
+				ObjectMeta: metav1.ObjectMeta{Generation: tt.generation},
+				Status:     tt.status,
+			}
+			assert.Equal(t, tt.expected, toAPIApplicationStatus(app))
+		})
+	}
+}
+
+func TestNewDisplayID(t *testing.T) {
+	t.Parallel()
+
+	seen := map[string]struct{}{}
+	for range 100 {
+		id := newDisplayID()
+		assert.Regexp(t, `^app-[2-9a-hjkmnp-z]{8}$`, id)
+		seen[id] = struct{}{}
+	}
+	assert.Len(t, seen, 100)
+}
+
+func TestEnsureDisplayID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("既存の表示用IDは変更しないこと", func(t *testing.T) {
+		t.Parallel()
+
+		app := &tacokumov1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				UID:         "0f8fad5b-d9cb-469f-a165-70867728950e",
+				Annotations: map[string]string{annotationDisplayID: "app-abcdefgh"},
+			},
+		}
+		ensureDisplayID(app)
+		assert.Equal(t, "app-abcdefgh", app.Annotations[annotationDisplayID])
+	})
+
+	t.Run("UIDを持つ場合はレスポンスと同じ表示用IDを保存すること", func(t *testing.T) {
+		t.Parallel()
+
+		app := &tacokumov1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				UID: "0f8fad5b-d9cb-469f-a165-70867728950e",
+			},
+		}
+		before := displayID(app)
+		ensureDisplayID(app)
+		assert.Equal(t, before, app.Annotations[annotationDisplayID])
+	})
+}
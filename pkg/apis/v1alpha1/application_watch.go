@@ -0,0 +1,292 @@
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/authz"
+	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// watchHeartbeatInterval はイベントがない間に接続を維持するためのコメント行を送る間隔
+	// ロードバランサーのアイドルタイムアウトより短くする
+	watchHeartbeatInterval = 15 * time.Second
+	// watchEventBufferSize は送信待ちにできるイベントの数
+	// クライアントの受信が追いつかない場合はKubernetesからの受信を待たせる
+	watchEventBufferSize = 16
+)
+
+// WatchApplicationsのイベントの種類
+const (
+	watchEventAdded    = "ADDED"
+	watchEventModified = "MODIFIED"
+	watchEventDeleted  = "DELETED"
+	watchEventBookmark = "BOOKMARK"
+	watchEventError    = "ERROR"
+)
+
+var errWatchNotSupported = &ErrorWithCode{
+	Code:    http.StatusServiceUnavailable,
+	Message: "watching applications is not supported",
+}
+
+// watchBookmark はBOOKMARKイベントのdata
+type watchBookmark struct {
+	ResourceVersion string `json:"resource_version"`
+}
+
+// WatchApplications はポータルのnamespaceのApplicationの変更をServer-Sent Eventsで返す
+// 接続中も各イベントのApplicationを呼び出し元が参照できるかを確認し､参照できないApplicationの変更は送らない
+func (s *ApplicationService) WatchApplications(
+	ctx context.Context,
+	params api.WatchApplicationsParams,
+) (api.WatchApplicationsOK, error) {
+	if err := authorize(ctx, authz.RoleViewer); err != nil {
+		return api.WatchApplicationsOK{}, err
+	}
+	watcher, ok := s.client.(client.WithWatch)
+	if !ok {
+		return api.WatchApplicationsOK{}, errWatchNotSupported
+	}
+	// EventSourceは再接続時にクエリを変えられないため､Last-Event-IDでも再開できるようにする
+	resourceVersion := params.ResourceVersion.Or(params.LastEventID.Or(""))
+
+	// レスポンスの書き込みはこのメソッドから戻った後に行われるため､ストリームの終了はCloseで行う
+	streamCtx, cancel := context.WithCancel(ctx)
+	heartbeatInterval := watchHeartbeatInterval
+	if s.heartbeatInterval > 0 {
+		heartbeatInterval = s.heartbeatInterval
+	}
+	stream := &applicationWatchStream{
+		resourceVersion:     resourceVersion,
+		sentResourceVersion: resourceVersion,
+		heartbeatInterval:   heartbeatInterval,
+		revocations:         s.revocations,
+		visible:             map[string]bool{},
+		events:              make(chan []byte, watchEventBufferSize),
+	}
+	if err := s.seedVisibleApplications(streamCtx, stream); err != nil {
+		cancel()
+		return api.WatchApplicationsOK{}, err
+	}
+	w, err := watcher.Watch(streamCtx, &tacokumov1alpha1.ApplicationList{}, &client.ListOptions{
+		Namespace: s.config.PortalName,
+		Raw: &metav1.ListOptions{
+			ResourceVersion:     resourceVersion,
+			AllowWatchBookmarks: true,
+		},
+	})
+	if err != nil {
+		cancel()
+		return api.WatchApplicationsOK{}, err
+	}
+
+	go stream.run(streamCtx, w)
+	return api.WatchApplicationsOK{
+		Data: &eventStreamReader{
+			events: stream.events,
+			flush:  flusherFromContext(ctx),
+			cancel: cancel,
+		},
+	}, nil
+}
+
+// seedVisibleApplications は途中から再開する場合に､クライアントが既に知っているApplicationを求める
+// Installation Access Tokenでは参照できなくなったApplicationをDELETEDとして送る必要があるため､
+// 現在参照できるApplicationをクライアントが知っているものとみなす
+func (s *ApplicationService) seedVisibleApplications(ctx context.Context, stream *applicationWatchStream) error {
+	identity, ok := auth.IdentityFromContext(ctx)
+	if stream.resourceVersion == "" || !ok || !identity.RepositoryScoped() {
+		return nil
+	}
+	appList := tacokumov1alpha1.ApplicationList{}
+	if err := s.client.List(ctx, &appList, client.InNamespace(s.config.PortalName)); err != nil {
+		return err
+	}
+	for i := range appList.Items {
+		if authorizeRepository(ctx, appList.Items[i].Spec.ReleaseTemplate.Repo.URL) == nil {
+			stream.visible[appList.Items[i].Name] = true
+		}
+	}
+	return nil
+}
+
+// applicationWatchStream はKubernetesのwatchのイベントをクライアントに送るイベントに変換する
+type applicationWatchStream struct {
+	// resourceVersion はKubernetesから最後に受け取ったイベントのresourceVersion
+	resourceVersion string
+	// sentResourceVersion はクライアントに最後に送ったイベントのid
+	sentResourceVersion string
+	// visible はクライアントに送ったApplicationの名前
+	// 参照できなくなったApplicationのみをDELETEDとして送るために使う
+	visible           map[string]bool
+	heartbeatInterval time.Duration
+	// revocations はハートビートごとにアクセストークンの失効を確認するためのもの｡nilの場合は確認しない
+	revocations auth.RevocationList
+	events      chan []byte
+}
+
+// run はwの終了､アクセストークンの有効期限切れや失効､またはctxのキャンセルまでイベントを送る
+// 終了時はeventsを閉じ､クライアントへのレスポンスを終える
+func (st *applicationWatchStream) run(ctx context.Context, w watch.Interface) {
+	defer close(st.events)
+	defer w.Stop()
+
+	heartbeat := time.NewTicker(st.heartbeatInterval)
+	defer heartbeat.Stop()
+	var expired <-chan time.Time
+	if identity, ok := auth.IdentityFromContext(ctx); ok && !identity.ExpiresAt.IsZero() {
+		timer := time.NewTimer(time.Until(identity.ExpiresAt))
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-expired:
+			st.sendError(ctx, api.Error{Code: ErrorCodeUnauthenticated, Message: "access token expired"})
+			return
+		case <-heartbeat.C:
+			if !st.checkRevocation(ctx) {
+				return
+			}
+			// 参照できないApplicationのイベントを読み飛ばした場合も再開位置を進められるように､BOOKMARKで代用する
+			if st.resourceVersion != st.sentResourceVersion {
+				st.sendBookmark(ctx)
+			} else {
+				st.send(ctx, eventStreamHeartbeat)
+			}
+		case event, ok := <-w.ResultChan():
+			// APIサーバーがwatchを終了した場合､クライアントは最後のidから再接続する
+			if !ok {
+				return
+			}
+			if !st.handle(ctx, event) {
+				return
+			}
+		}
+	}
+}
+
+// checkRevocation は接続後にアクセストークンが失効していないかを確認し､ストリームを続ける場合はtrueを返す
+// 失効している場合､または確認できなかった場合はERRORイベントを送る
+// 確認できなかった場合もSecurityHandlerと同様に拒否し､クライアントの再接続時に改めて認証させる
+func (st *applicationWatchStream) checkRevocation(ctx context.Context) bool {
+	identity, ok := auth.IdentityFromContext(ctx)
+	if st.revocations == nil || !ok {
+		return true
+	}
+	revoked, err := auth.IsIdentityRevoked(ctx, st.revocations, identity)
+	if err != nil {
+		st.sendError(ctx, api.Error{Code: ErrorCodeUnknown, Message: "internal server error"})
+		return false
+	}
+	if revoked {
+		st.sendError(ctx, api.Error{Code: ErrorCodeUnauthenticated, Message: "access token revoked"})
+		return false
+	}
+	return true
+}
+
+// handle はeventをクライアントに送り､ストリームを続ける場合はtrueを返す
+func (st *applicationWatchStream) handle(ctx context.Context, event watch.Event) bool {
+	if event.Type == watch.Error {
+		st.sendError(ctx, toWatchError(event.Object))
+		return false
+	}
+	app, ok := event.Object.(*tacokumov1alpha1.Application)
+	if !ok {
+		return true
+	}
+	st.resourceVersion = app.ResourceVersion
+
+	if event.Type == watch.Bookmark {
+		st.sendBookmark(ctx)
+		return true
+	}
+
+	eventType := watchEventType(event.Type)
+	if authorizeRepository(ctx, app.Spec.ReleaseTemplate.Repo.URL) != nil {
+		// 送ったことのないApplicationの存在はクライアントに知らせない
+		if !st.visible[app.Name] {
+			return true
+		}
+		eventType = watchEventDeleted
+	}
+	if eventType == watchEventDeleted {
+		delete(st.visible, app.Name)
+	} else {
+		st.visible[app.Name] = true
+	}
+
+	ret := toAPIApplication(app)
+	data, err := ret.MarshalJSON()
+	if err != nil {
+		st.sendError(ctx, api.Error{Code: ErrorCodeUnknown, Message: "internal server error"})
+		return false
+	}
+	if st.send(ctx, formatEvent(eventType, app.ResourceVersion, data)) {
+		st.sentResourceVersion = app.ResourceVersion
+	}
+	return true
+}
+
+func (st *applicationWatchStream) sendBookmark(ctx context.Context) {
+	data, _ := json.Marshal(watchBookmark{ResourceVersion: st.resourceVersion})
+	if st.send(ctx, formatEvent(watchEventBookmark, st.resourceVersion, data)) {
+		st.sentResourceVersion = st.resourceVersion
+	}
+}
+
+func (st *applicationWatchStream) sendError(ctx context.Context, e api.Error) {
+	data, err := e.MarshalJSON()
+	if err != nil {
+		return
+	}
+	st.send(ctx, formatEvent(watchEventError, "", data))
+}
+
+// send はクライアントへのイベントの送信を待ち､送信できなかった場合はfalseを返す
+func (st *applicationWatchStream) send(ctx context.Context, event []byte) bool {
+	select {
+	case st.events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func watchEventType(t watch.EventType) string {
+	switch t {
+	case watch.Added:
+		return watchEventAdded
+	case watch.Deleted:
+		return watchEventDeleted
+	default:
+		return watchEventModified
+	}
+}
+
+// toWatchError はwatchのエラーイベントをERRORイベントのdataに変換する
+func toWatchError(obj runtime.Object) api.Error {
+	status, ok := obj.(*metav1.Status)
+	if !ok {
+		return api.Error{Code: ErrorCodeUnknown, Message: "internal server error"}
+	}
+	if ret, ok := fromKubernetesError(apierrors.FromObject(status)); ok {
+		return ret.Response
+	}
+	return api.Error{Code: ErrorCodeUnknown, Message: "internal server error"}
+}
@@ -0,0 +1,344 @@
+package v1alpha1
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/authz"
+	"github.com/tacokumo/portal-api/pkg/k8sclient"
+	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+type sseEvent struct {
+	event string
+	id    string
+	data  string
+}
+
+// readEvent はServer-Sent Eventsのイベントを1件読み出す
+// コメント行のみのブロックはevent､id､dataが空のイベントとして返し､ストリームが終了した場合はfalseを返す
+func readEvent(t *testing.T, r *bufio.Reader) (sseEvent, bool) {
+	t.Helper()
+
+	ret := sseEvent{}
+	read := false
+	for {
+		line, err := r.ReadString('\n')
+		if err == io.EOF {
+			return ret, false
+		}
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			if read {
+				return ret, true
+			}
+			continue
+		}
+		read = true
+		field, value, _ := strings.Cut(line, ": ")
+		switch field {
+		case "event":
+			ret.event = value
+		case "id":
+			ret.id = value
+		case "data":
+			ret.data = value
+		}
+	}
+}
+
+// newTestWatchService はwatcherのイベントを返すclientを持つApplicationServiceを生成する
+// watchのresource_versionはwatchOptsに記録する
+func newTestWatchService(t *testing.T, watcher watch.Interface, watchOpts *client.ListOptions) *ApplicationService {
+	t.Helper()
+
+	scheme, err := k8sclient.NewScheme()
+	require.NoError(t, err)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Watch: func(_ context.Context, _ client.WithWatch, _ client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+				if watchOpts != nil {
+					watchOpts.ApplyOptions(opts)
+				}
+				return watcher, nil
+			},
+		}).
+		Build()
+	return &ApplicationService{
+		config:            newTestConfig(),
+		client:            c,
+		heartbeatInterval: 10 * time.Millisecond,
+	}
+}
+
+func newTestWatchApplication(name, resourceVersion string) *tacokumov1alpha1.Application {
+	return &tacokumov1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "portal-namespace",
+			ResourceVersion: resourceVersion,
+		},
+		Spec: tacokumov1alpha1.ApplicationSpec{
+			ReleaseTemplate: tacokumov1alpha1.ReleaseSpec{
+				Repo: tacokumov1alpha1.RepositoryRef{URL: "https://github.com/tacokumo/" + name + ".git"},
+			},
+		},
+	}
+}
+
+// readUntilEnd はハートビート以外のイベントをストリームの終了まで読み出す
+func readUntilEnd(t *testing.T, data io.Reader) []sseEvent {
+	t.Helper()
+
+	r := bufio.NewReader(data)
+	events := []sseEvent{}
+	for {
+		event, ok := readEvent(t, r)
+		if !ok {
+			return events
+		}
+		if event.event != "" {
+			events = append(events, event)
+		}
+	}
+}
+
+func TestApplicationService_WatchApplications(t *testing.T) {
+	t.Parallel()
+
+	watcher := watch.NewFakeWithChanSize(10, false)
+	watchOpts := &client.ListOptions{}
+	service := newTestWatchService(t, watcher, watchOpts)
+
+	ret, err := service.WatchApplications(withRole(t.Context(), authz.RoleViewer), api.WatchApplicationsParams{
+		LastEventID: api.NewOptString("10"),
+	})
+	require.NoError(t, err)
+	defer ret.Data.(io.Closer).Close()
+
+	assert.Equal(t, "portal-namespace", watchOpts.Namespace)
+	assert.Equal(t, "10", watchOpts.Raw.ResourceVersion)
+	assert.True(t, watchOpts.Raw.AllowWatchBookmarks)
+
+	watcher.Add(newTestWatchApplication("example-app", "11"))
+	watcher.Modify(newTestWatchApplication("example-app", "12"))
+	watcher.Action(watch.Bookmark, &tacokumov1alpha1.Application{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "13"}})
+	watcher.Delete(newTestWatchApplication("example-app", "14"))
+	watcher.Error(&apierrors.NewResourceExpired("too old resource version").ErrStatus)
+
+	events := readUntilEnd(t, ret.Data)
+	require.Len(t, events, 5)
+	for i, expected := range []sseEvent{
+		{event: "ADDED", id: "11"},
+		{event: "MODIFIED", id: "12"},
+		{event: "BOOKMARK", id: "13", data: `{"resource_version":"13"}`},
+		{event: "DELETED", id: "14"},
+		{event: "ERROR"},
+	} {
+		assert.Equal(t, expected.event, events[i].event)
+		assert.Equal(t, expected.id, events[i].id)
+		if expected.data != "" {
+			assert.JSONEq(t, expected.data, events[i].data)
+		}
+	}
+
+	app := api.Application{}
+	require.NoError(t, json.Unmarshal([]byte(events[1].data), &app))
+	assert.Equal(t, "example-app", app.Name)
+	assert.Equal(t, "12", app.ResourceVersion)
+
+	apiErr := api.Error{}
+	require.NoError(t, json.Unmarshal([]byte(events[4].data), &apiErr))
+	assert.Equal(t, int32(ErrorCodeResourceVersionExpired), apiErr.Code)
+}
+
+func TestApplicationService_WatchApplications_リポジトリ単位の認可(t *testing.T) {
+	t.Parallel()
+
+	watcher := watch.NewFakeWithChanSize(10, false)
+	service := newTestWatchService(t, watcher, nil)
+	ctx := auth.WithIdentity(t.Context(), &auth.Identity{
+		UserID:       "installation:1",
+		Role:         authz.RoleViewer,
+		AuthMethod:   auth.AuthMethodInstallation,
+		Repositories: []string{"github.com/tacokumo/example-app"},
+	})
+
+	ret, err := service.WatchApplications(ctx, api.WatchApplicationsParams{})
+	require.NoError(t, err)
+	defer ret.Data.(io.Closer).Close()
+	r := bufio.NewReader(ret.Data)
+
+	watcher.Add(newTestWatchApplication("other-app", "1"))
+	watcher.Add(newTestWatchApplication("example-app", "2"))
+	moved := newTestWatchApplication("example-app", "3")
+	moved.Spec.ReleaseTemplate.Repo.URL = "https://github.com/tacokumo/other-app.git"
+	watcher.Modify(moved)
+	watcher.Modify(newTestWatchApplication("other-app", "4"))
+
+	// ハートビートによるBOOKMARKは送られるタイミングが定まらないため読み飛ばす
+	next := func() sseEvent {
+		for {
+			event, ok := readEvent(t, r)
+			require.True(t, ok)
+			if event.event != "" && event.event != "BOOKMARK" {
+				return event
+			}
+		}
+	}
+
+	// 参照できないother-appのイベントは送らないこと
+	event := next()
+	assert.Equal(t, "ADDED", event.event)
+	assert.Equal(t, "2", event.id)
+
+	// 参照できなくなったApplicationはDELETEDとして送ること
+	event = next()
+	assert.Equal(t, "DELETED", event.event)
+	assert.Equal(t, "3", event.id)
+
+	// 読み飛ばしたイベントの位置はBOOKMARKで送ること
+	for {
+		event, ok := readEvent(t, r)
+		require.True(t, ok)
+		if event.event == "BOOKMARK" && event.id == "4" {
+			return
+		}
+	}
+}
+
+func TestApplicationService_WatchApplications_トークンの有効期限(t *testing.T) {
+	t.Parallel()
+
+	service := newTestWatchService(t, watch.NewFake(), nil)
+	ctx := auth.WithIdentity(t.Context(), &auth.Identity{
+		UserID:    "user-1",
+		Role:      authz.RoleViewer,
+		ExpiresAt: time.Now().Add(50 * time.Millisecond),
+	})
+
+	ret, err := service.WatchApplications(ctx, api.WatchApplicationsParams{})
+	require.NoError(t, err)
+	defer ret.Data.(io.Closer).Close()
+
+	events := readUntilEnd(t, ret.Data)
+	require.Len(t, events, 1)
+	assert.Equal(t, "ERROR", events[0].event)
+	apiErr := api.Error{}
+	require.NoError(t, json.Unmarshal([]byte(events[0].data), &apiErr))
+	assert.Equal(t, int32(ErrorCodeUnauthenticated), apiErr.Code)
+}
+
+func TestApplicationService_WatchApplications_トークンの失効(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		revoke func(ctx context.Context, l auth.RevocationList) error
+	}{
+		{
+			name: "セッションを失効させた場合はERRORを送って終了すること",
+			revoke: func(ctx context.Context, l auth.RevocationList) error {
+				return l.RevokeSession(ctx, "session-1", time.Hour)
+			},
+		},
+		{
+			name: "アクセストークンを失効させた場合はERRORを送って終了すること",
+			revoke: func(ctx context.Context, l auth.RevocationList) error {
+				return l.RevokeToken(ctx, "jti-1", time.Hour)
+			},
+		},
+		{
+			name: "全てのトークンを失効させた場合はERRORを送って終了すること",
+			revoke: func(ctx context.Context, l auth.RevocationList) error {
+				return l.RevokeIssuedBefore(ctx, time.Now().Add(time.Second))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			revocations := auth.NewMemoryRevocationList()
+			service := newTestWatchService(t, watch.NewFake(), nil)
+			service.revocations = revocations
+			ctx := auth.WithIdentity(t.Context(), &auth.Identity{
+				UserID:    "user-1",
+				Role:      authz.RoleViewer,
+				SessionID: "session-1",
+				TokenID:   "jti-1",
+				IssuedAt:  time.Now().Add(-time.Minute),
+				ExpiresAt: time.Now().Add(time.Hour),
+			})
+
+			ret, err := service.WatchApplications(ctx, api.WatchApplicationsParams{})
+			require.NoError(t, err)
+			defer ret.Data.(io.Closer).Close()
+			require.NoError(t, tt.revoke(t.Context(), revocations))
+
+			events := readUntilEnd(t, ret.Data)
+			require.Len(t, events, 1)
+			assert.Equal(t, "ERROR", events[0].event)
+			apiErr := api.Error{}
+			require.NoError(t, json.Unmarshal([]byte(events[0].data), &apiErr))
+			assert.Equal(t, int32(ErrorCodeUnauthenticated), apiErr.Code)
+		})
+	}
+}
+
+func TestApplicationService_WatchApplications_HTTP(t *testing.T) {
+	t.Parallel()
+
+	watcher := watch.NewFakeWithChanSize(10, false)
+	tokens := newTestTokenService(t)
+	handler := NewHandler(newTestConfig(), nil, nil, nil, nil, NewAuthService(nil, nil, nil, nil, tokens, nil, nil, nil), NewAdminService(nil, nil, nil, nil, nil), nil, nil)
+	handler.ApplicationService = newTestWatchService(t, watcher, nil)
+	srv, err := api.NewServer(handler, NewSecurityHandler(tokens, nil, nil), api.WithErrorHandler(handler.HandleError))
+	require.NoError(t, err)
+	ts := httptest.NewServer(StreamingMiddleware(srv))
+	t.Cleanup(ts.Close)
+
+	issued, err := tokens.IssueAccessToken(auth.TokenSubject{UserID: "42", Role: authz.RoleViewer})
+	require.NoError(t, err)
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, ts.URL+"/v1alpha1/applications/watch", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+issued.Token)
+	resp, err := ts.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	// イベントごとにフラッシュされ､ストリームの途中でもイベントを受け取れること
+	watcher.Add(newTestWatchApplication("example-app", "1"))
+	r := bufio.NewReader(resp.Body)
+	for {
+		event, ok := readEvent(t, r)
+		require.True(t, ok)
+		if event.event != "" {
+			assert.Equal(t, "ADDED", event.event)
+			assert.Equal(t, "1", event.id)
+			return
+		}
+	}
+}
@@ -0,0 +1,335 @@
+package v1alpha1
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/audit"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/authz"
+	"github.com/tacokumo/portal-api/pkg/session"
+)
+
+type AuthService struct {
+	oauth         *auth.OAuth
+	pats          *auth.PATVerifier
+	installations *auth.InstallationVerifier
+	roles         *authz.RoleResolver
+	tokens        *auth.TokenService
+	sessions      *session.Manager
+	revocations   auth.RevocationList
+	audits        *audit.Logger
+}
+
+// NewAuthService はAuthServiceを生成する
+// 引数がnilの場合は認証機能が無効であり､各APIは503を返す
+func NewAuthService(
+	oauth *auth.OAuth,
+	pats *auth.PATVerifier,
+	installations *auth.InstallationVerifier,
+	roles *authz.RoleResolver,
+	tokens *auth.TokenService,
+	sessions *session.Manager,
+	revocations auth.RevocationList,
+	audits *audit.Logger,
+) *AuthService {
+	return &AuthService{
+		oauth:         oauth,
+		pats:          pats,
+		installations: installations,
+		roles:         roles,
+		tokens:        tokens,
+		sessions:      sessions,
+		revocations:   revocations,
+		audits:        audits,
+	}
+}
+
+func (s *AuthService) GetAuthGitHubLogin(ctx context.Context) (*api.GetAuthGitHubLoginFound, error) {
+	if s.oauth == nil {
+		return nil, errAuthNotConfigured
+	}
+
+	authURL, err := s.oauth.AuthCodeURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetAuthGitHubLoginFound{
+		Location: authURL,
+	}, nil
+}
+
+func (s *AuthService) GetAuthGitHubCallback(ctx context.Context, params api.GetAuthGitHubCallbackParams) (_ *api.AuthResult, err error) {
+	actor := audit.Actor{AuthMethod: auth.AuthMethodOAuth}
+	defer func() { s.audits.Log(ctx, &audit.Event{Action: audit.ActionLogin, Actor: actor}, err) }()
+
+	if s.oauth == nil || s.roles == nil || s.tokens == nil || s.sessions == nil {
+		return nil, errAuthNotConfigured
+	}
+
+	result, err := s.oauth.HandleCallback(ctx, auth.CallbackRequest{
+		Code:  params.Code.Value,
+		State: params.State.Value,
+		Error: params.Error.Value,
+	})
+	if err != nil {
+		return nil, toAuthError(err)
+	}
+
+	userID := strconv.FormatInt(result.User.ID, 10)
+	actor.UserID = userID
+	role, err := s.roles.Resolve(ctx, userID, result.Token.AccessToken)
+	if err != nil {
+		return nil, toAuthError(err)
+	}
+	actor.Role = role
+
+	sess, refreshToken, err := s.sessions.Create(ctx, userID, role, auth.AuthMethodOAuth)
+	if err != nil {
+		return nil, err
+	}
+	actor.SessionID = sess.ID
+	issued, err := s.issueAccessToken(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.AuthResult{
+		User: api.GitHubUser{
+			ID:    result.User.ID,
+			Login: result.User.Login,
+			Name:  api.NewOptString(result.User.Name),
+		},
+		AccessToken:  issued.Token,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(s.tokens.AccessTokenDuration().Seconds()),
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+func (s *AuthService) RefreshToken(ctx context.Context, req *api.RefreshTokenRequest) (_ *api.TokenResponse, err error) {
+	actor := audit.Actor{}
+	defer func() { s.audits.Log(ctx, &audit.Event{Action: audit.ActionRefreshToken, Actor: actor}, err) }()
+
+	if s.tokens == nil || s.sessions == nil {
+		return nil, errAuthNotConfigured
+	}
+
+	sess, refreshToken, err := s.sessions.Refresh(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, toAuthError(err)
+	}
+	actor = sessionActor(sess)
+	if err := s.checkSessionRevoked(ctx, sess); err != nil {
+		return nil, toAuthError(err)
+	}
+	issued, err := s.issueAccessToken(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.TokenResponse{
+		AccessToken:  issued.Token,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(s.tokens.AccessTokenDuration().Seconds()),
+		RefreshToken: api.NewOptString(refreshToken),
+	}, nil
+}
+
+// ExchangePersonalAccessToken はCLI向けにPATをアクセストークンに交換する
+// PATはGitHub側で失効できるため､リフレッシュトークンは発行しない
+func (s *AuthService) ExchangePersonalAccessToken(ctx context.Context, req *api.ExchangePersonalAccessTokenRequest) (_ *api.TokenResponse, err error) {
+	actor := audit.Actor{AuthMethod: auth.AuthMethodPAT}
+	defer func() {
+		s.audits.Log(ctx, &audit.Event{Action: audit.ActionExchangePersonalAccessToken, Actor: actor}, err)
+	}()
+
+	if s.pats == nil || s.roles == nil || s.tokens == nil {
+		return nil, errAuthNotConfigured
+	}
+
+	user, err := s.pats.Verify(ctx, req.Token)
+	if err != nil {
+		return nil, toAuthError(err)
+	}
+	userID := strconv.FormatInt(user.ID, 10)
+	actor.UserID = userID
+	role, err := s.roles.Resolve(ctx, userID, req.Token)
+	if err != nil {
+		return nil, toAuthError(err)
+	}
+	actor.Role = role
+
+	issued, err := s.tokens.IssueAccessToken(auth.TokenSubject{
+		UserID:     userID,
+		Role:       role,
+		AuthMethod: auth.AuthMethodPAT,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.TokenResponse{
+		AccessToken: issued.Token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(s.tokens.AccessTokenDuration().Seconds()),
+	}, nil
+}
+
+// ExchangeInstallationToken はGitHub Actions向けにInstallation Access Tokenをアクセストークンに交換する
+// 発行するアクセストークンはInstallationがアクセスできるリポジトリのApplicationのみを操作できる
+func (s *AuthService) ExchangeInstallationToken(ctx context.Context, req *api.ExchangeInstallationTokenRequest) (_ *api.TokenResponse, err error) {
+	actor := audit.Actor{AuthMethod: auth.AuthMethodInstallation}
+	defer func() {
+		s.audits.Log(ctx, &audit.Event{Action: audit.ActionExchangeInstallationToken, Actor: actor}, err)
+	}()
+
+	if s.installations == nil || s.tokens == nil {
+		return nil, errAuthNotConfigured
+	}
+
+	result, err := s.installations.Verify(ctx, req.Token)
+	if err != nil {
+		return nil, toAuthError(err)
+	}
+	actor.UserID = auth.InstallationSubject(result.Installation.ID)
+	actor.Role = authz.RoleWriter
+
+	// CIからのデプロイを想定し､リポジトリの範囲内ではwriterとする
+	issued, err := s.tokens.IssueAccessToken(auth.TokenSubject{
+		UserID:       actor.UserID,
+		Role:         actor.Role,
+		AuthMethod:   auth.AuthMethodInstallation,
+		Repositories: result.Repositories,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.TokenResponse{
+		AccessToken: issued.Token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(s.tokens.AccessTokenDuration().Seconds()),
+	}, nil
+}
+
+func (s *AuthService) Logout(ctx context.Context, req *api.RefreshTokenRequest) (err error) {
+	actor := audit.Actor{}
+	defer func() { s.audits.Log(ctx, &audit.Event{Action: audit.ActionLogout, Actor: actor}, err) }()
+
+	if s.sessions == nil {
+		return errAuthNotConfigured
+	}
+
+	sess, err := s.sessions.Revoke(ctx, req.RefreshToken)
+	if err != nil {
+		return err
+	}
+	if sess != nil {
+		actor = sessionActor(sess)
+	}
+	// ADR004: ログアウト時に権限キャッシュを無効化する
+	if sess != nil && s.roles != nil {
+		if err := s.roles.Invalidate(ctx, sess.UserID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSessionRevoked は全トークンの失効より前にログインしたセッションを削除してErrInvalidTokenを返す
+func (s *AuthService) checkSessionRevoked(ctx context.Context, sess *session.Session) error {
+	if s.revocations == nil {
+		return nil
+	}
+	before, err := s.revocations.RevokedBefore(ctx)
+	if err != nil {
+		return err
+	}
+	if !sess.CreatedAt.Before(before) {
+		return nil
+	}
+	if _, err := s.sessions.Delete(ctx, sess.ID); err != nil && !errors.Is(err, session.ErrNotFound) {
+		return err
+	}
+	return errors.Mark(errors.New("session was revoked"), auth.ErrInvalidToken)
+}
+
+// sessionActor はリフレッシュトークンで認証した呼び出し元を監査ログのActorにする
+func sessionActor(sess *session.Session) audit.Actor {
+	return audit.Actor{
+		UserID:     sess.UserID,
+		Role:       sess.Role,
+		AuthMethod: sess.AuthMethod,
+		SessionID:  sess.ID,
+	}
+}
+
+func (s *AuthService) issueAccessToken(sess *session.Session) (*auth.IssuedToken, error) {
+	return s.tokens.IssueAccessToken(auth.TokenSubject{
+		UserID:     sess.UserID,
+		Role:       sess.Role,
+		AuthMethod: sess.AuthMethod,
+		SessionID:  sess.ID,
+	})
+}
+
+func (s *AuthService) GetJWKS(ctx context.Context) (*api.JWKS, error) {
+	if s.tokens == nil {
+		return nil, errAuthNotConfigured
+	}
+
+	return &api.JWKS{
+		Keys: lo.Map(s.tokens.JWKS(), func(key auth.JWK, _ int) api.JWK {
+			return api.JWK{
+				Kty: key.Kty,
+				Use: key.Use,
+				Alg: key.Alg,
+				Kid: key.Kid,
+				N:   key.N,
+				E:   key.E,
+			}
+		}),
+	}, nil
+}
+
+var errAuthNotConfigured = &ErrorWithCode{
+	Code:    http.StatusServiceUnavailable,
+	Message: "authentication is not configured",
+}
+
+// toAuthError はADR004で定義された認証エラーをHTTPステータスに対応付ける
+func toAuthError(err error) error {
+	switch {
+	case errors.Is(err, auth.ErrStateMismatch):
+		return &ErrorWithCode{Code: http.StatusBadRequest, Message: auth.ErrStateMismatch.Error()}
+	case errors.Is(err, auth.ErrInvalidRequest):
+		return &ErrorWithCode{Code: http.StatusBadRequest, Message: auth.ErrInvalidRequest.Error()}
+	case errors.Is(err, auth.ErrAccessDenied):
+		return &ErrorWithCode{Code: http.StatusUnauthorized, Message: auth.ErrAccessDenied.Error()}
+	case errors.Is(err, authz.ErrNotOrgMember):
+		return &ErrorWithCode{Code: http.StatusForbidden, Message: authz.ErrNotOrgMember.Error()}
+	case errors.Is(err, auth.ErrInvalidToken):
+		return &ErrorWithCode{Code: http.StatusUnauthorized, Message: auth.ErrInvalidToken.Error()}
+	case errors.Is(err, auth.ErrInsufficientScope):
+		return &ErrorWithCode{Code: http.StatusForbidden, Message: auth.ErrInsufficientScope.Error()}
+	case errors.Is(err, auth.ErrInvalidInstallationToken):
+		return &ErrorWithCode{Code: http.StatusUnauthorized, Message: auth.ErrInvalidInstallationToken.Error()}
+	case errors.Is(err, auth.ErrInstallationSuspended):
+		return &ErrorWithCode{Code: http.StatusForbidden, Message: auth.ErrInstallationSuspended.Error()}
+	case errors.Is(err, auth.ErrInsufficientPermissions):
+		return &ErrorWithCode{Code: http.StatusForbidden, Message: auth.ErrInsufficientPermissions.Error()}
+	case errors.Is(err, auth.ErrRateLimitExceeded):
+		return &ErrorWithCode{Code: http.StatusTooManyRequests, Message: auth.ErrRateLimitExceeded.Error()}
+	case errors.Is(err, auth.ErrGitHubAPI):
+		return &ErrorWithCode{Code: http.StatusBadGateway, Message: auth.ErrGitHubAPI.Error()}
+	case errors.Is(err, session.ErrTokenReused):
+		return &ErrorWithCode{Code: http.StatusUnauthorized, Message: "refresh_token_reused"}
+	case errors.Is(err, session.ErrNotFound):
+		return &ErrorWithCode{Code: http.StatusUnauthorized, Message: auth.ErrInvalidToken.Error()}
+	default:
+		return err
+	}
+}
@@ -0,0 +1,543 @@
+package v1alpha1
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/audit"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/authz"
+	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/github"
+	"github.com/tacokumo/portal-api/pkg/github/githubtest"
+	"github.com/tacokumo/portal-api/pkg/session"
+)
+
+func newTestAuthService(t *testing.T, srv *githubtest.Server) *AuthService {
+	t.Helper()
+
+	githubClient := github.NewClient(srv.URL, srv.Client())
+	oauth := auth.NewOAuth(config.GitHubConfig{
+		BaseURL:    srv.URL,
+		APIBaseURL: srv.URL,
+		OAuth: config.GitHubOAuthConfig{
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			RedirectURL:  "http://localhost:8080/auth/github/callback",
+		},
+	}, auth.NewMemoryStateStore(), githubClient)
+	roles := authz.NewRoleResolver(config.OrganizationConfig{
+		Name:         "tacokumo",
+		DefaultRole:  authz.RoleViewer,
+		TeamMappings: []config.TeamMapping{{Team: "maintainers", Role: authz.RoleWriter}},
+	}, githubClient, authz.NewMemoryPermissionCache())
+	appKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	installations := auth.NewInstallationVerifierWithKey("12345", appKey, githubClient)
+	return NewAuthService(oauth, auth.NewPATVerifier(githubClient), installations, roles, newTestTokenService(t), session.NewManager(session.NewMemoryStore(), 8*time.Hour), nil, nil)
+}
+
+func newTestTokenService(t *testing.T) *auth.TokenService {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	tokens, err := auth.NewTokenServiceWithKeys(key, &key.PublicKey, time.Hour)
+	require.NoError(t, err)
+	return tokens
+}
+
+func TestAuthService_GetAuthGitHubLogin(t *testing.T) {
+	t.Parallel()
+
+	t.Run("GitHubの認可URLへのリダイレクトを返すこと", func(t *testing.T) {
+		t.Parallel()
+
+		srv := githubtest.NewServer(t)
+		service := newTestAuthService(t, srv)
+
+		ret, err := service.GetAuthGitHubLogin(t.Context())
+		assert.NoError(t, err)
+		u, err := url.Parse(ret.Location)
+		assert.NoError(t, err)
+		assert.Equal(t, srv.URL+"/login/oauth/authorize", u.Scheme+"://"+u.Host+u.Path)
+	})
+
+	t.Run("認証が設定されていない場合は503となること", func(t *testing.T) {
+		t.Parallel()
+
+		service := NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil)
+		_, err := service.GetAuthGitHubLogin(t.Context())
+		var ewc *ErrorWithCode
+		assert.ErrorAs(t, err, &ewc)
+		assert.Equal(t, http.StatusServiceUnavailable, ewc.Code)
+	})
+}
+
+func TestAuthService_GetAuthGitHubCallback(t *testing.T) {
+	tests := []struct {
+		name         string
+		paramsFn     func(state string) api.GetAuthGitHubCallbackParams
+		notMember    bool
+		teams        []github.Team
+		failure      int
+		expectedRole string
+		expectedCode int
+		expectedMsg  string
+	}{
+		{
+			name: "認可コードを交換してユーザー情報を返すこと",
+			paramsFn: func(state string) api.GetAuthGitHubCallbackParams {
+				return api.GetAuthGitHubCallbackParams{
+					Code:  api.NewOptString("valid-code"),
+					State: api.NewOptString(state),
+				}
+			},
+			expectedRole: authz.RoleViewer,
+		},
+		{
+			name: "writerに対応付けられたTeamのメンバーはwriterとなること",
+			paramsFn: func(state string) api.GetAuthGitHubCallbackParams {
+				return api.GetAuthGitHubCallbackParams{
+					Code:  api.NewOptString("valid-code"),
+					State: api.NewOptString(state),
+				}
+			},
+			teams: []github.Team{
+				{Slug: "maintainers", Organization: github.Organization{Login: "tacokumo"}},
+			},
+			expectedRole: authz.RoleWriter,
+		},
+		{
+			name: "Organizationのメンバーではない場合は403となること",
+			paramsFn: func(state string) api.GetAuthGitHubCallbackParams {
+				return api.GetAuthGitHubCallbackParams{
+					Code:  api.NewOptString("valid-code"),
+					State: api.NewOptString(state),
+				}
+			},
+			notMember:    true,
+			expectedCode: http.StatusForbidden,
+			expectedMsg:  "organization_not_member",
+		},
+		{
+			name: "stateが一致しない場合は400となること",
+			paramsFn: func(state string) api.GetAuthGitHubCallbackParams {
+				return api.GetAuthGitHubCallbackParams{
+					Code:  api.NewOptString("valid-code"),
+					State: api.NewOptString("forged-state"),
+				}
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedMsg:  "state_mismatch",
+		},
+		{
+			name: "ユーザーが認可を拒否した場合は401となること",
+			paramsFn: func(state string) api.GetAuthGitHubCallbackParams {
+				return api.GetAuthGitHubCallbackParams{
+					State: api.NewOptString(state),
+					Error: api.NewOptString("access_denied"),
+				}
+			},
+			expectedCode: http.StatusUnauthorized,
+			expectedMsg:  "access_denied",
+		},
+		{
+			name: "GitHub APIが障害の場合は502となること",
+			paramsFn: func(state string) api.GetAuthGitHubCallbackParams {
+				return api.GetAuthGitHubCallbackParams{
+					Code:  api.NewOptString("valid-code"),
+					State: api.NewOptString(state),
+				}
+			},
+			failure:      http.StatusInternalServerError,
+			expectedCode: http.StatusBadGateway,
+			expectedMsg:  "github_api_error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := githubtest.NewServer(t)
+			srv.AddCode("valid-code", "gho_token")
+			srv.AddUser("gho_token", github.User{ID: 42, Login: "octocat", Name: "The Octocat"})
+			if !tt.notMember {
+				srv.AddMembership("gho_token", "tacokumo")
+			}
+			for _, team := range tt.teams {
+				srv.AddTeam("gho_token", team)
+			}
+			service := newTestAuthService(t, srv)
+
+			login, err := service.GetAuthGitHubLogin(t.Context())
+			require.NoError(t, err)
+			u, err := url.Parse(login.Location)
+			require.NoError(t, err)
+			if tt.failure != 0 {
+				srv.Fail(tt.failure)
+			}
+
+			ret, err := service.GetAuthGitHubCallback(t.Context(), tt.paramsFn(u.Query().Get("state")))
+			if tt.expectedCode != 0 {
+				var ewc *ErrorWithCode
+				assert.ErrorAs(t, err, &ewc)
+				assert.Equal(t, tt.expectedCode, ewc.Code)
+				assert.Equal(t, tt.expectedMsg, ewc.Message)
+				// 全ての認証エラーはErrorスキーマの一覧にあるエラーコードで返すこと
+				assert.NotEqual(t, int32(ErrorCodeUnknown), (&Handler{}).NewError(t.Context(), err).Response.Code)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(42), ret.User.ID)
+			assert.Equal(t, "octocat", ret.User.Login)
+			assert.Equal(t, "The Octocat", ret.User.Name.Value)
+			assert.Equal(t, "Bearer", ret.TokenType)
+			assert.Equal(t, int64(3600), ret.ExpiresIn)
+
+			assert.NotEmpty(t, ret.RefreshToken)
+
+			claims, err := service.tokens.Verify(ret.AccessToken)
+			assert.NoError(t, err)
+			assert.Equal(t, "42", claims.Subject)
+			assert.Equal(t, tt.expectedRole, claims.Role)
+			assert.NotEmpty(t, claims.SessionID)
+		})
+	}
+}
+
+func TestAuthService_ExchangePersonalAccessToken(t *testing.T) {
+	tests := []struct {
+		name         string
+		token        string
+		scopes       []string
+		notMember    bool
+		teams        []github.Team
+		rateLimited  bool
+		expectedRole string
+		expectedCode int
+		expectedMsg  string
+	}{
+		{
+			name:         "PATをアクセストークンに交換できること",
+			token:        "ghp_valid",
+			scopes:       []string{"read:org", "read:user"},
+			expectedRole: authz.RoleViewer,
+		},
+		{
+			name:   "Teamに対応付けられたロールのアクセストークンが発行されること",
+			token:  "ghp_valid",
+			scopes: []string{"read:org", "read:user"},
+			teams: []github.Team{
+				{Slug: "maintainers", Organization: github.Organization{Login: "tacokumo"}},
+			},
+			expectedRole: authz.RoleWriter,
+		},
+		{
+			name:         "不正なPATの場合は401となること",
+			token:        "ghp_invalid",
+			expectedCode: http.StatusUnauthorized,
+			expectedMsg:  "invalid_token",
+		},
+		{
+			name:         "スコープが不足している場合は403となること",
+			token:        "ghp_valid",
+			scopes:       []string{"read:user"},
+			expectedCode: http.StatusForbidden,
+			expectedMsg:  "insufficient_scope",
+		},
+		{
+			name:         "Organizationのメンバーではない場合は403となること",
+			token:        "ghp_valid",
+			notMember:    true,
+			expectedCode: http.StatusForbidden,
+			expectedMsg:  "organization_not_member",
+		},
+		{
+			name:         "GitHub APIのレート制限の場合は429となること",
+			token:        "ghp_valid",
+			rateLimited:  true,
+			expectedCode: http.StatusTooManyRequests,
+			expectedMsg:  "rate_limit_exceeded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := githubtest.NewServer(t)
+			srv.AddUser("ghp_valid", github.User{ID: 42, Login: "octocat"})
+			if tt.scopes != nil {
+				srv.SetScopes("ghp_valid", tt.scopes...)
+			}
+			if !tt.notMember {
+				srv.AddMembership("ghp_valid", "tacokumo")
+			}
+			for _, team := range tt.teams {
+				srv.AddTeam("ghp_valid", team)
+			}
+			if tt.rateLimited {
+				srv.RateLimit()
+			}
+			service := newTestAuthService(t, srv)
+			audits, recorder := newTestAuditLogger()
+			service.audits = audits
+
+			ret, err := service.ExchangePersonalAccessToken(t.Context(), &api.ExchangePersonalAccessTokenRequest{Token: tt.token})
+			events := recorder.Events()
+			require.Len(t, events, 1)
+			assert.Equal(t, audit.ActionExchangePersonalAccessToken, events[0].Action)
+			assert.Equal(t, auth.AuthMethodPAT, events[0].Actor.AuthMethod)
+			if tt.expectedCode != 0 {
+				var ewc *ErrorWithCode
+				assert.ErrorAs(t, err, &ewc)
+				assert.Equal(t, tt.expectedCode, ewc.Code)
+				assert.Equal(t, tt.expectedMsg, ewc.Message)
+				// 全ての認証エラーはErrorスキーマの一覧にあるエラーコードで返すこと
+				assert.NotEqual(t, int32(ErrorCodeUnknown), (&Handler{}).NewError(t.Context(), err).Response.Code)
+				assert.Equal(t, audit.OutcomeFailure, events[0].Outcome)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, audit.OutcomeSuccess, events[0].Outcome)
+			assert.Equal(t, "42", events[0].Actor.UserID)
+			assert.Equal(t, tt.expectedRole, events[0].Actor.Role)
+			assert.Equal(t, "Bearer", ret.TokenType)
+			assert.False(t, ret.RefreshToken.IsSet())
+
+			claims, err := service.tokens.Verify(ret.AccessToken)
+			assert.NoError(t, err)
+			assert.Equal(t, "42", claims.Subject)
+			assert.Equal(t, tt.expectedRole, claims.Role)
+			assert.Equal(t, auth.AuthMethodPAT, claims.AuthMethod)
+			assert.Empty(t, claims.SessionID)
+		})
+	}
+
+	t.Run("認証が設定されていない場合は503となること", func(t *testing.T) {
+		t.Parallel()
+
+		service := NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil)
+		_, err := service.ExchangePersonalAccessToken(t.Context(), &api.ExchangePersonalAccessTokenRequest{Token: "ghp_valid"})
+		var ewc *ErrorWithCode
+		assert.ErrorAs(t, err, &ewc)
+		assert.Equal(t, http.StatusServiceUnavailable, ewc.Code)
+	})
+}
+
+func TestAuthService_ExchangeInstallationToken(t *testing.T) {
+	installation := github.Installation{ID: 100, Account: github.Account{Login: "tacokumo"}}
+	repo := github.Repository{FullName: "tacokumo/app-1", HTMLURL: "https://github.com/tacokumo/app-1"}
+	suspendedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		token        string
+		installation github.Installation
+		expectedCode int
+		expectedMsg  string
+	}{
+		{
+			name:         "リポジトリに限定されたアクセストークンに交換できること",
+			token:        "ghs_valid",
+			installation: installation,
+		},
+		{
+			name:         "不正なトークンの場合は401となること",
+			token:        "ghs_invalid",
+			installation: installation,
+			expectedCode: http.StatusUnauthorized,
+			expectedMsg:  "invalid_installation_token",
+		},
+		{
+			name:  "停止されたInstallationの場合は403となること",
+			token: "ghs_valid",
+			installation: github.Installation{
+				ID:          installation.ID,
+				Account:     installation.Account,
+				SuspendedAt: &suspendedAt,
+			},
+			expectedCode: http.StatusForbidden,
+			expectedMsg:  "installation_suspended",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := githubtest.NewServer(t)
+			srv.AddInstallation("ghs_valid", tt.installation, repo)
+			service := newTestAuthService(t, srv)
+
+			ret, err := service.ExchangeInstallationToken(t.Context(), &api.ExchangeInstallationTokenRequest{Token: tt.token})
+			if tt.expectedCode != 0 {
+				var ewc *ErrorWithCode
+				assert.ErrorAs(t, err, &ewc)
+				assert.Equal(t, tt.expectedCode, ewc.Code)
+				assert.Equal(t, tt.expectedMsg, ewc.Message)
+				// 全ての認証エラーはErrorスキーマの一覧にあるエラーコードで返すこと
+				assert.NotEqual(t, int32(ErrorCodeUnknown), (&Handler{}).NewError(t.Context(), err).Response.Code)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.False(t, ret.RefreshToken.IsSet())
+
+			claims, err := service.tokens.Verify(ret.AccessToken)
+			assert.NoError(t, err)
+			assert.Equal(t, "installation:100", claims.Subject)
+			assert.Equal(t, authz.RoleWriter, claims.Role)
+			assert.Equal(t, auth.AuthMethodInstallation, claims.AuthMethod)
+			assert.Equal(t, []string{"github.com/tacokumo/app-1"}, claims.Repositories)
+		})
+	}
+
+	t.Run("GitHub Appが設定されていない場合は503となること", func(t *testing.T) {
+		t.Parallel()
+
+		service := NewAuthService(nil, nil, nil, nil, newTestTokenService(t), nil, nil, nil)
+		_, err := service.ExchangeInstallationToken(t.Context(), &api.ExchangeInstallationTokenRequest{Token: "ghs_valid"})
+		var ewc *ErrorWithCode
+		assert.ErrorAs(t, err, &ewc)
+		assert.Equal(t, http.StatusServiceUnavailable, ewc.Code)
+	})
+}
+
+func TestAuthService_GetJWKS(t *testing.T) {
+	t.Parallel()
+
+	t.Run("署名検証用の公開鍵を返すこと", func(t *testing.T) {
+		t.Parallel()
+
+		service := NewAuthService(nil, nil, nil, nil, newTestTokenService(t), nil, nil, nil)
+		ret, err := service.GetJWKS(t.Context())
+		assert.NoError(t, err)
+		assert.Len(t, ret.Keys, 1)
+		assert.Equal(t, "RS256", ret.Keys[0].Alg)
+		assert.NotEmpty(t, ret.Keys[0].Kid)
+	})
+
+	t.Run("認証が設定されていない場合は503となること", func(t *testing.T) {
+		t.Parallel()
+
+		service := NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil)
+		_, err := service.GetJWKS(t.Context())
+		var ewc *ErrorWithCode
+		assert.ErrorAs(t, err, &ewc)
+		assert.Equal(t, http.StatusServiceUnavailable, ewc.Code)
+	})
+}
+
+func TestAuthService_RefreshToken(t *testing.T) {
+	t.Parallel()
+
+	newService := func(t *testing.T) (*AuthService, string) {
+		t.Helper()
+
+		service := NewAuthService(nil, nil, nil, nil, newTestTokenService(t), session.NewManager(session.NewMemoryStore(), 8*time.Hour), nil, nil)
+		_, refreshToken, err := service.sessions.Create(t.Context(), "42", "viewer", auth.AuthMethodOAuth)
+		require.NoError(t, err)
+		return service, refreshToken
+	}
+
+	t.Run("新しいアクセストークンとリフレッシュトークンを返すこと", func(t *testing.T) {
+		t.Parallel()
+
+		service, refreshToken := newService(t)
+		ret, err := service.RefreshToken(t.Context(), &api.RefreshTokenRequest{RefreshToken: refreshToken})
+		assert.NoError(t, err)
+		assert.NotEqual(t, refreshToken, ret.RefreshToken.Value)
+
+		claims, err := service.tokens.Verify(ret.AccessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, "42", claims.Subject)
+		assert.Equal(t, "viewer", claims.Role)
+	})
+
+	t.Run("使用済みのリフレッシュトークンは401となりセッションが失効すること", func(t *testing.T) {
+		t.Parallel()
+
+		service, refreshToken := newService(t)
+		ret, err := service.RefreshToken(t.Context(), &api.RefreshTokenRequest{RefreshToken: refreshToken})
+		require.NoError(t, err)
+
+		_, err = service.RefreshToken(t.Context(), &api.RefreshTokenRequest{RefreshToken: refreshToken})
+		var ewc *ErrorWithCode
+		assert.ErrorAs(t, err, &ewc)
+		assert.Equal(t, http.StatusUnauthorized, ewc.Code)
+		assert.Equal(t, "refresh_token_reused", ewc.Message)
+
+		_, err = service.RefreshToken(t.Context(), &api.RefreshTokenRequest{RefreshToken: ret.RefreshToken.Value})
+		assert.ErrorAs(t, err, &ewc)
+		assert.Equal(t, http.StatusUnauthorized, ewc.Code)
+	})
+
+	t.Run("未知のリフレッシュトークンは401となること", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newService(t)
+		_, err := service.RefreshToken(t.Context(), &api.RefreshTokenRequest{RefreshToken: "unknown"})
+		var ewc *ErrorWithCode
+		assert.ErrorAs(t, err, &ewc)
+		assert.Equal(t, http.StatusUnauthorized, ewc.Code)
+		assert.Equal(t, "invalid_token", ewc.Message)
+	})
+
+	t.Run("全トークンの失効より前にログインしたセッションは401となり削除されること", func(t *testing.T) {
+		t.Parallel()
+
+		revocations := auth.NewMemoryRevocationList()
+		sessions := session.NewManager(session.NewMemoryStore(), 8*time.Hour)
+		service := NewAuthService(nil, nil, nil, nil, newTestTokenService(t), sessions, revocations, nil)
+		_, refreshToken, err := sessions.Create(t.Context(), "42", "viewer", auth.AuthMethodOAuth)
+		require.NoError(t, err)
+		require.NoError(t, revocations.RevokeIssuedBefore(t.Context(), time.Now().Add(time.Second)))
+
+		_, err = service.RefreshToken(t.Context(), &api.RefreshTokenRequest{RefreshToken: refreshToken})
+		var ewc *ErrorWithCode
+		assert.ErrorAs(t, err, &ewc)
+		assert.Equal(t, http.StatusUnauthorized, ewc.Code)
+		assert.Equal(t, "invalid_token", ewc.Message)
+
+		remaining, err := sessions.List(t.Context(), "42")
+		assert.NoError(t, err)
+		assert.Empty(t, remaining)
+	})
+}
+
+func TestAuthService_Logout(t *testing.T) {
+	t.Parallel()
+
+	roles, srv := newTestRoleResolver(t)
+	_, err := roles.Resolve(t.Context(), "42", "gho_token")
+	require.NoError(t, err)
+
+	service := NewAuthService(nil, nil, nil, roles, newTestTokenService(t), session.NewManager(session.NewMemoryStore(), 8*time.Hour), nil, nil)
+	_, refreshToken, err := service.sessions.Create(t.Context(), "42", "viewer", auth.AuthMethodOAuth)
+	require.NoError(t, err)
+
+	assert.NoError(t, service.Logout(t.Context(), &api.RefreshTokenRequest{RefreshToken: refreshToken}))
+	assert.NoError(t, service.Logout(t.Context(), &api.RefreshTokenRequest{RefreshToken: refreshToken}), "ログアウトは冪等であること")
+
+	_, err = service.RefreshToken(t.Context(), &api.RefreshTokenRequest{RefreshToken: refreshToken})
+	var ewc *ErrorWithCode
+	assert.ErrorAs(t, err, &ewc)
+	assert.Equal(t, http.StatusUnauthorized, ewc.Code)
+
+	// ログアウトで権限キャッシュが無効化されているため､GitHub APIを参照する
+	srv.Fail(http.StatusServiceUnavailable)
+	_, err = roles.Resolve(t.Context(), "42", "gho_token")
+	assert.Error(t, err)
+}
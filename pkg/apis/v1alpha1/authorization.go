@@ -0,0 +1,44 @@
+package v1alpha1
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/tacokumo/portal-api/pkg/authz"
+)
+
+var (
+	errUnauthenticated = &ErrorWithCode{
+		Code:    http.StatusUnauthorized,
+		Message: "unauthorized",
+	}
+	errForbidden = &ErrorWithCode{
+		Code:    http.StatusForbidden,
+		Message: authz.ErrForbidden.Error(),
+	}
+)
+
+// authorize は呼び出し元がrequiredのロールを持つことを確認する
+// 参照系のAPIはviewer､更新系のAPIはwriterを要求する
+func authorize(ctx context.Context, required string) error {
+	return toAuthzError(authz.Authorize(ctx, required))
+}
+
+// authorizeRepository は呼び出し元がrepoURLをリポジトリとするApplicationを操作できることを確認する
+func authorizeRepository(ctx context.Context, repoURL string) error {
+	return toAuthzError(authz.AuthorizeRepository(ctx, repoURL))
+}
+
+func toAuthzError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, authz.ErrUnauthenticated):
+		return errUnauthenticated
+	case errors.Is(err, authz.ErrForbidden):
+		return errForbidden
+	default:
+		return err
+	}
+}
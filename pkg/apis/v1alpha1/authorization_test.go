@@ -0,0 +1,341 @@
+package v1alpha1
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/audit"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/authz"
+	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/k8sclient"
+	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// withRole はroleを持つ呼び出し元のIdentityをctxに紐付ける
+func withRole(ctx context.Context, role string) context.Context {
+	return auth.WithIdentity(ctx, &auth.Identity{
+		UserID:     "42",
+		Role:       role,
+		AuthMethod: auth.AuthMethodOAuth,
+	})
+}
+
+// auditRecorder は記録された監査ログを保持するSink
+type auditRecorder struct {
+	mu     sync.Mutex
+	events []audit.Event
+}
+
+func (r *auditRecorder) Write(ctx context.Context, event *audit.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, *event)
+	return nil
+}
+
+func (r *auditRecorder) Events() []audit.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Clone(r.events)
+}
+
+func newTestAuditLogger() (*audit.Logger, *auditRecorder) {
+	recorder := &auditRecorder{}
+	return audit.NewLogger(slog.Default(), recorder), recorder
+}
+
+// newTestConfig はテスト用のportal-namespaceとgithub.comのリポジトリを許可する設定を返す
+func newTestConfig() *config.Config {
+	return &config.Config{
+		PortalName: "portal-namespace",
+		Application: config.ApplicationConfig{
+			AllowedGitHosts: []string{"github.com"},
+		},
+	}
+}
+
+// repositoryURL はリクエストのrepository_urlに指定するURLを生成する
+func repositoryURL(t *testing.T, raw string) url.URL {
+	t.Helper()
+
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	return *u
+}
+
+func TestHandler_Authorization(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := k8sclient.NewScheme()
+	require.NoError(t, err)
+	h := NewHandler(
+		newTestConfig(),
+		fake.NewClientBuilder().WithScheme(scheme).Build(),
+		nil,
+		nil,
+		nil,
+		NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil),
+		NewAdminService(nil, nil, nil, nil, nil),
+		nil,
+		nil,
+	)
+
+	operations := []struct {
+		name     string
+		required string
+		call     func(ctx context.Context) error
+	}{
+		{
+			name:     "GetApplications",
+			required: authz.RoleViewer,
+			call: func(ctx context.Context) error {
+				_, err := h.GetApplications(ctx)
+				return err
+			},
+		},
+		{
+			name:     "ListApplications",
+			required: authz.RoleViewer,
+			call: func(ctx context.Context) error {
+				_, err := h.ListApplications(ctx, api.ListApplicationsParams{})
+				return err
+			},
+		},
+		{
+			name:     "WatchApplications",
+			required: authz.RoleViewer,
+			call: func(ctx context.Context) error {
+				ret, err := h.WatchApplications(ctx, api.WatchApplicationsParams{})
+				if c, ok := ret.Data.(io.Closer); ok {
+					_ = c.Close()
+				}
+				return err
+			},
+		},
+		{
+			name:     "GetApplication",
+			required: authz.RoleViewer,
+			call: func(ctx context.Context) error {
+				_, err := h.GetApplication(ctx, api.GetApplicationParams{Name: "example-app"})
+				return err
+			},
+		},
+		{
+			name:     "CreateApplication",
+			required: authz.RoleWriter,
+			call: func(ctx context.Context) error {
+				_, err := h.CreateApplication(ctx, &api.CreateApplicationRequest{Name: "example-app"}, api.CreateApplicationParams{})
+				return err
+			},
+		},
+		{
+			name:     "UpdateApplication",
+			required: authz.RoleWriter,
+			call: func(ctx context.Context) error {
+				_, err := h.UpdateApplication(ctx, &api.UpdateApplicationRequest{}, api.UpdateApplicationParams{Name: "example-app"})
+				return err
+			},
+		},
+		{
+			name:     "PatchApplication",
+			required: authz.RoleWriter,
+			call: func(ctx context.Context) error {
+				_, err := h.PatchApplication(ctx, &api.PatchApplicationRequest{}, api.PatchApplicationParams{Name: "example-app"})
+				return err
+			},
+		},
+		{
+			name:     "DeleteApplication",
+			required: authz.RoleWriter,
+			call: func(ctx context.Context) error {
+				return h.DeleteApplication(ctx, api.DeleteApplicationParams{Name: "example-app", ResourceVersion: "1"})
+			},
+		},
+		{
+			name:     "ListApplicationReleases",
+			required: authz.RoleViewer,
+			call: func(ctx context.Context) error {
+				_, err := h.ListApplicationReleases(ctx, api.ListApplicationReleasesParams{Name: "example-app"})
+				return err
+			},
+		},
+		{
+			name:     "GetApplicationRelease",
+			required: authz.RoleViewer,
+			call: func(ctx context.Context) error {
+				_, err := h.GetApplicationRelease(ctx, api.GetApplicationReleaseParams{Name: "example-app", Release: "example-app-production"})
+				return err
+			},
+		},
+		{
+			name:     "GetApplicationSecret",
+			required: authz.RoleViewer,
+			call: func(ctx context.Context) error {
+				_, err := h.GetApplicationSecret(ctx, api.GetApplicationSecretParams{Name: "example-app"})
+				return err
+			},
+		},
+		{
+			name:     "CreateApplicationSecret",
+			required: authz.RoleWriter,
+			call: func(ctx context.Context) error {
+				_, err := h.CreateApplicationSecret(ctx, &api.CreateSecretRequest{}, api.CreateApplicationSecretParams{Name: "example-app"})
+				return err
+			},
+		},
+		{
+			name:     "UpdateApplicationSecret",
+			required: authz.RoleWriter,
+			call: func(ctx context.Context) error {
+				_, err := h.UpdateApplicationSecret(ctx, &api.CreateSecretRequest{}, api.UpdateApplicationSecretParams{Name: "example-app"})
+				return err
+			},
+		},
+		{
+			name:     "PatchApplicationSecret",
+			required: authz.RoleWriter,
+			call: func(ctx context.Context) error {
+				_, err := h.PatchApplicationSecret(ctx, &api.CreateSecretRequest{}, api.PatchApplicationSecretParams{Name: "example-app"})
+				return err
+			},
+		},
+		{
+			name:     "DeleteApplicationSecret",
+			required: authz.RoleWriter,
+			call: func(ctx context.Context) error {
+				return h.DeleteApplicationSecret(ctx, api.DeleteApplicationSecretParams{Name: "example-app"})
+			},
+		},
+		{
+			name:     "DeleteApplicationSecretKey",
+			required: authz.RoleWriter,
+			call: func(ctx context.Context) error {
+				_, err := h.DeleteApplicationSecretKey(ctx, api.DeleteApplicationSecretKeyParams{Name: "example-app", Key: "DB_PASSWORD"})
+				return err
+			},
+		},
+	}
+
+	for _, op := range operations {
+		t.Run(op.name+": Identityがない場合は401となること", func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, op.call(t.Context()), errUnauthenticated)
+		})
+
+		if op.required == authz.RoleWriter {
+			t.Run(op.name+": viewerの場合は403となること", func(t *testing.T) {
+				t.Parallel()
+
+				err := op.call(withRole(t.Context(), authz.RoleViewer))
+				assert.ErrorIs(t, err, errForbidden)
+				assert.Equal(t, http.StatusForbidden, h.NewError(t.Context(), err).StatusCode)
+			})
+		}
+
+		t.Run(op.name+": 必要なロールを持つ場合は認可されること", func(t *testing.T) {
+			t.Parallel()
+
+			// 認可を通過した後のKubernetes APIのエラーは問わない
+			err := op.call(withRole(t.Context(), op.required))
+			assert.NotErrorIs(t, err, errUnauthenticated)
+			assert.NotErrorIs(t, err, errForbidden)
+		})
+	}
+}
+
+func TestHandler_RepositoryScopedAuthorization(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := k8sclient.NewScheme()
+	require.NoError(t, err)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	for _, name := range []string{"app-1", "app-2"} {
+		require.NoError(t, c.Create(t.Context(), &tacokumov1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "portal-namespace",
+			},
+			Spec: tacokumov1alpha1.ApplicationSpec{
+				ReleaseTemplate: tacokumov1alpha1.ReleaseSpec{
+					Repo: tacokumov1alpha1.RepositoryRef{
+						URL: "https://github.com/tacokumo/" + name + ".git",
+					},
+				},
+			},
+		}))
+	}
+	h := NewHandler(newTestConfig(), c, nil, nil, nil, NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil), NewAdminService(nil, nil, nil, nil, nil), nil, nil)
+
+	ctx := auth.WithIdentity(t.Context(), &auth.Identity{
+		UserID:       "installation:100",
+		Role:         authz.RoleWriter,
+		AuthMethod:   auth.AuthMethodInstallation,
+		Repositories: []string{"github.com/tacokumo/app-1"},
+	})
+
+	t.Run("アクセスできるリポジトリのApplicationのみ一覧に含まれること", func(t *testing.T) {
+		t.Parallel()
+
+		apps, err := h.GetApplications(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, apps, 1)
+		assert.Equal(t, "app-1", apps[0].Name)
+	})
+
+	t.Run("アクセスできるリポジトリのApplicationを取得できること", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := h.GetApplication(ctx, api.GetApplicationParams{Name: "app-1"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("アクセスできないリポジトリのApplicationは403となること", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := h.GetApplication(ctx, api.GetApplicationParams{Name: "app-2"})
+		assert.ErrorIs(t, err, errForbidden)
+	})
+
+	t.Run("アクセスできないリポジトリのApplicationは作成できないこと", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := h.CreateApplication(ctx, &api.CreateApplicationRequest{
+			Name:          "app-3",
+			RepositoryURL: repositoryURL(t, "https://github.com/tacokumo/app-3.git"),
+		}, api.CreateApplicationParams{})
+		assert.ErrorIs(t, err, errForbidden)
+	})
+
+	t.Run("アクセスできないリポジトリのApplicationのSecretは更新できないこと", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := h.UpdateApplicationSecret(ctx, &api.CreateSecretRequest{}, api.UpdateApplicationSecretParams{Name: "app-2"})
+		assert.ErrorIs(t, err, errForbidden)
+		_, err = h.PatchApplicationSecret(ctx, &api.CreateSecretRequest{}, api.PatchApplicationSecretParams{Name: "app-2"})
+		assert.ErrorIs(t, err, errForbidden)
+	})
+
+	t.Run("アクセスできないリポジトリのApplicationのSecretは削除できないこと", func(t *testing.T) {
+		t.Parallel()
+
+		err := h.DeleteApplicationSecret(ctx, api.DeleteApplicationSecretParams{Name: "app-2"})
+		assert.ErrorIs(t, err, errForbidden)
+		_, err = h.DeleteApplicationSecretKey(ctx, api.DeleteApplicationSecretKeyParams{Name: "app-2", Key: "DB_PASSWORD"})
+		assert.ErrorIs(t, err, errForbidden)
+	})
+}
@@ -1,5 +1,111 @@
 package v1alpha1
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// エラーレスポンスのcodeに返すエラーコード
+// クライアントがエラーの種類を判別できるように､一度公開したコードの意味は変更しない
+// 上3桁は対応するHTTPステータスコードとし､同じステータスの中で種類を区別する場合は下2桁を使う
+// 一覧はOpenAPIのErrorスキーマにも記載する
 const (
+	// ErrorCodeUnknown は分類できない内部エラー
 	ErrorCodeUnknown = 0
+
+	ErrorCodeInvalidRequest   = 40000
+	ErrorCodeValidationFailed = 40001
+	ErrorCodeUnauthenticated  = 40100
+	ErrorCodeForbidden        = 40300
+	ErrorCodeNotFound         = 40400
+	ErrorCodeConflict         = 40900
+	ErrorCodeAlreadyExists    = 40901
+	// ErrorCodeResourceVersionExpired は監視を再開するresource_versionが古すぎることを表す
+	ErrorCodeResourceVersionExpired = 41000
+	ErrorCodeUnprocessable          = 42200
+	// ErrorCodeRateLimited はGitHub APIのレート制限を超えたことを表す
+	ErrorCodeRateLimited = 42900
+	// ErrorCodeUpstream はGitHub APIなど外部のAPIが失敗したことを表す
+	ErrorCodeUpstream           = 50200
+	ErrorCodeServiceUnavailable = 50300
+	ErrorCodeTimeout            = 50400
 )
+
+// defaultErrorCodes はErrorCodeを指定していないErrorWithCodeのHTTPステータスコードに対応するエラーコード
+var defaultErrorCodes = map[int]int32{
+	http.StatusBadRequest:          ErrorCodeInvalidRequest,
+	http.StatusUnauthorized:        ErrorCodeUnauthenticated,
+	http.StatusForbidden:           ErrorCodeForbidden,
+	http.StatusNotFound:            ErrorCodeNotFound,
+	http.StatusConflict:            ErrorCodeConflict,
+	http.StatusGone:                ErrorCodeResourceVersionExpired,
+	http.StatusUnprocessableEntity: ErrorCodeUnprocessable,
+	http.StatusTooManyRequests:     ErrorCodeRateLimited,
+	http.StatusBadGateway:          ErrorCodeUpstream,
+	http.StatusServiceUnavailable:  ErrorCodeServiceUnavailable,
+	http.StatusGatewayTimeout:      ErrorCodeTimeout,
+}
+
+// fromKubernetesError はKubernetes APIのエラーをクライアントに返すエラーに変換する
+// Kubernetes APIのメッセージにはクラスタ内部の情報が含まれるため､メッセージは対象の種類と名前から組み立てる
+func fromKubernetesError(err error) (*api.ErrorStatusCode, bool) {
+	newError := func(status int, code int32, message string) *api.ErrorStatusCode {
+		return &api.ErrorStatusCode{
+			StatusCode: status,
+			Response:   api.Error{Code: code, Message: message},
+		}
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return newError(http.StatusGatewayTimeout, ErrorCodeTimeout, "request to kubernetes API timed out"), true
+	}
+
+	var status apierrors.APIStatus
+	if !errors.As(err, &status) {
+		return nil, false
+	}
+	details := status.Status().Details
+	target := "resource"
+	if details != nil && details.Kind != "" {
+		target = details.Kind
+		if details.Name != "" {
+			target = fmt.Sprintf("%s %q", details.Kind, details.Name)
+		}
+	}
+
+	switch apierrors.ReasonForError(err) {
+	case metav1.StatusReasonNotFound:
+		return newError(http.StatusNotFound, ErrorCodeNotFound, target+" not found"), true
+	case metav1.StatusReasonAlreadyExists:
+		return newError(http.StatusConflict, ErrorCodeAlreadyExists, target+" already exists"), true
+	case metav1.StatusReasonConflict:
+		return newError(http.StatusConflict, ErrorCodeConflict, target+" has been modified; get the latest resource_version and retry"), true
+	case metav1.StatusReasonExpired, metav1.StatusReasonGone:
+		return newError(http.StatusGone, ErrorCodeResourceVersionExpired, "resource_version is too old; list applications again and restart watching"), true
+	case metav1.StatusReasonForbidden:
+		return newError(http.StatusForbidden, ErrorCodeForbidden, "portal is not permitted to access "+target), true
+	case metav1.StatusReasonInvalid:
+		ret := newError(http.StatusUnprocessableEntity, ErrorCodeUnprocessable, target+" is invalid")
+		if details != nil {
+			for _, cause := range details.Causes {
+				ret.Response.Details = append(ret.Response.Details, api.FieldViolation{
+					Field:   cause.Field,
+					Message: cause.Message,
+				})
+			}
+		}
+		return ret, true
+	case metav1.StatusReasonTimeout, metav1.StatusReasonServerTimeout:
+		return newError(http.StatusGatewayTimeout, ErrorCodeTimeout, "request to kubernetes API timed out"), true
+	case metav1.StatusReasonTooManyRequests, metav1.StatusReasonServiceUnavailable:
+		return newError(http.StatusServiceUnavailable, ErrorCodeServiceUnavailable, "kubernetes API is unavailable"), true
+	default:
+		return nil, false
+	}
+}
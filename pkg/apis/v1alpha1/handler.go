@@ -2,11 +2,18 @@ package v1alpha1
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 
+	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/ogen-go/ogen/validate"
 	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/audit"
 	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/k8sclient"
+	"github.com/tacokumo/portal-api/pkg/secret"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -14,23 +21,57 @@ type Handler struct {
 	*HealthCheckService
 	*ApplicationService
 	*ApplicationSecretService
+	*AuthService
+	*AdminService
+	*SecretKeyService
+
+	logger *slog.Logger
 }
 
+// NewHandler はHandlerを生成する
+// cacheを指定した場合はApplicationの参照をキャッシュから返し､readinessはキャッシュの同期を待つ
+// secretsを指定しない場合､SecretのAPIは全て503を返す
+// rotatorを指定しない場合､鍵のローテーションのAPIは全て503を返す
 func NewHandler(
 	cfg *config.Config,
-	client client.Client) *Handler {
+	client client.Client,
+	cache *k8sclient.Cache,
+	secrets *secret.Vault,
+	rotator *secret.Rotator,
+	authService *AuthService,
+	adminService *AdminService,
+	audits *audit.Logger,
+	logger *slog.Logger) *Handler {
+	healthCheckService := &HealthCheckService{}
+	applicationService := &ApplicationService{config: cfg, client: client, secrets: secrets, audits: audits}
+	if authService != nil {
+		// SecurityHandlerと同じ失効情報を参照する
+		applicationService.revocations = authService.revocations
+	}
+	if cache != nil {
+		healthCheckService.cache = cache
+		applicationService.reader = cache
+	}
 	return &Handler{
-		HealthCheckService:       &HealthCheckService{},
-		ApplicationService:       &ApplicationService{config: cfg, client: client},
-		ApplicationSecretService: NewApplicationSecretService(cfg, client),
+		HealthCheckService:       healthCheckService,
+		ApplicationService:       applicationService,
+		ApplicationSecretService: NewApplicationSecretService(cfg, client, secrets, audits),
+		AuthService:              authService,
+		AdminService:             adminService,
+		SecretKeyService:         NewSecretKeyService(rotator, audits),
+		logger:                   logger,
 	}
 }
 
 var _ api.Handler = &Handler{}
 
+// ErrorWithCode はクライアントに返すエラー
+// Codeはレスポンスのステータスコード､ErrorCodeはレスポンスのcodeであり､
+// ErrorCodeを省略した場合はステータスコードに対応するerror_code.goのエラーコードを返す
 type ErrorWithCode struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
+	Code      int    `json:"code"`
+	ErrorCode int32  `json:"error_code,omitempty"`
+	Message   string `json:"message"`
 }
 
 var _ error = &ErrorWithCode{}
@@ -40,21 +81,106 @@ func (e *ErrorWithCode) Error() string {
 }
 
 func (h *Handler) NewError(ctx context.Context, err error) *api.ErrorStatusCode {
-	if ewc, ok := err.(*ErrorWithCode); ok {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return newValidationErrorStatusCode(validationErr.Violations)
+	}
+
+	// OpenAPIのスキーマによるリクエストの検証に失敗した場合
+	var ogenValidateErr *validate.Error
+	if errors.As(err, &ogenValidateErr) {
+		violations := make([]api.FieldViolation, 0, len(ogenValidateErr.Fields))
+		for _, f := range ogenValidateErr.Fields {
+			violations = append(violations, api.FieldViolation{Field: f.Name, Message: f.Error.Error()})
+		}
+		return newValidationErrorStatusCode(violations)
+	}
+	var decodeRequestErr *ogenerrors.DecodeRequestError
+	var decodeParamsErr *ogenerrors.DecodeParamsError
+	if errors.As(err, &decodeRequestErr) || errors.As(err, &decodeParamsErr) {
+		return &api.ErrorStatusCode{
+			StatusCode: http.StatusBadRequest,
+			Response: api.Error{
+				Code:    ErrorCodeInvalidRequest,
+				Message: err.Error(),
+			},
+		}
+	}
+
+	var ewc *ErrorWithCode
+	if errors.As(err, &ewc) {
+		code := ewc.ErrorCode
+		if code == 0 {
+			code = defaultErrorCodes[ewc.Code]
+		}
 		return &api.ErrorStatusCode{
 			StatusCode: ewc.Code,
 			Response: api.Error{
-				Code:    int32(ewc.Code),
+				Code:    code,
 				Message: ewc.Message,
 			},
 		}
 	}
 
+	if ret, ok := fromKubernetesError(err); ok {
+		if ret.StatusCode == http.StatusForbidden {
+			// Portal API自身のServiceAccountの権限不足であり､呼び出し元の権限の問題ではない
+			h.log().WarnContext(ctx, "kubernetes API denied the request", "error", err)
+		}
+		return ret
+	}
+
+	// セキュリティスキームをいずれも満たさなかった場合
+	var secErr *ogenerrors.SecurityError
+	if errors.As(err, &secErr) {
+		return &api.ErrorStatusCode{
+			StatusCode: http.StatusUnauthorized,
+			Response: api.Error{
+				Code:    ErrorCodeUnauthenticated,
+				Message: "unauthorized",
+			},
+		}
+	}
+
+	// 内部エラーの詳細はクライアントに返さず､ログにのみ出力する
+	h.log().ErrorContext(ctx, "unexpected error", "error", err, "request_id", audit.RequestIDFromContext(ctx))
 	return &api.ErrorStatusCode{
 		StatusCode: http.StatusInternalServerError,
 		Response: api.Error{
 			Code:    ErrorCodeUnknown,
-			Message: err.Error(),
+			Message: "internal server error",
 		},
 	}
 }
+
+func (h *Handler) log() *slog.Logger {
+	if h.logger == nil {
+		return slog.Default()
+	}
+	return h.logger
+}
+
+func newValidationErrorStatusCode(violations []api.FieldViolation) *api.ErrorStatusCode {
+	return &api.ErrorStatusCode{
+		StatusCode: http.StatusBadRequest,
+		Response: api.Error{
+			Code:    ErrorCodeValidationFailed,
+			Message: "invalid request",
+			Details: violations,
+		},
+	}
+}
+
+// HandleError はリクエストのデコードや検証に失敗した場合のエラーを､ハンドラーのエラーと同じ形式で返す
+// ogenのデフォルトのエラーハンドラーはError以外の形式でレスポンスを返すため､api.WithErrorHandlerで置き換える
+func (h *Handler) HandleError(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
+	ret := h.NewError(ctx, err)
+	body, marshalErr := ret.Response.MarshalJSON()
+	if marshalErr != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(ret.StatusCode)
+	_, _ = w.Write(body)
+}
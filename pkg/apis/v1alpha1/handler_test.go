@@ -1,11 +1,25 @@
 package v1alpha1
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/ogen-go/ogen/ogenerrors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/k8sclient"
+	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestHandler_NewError(t *testing.T) {
@@ -24,13 +38,115 @@ func TestHandler_NewError(t *testing.T) {
 			},
 			expectedCode:     http.StatusBadRequest,
 			expectedMessage:  "bad request error",
-			expectedRespCode: int32(http.StatusBadRequest),
+			expectedRespCode: ErrorCodeInvalidRequest,
 		},
 		{
-			name:             "通常のerrorを渡した場合、500とエラーメッセージが返ること",
-			err:              errors.New("unexpected error"),
+			name: "ErrorCodeを指定したErrorWithCodeを渡した場合、指定したエラーコードが返ること",
+			err: &ErrorWithCode{
+				Code:      http.StatusConflict,
+				ErrorCode: ErrorCodeAlreadyExists,
+				Message:   "already exists",
+			},
+			expectedCode:     http.StatusConflict,
+			expectedMessage:  "already exists",
+			expectedRespCode: ErrorCodeAlreadyExists,
+		},
+		{
+			name: "ラップされたErrorWithCodeを渡した場合も指定されたコードとメッセージが返ること",
+			err: &ogenerrors.SecurityError{
+				Security: "BearerAuth",
+				Err:      &ErrorWithCode{Code: http.StatusUnauthorized, Message: "invalid_token"},
+			},
+			expectedCode:     http.StatusUnauthorized,
+			expectedMessage:  "invalid_token",
+			expectedRespCode: ErrorCodeUnauthenticated,
+		},
+		{
+			name: "セキュリティ要件を満たさない場合、401が返ること",
+			err: &ogenerrors.SecurityError{
+				Err: ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			},
+			expectedCode:     http.StatusUnauthorized,
+			expectedMessage:  "unauthorized",
+			expectedRespCode: ErrorCodeUnauthenticated,
+		},
+		{
+			name: "ValidationErrorを渡した場合、400が返ること",
+			err: &ValidationError{
+				Violations: []api.FieldViolation{{Field: "name", Message: "invalid"}},
+			},
+			expectedCode:     http.StatusBadRequest,
+			expectedMessage:  "invalid request",
+			expectedRespCode: ErrorCodeValidationFailed,
+		},
+		{
+			name:             "GitHub APIのレート制限を渡した場合、429とレート制限のエラーコードが返ること",
+			err:              toAuthError(auth.ErrRateLimitExceeded),
+			expectedCode:     http.StatusTooManyRequests,
+			expectedMessage:  "rate_limit_exceeded",
+			expectedRespCode: ErrorCodeRateLimited,
+		},
+		{
+			name:             "GitHub APIの障害を渡した場合、502と外部APIのエラーコードが返ること",
+			err:              toAuthError(auth.ErrGitHubAPI),
+			expectedCode:     http.StatusBadGateway,
+			expectedMessage:  "github_api_error",
+			expectedRespCode: ErrorCodeUpstream,
+		},
+		{
+			name:             "KubernetesのNotFoundを渡した場合、404が返ること",
+			err:              apierrors.NewNotFound(tacokumov1alpha1.GroupVersion.WithResource("applications").GroupResource(), "example-app"),
+			expectedCode:     http.StatusNotFound,
+			expectedMessage:  `applications "example-app" not found`,
+			expectedRespCode: ErrorCodeNotFound,
+		},
+		{
+			name:             "KubernetesのAlreadyExistsを渡した場合、409が返ること",
+			err:              fmt.Errorf("create: %w", apierrors.NewAlreadyExists(tacokumov1alpha1.GroupVersion.WithResource("applications").GroupResource(), "example-app")),
+			expectedCode:     http.StatusConflict,
+			expectedMessage:  `applications "example-app" already exists`,
+			expectedRespCode: ErrorCodeAlreadyExists,
+		},
+		{
+			name:             "KubernetesのConflictを渡した場合、409が返ること",
+			err:              apierrors.NewConflict(tacokumov1alpha1.GroupVersion.WithResource("applications").GroupResource(), "example-app", errors.New("the object has been modified")),
+			expectedCode:     http.StatusConflict,
+			expectedMessage:  `applications "example-app" has been modified; get the latest resource_version and retry`,
+			expectedRespCode: ErrorCodeConflict,
+		},
+		{
+			name:             "KubernetesのForbiddenを渡した場合、内部の情報を含まない403が返ること",
+			err:              apierrors.NewForbidden(corev1.Resource("secrets"), "example-app-secret", errors.New(`User "system:serviceaccount:portal:portal-api" cannot get resource "secrets"`)),
+			expectedCode:     http.StatusForbidden,
+			expectedMessage:  `portal is not permitted to access secrets "example-app-secret"`,
+			expectedRespCode: ErrorCodeForbidden,
+		},
+		{
+			name:             "KubernetesのInvalidを渡した場合、422が返ること",
+			err:              apierrors.NewInvalid(tacokumov1alpha1.GroupVersion.WithKind("Application").GroupKind(), "example-app", nil),
+			expectedCode:     http.StatusUnprocessableEntity,
+			expectedMessage:  `Application "example-app" is invalid`,
+			expectedRespCode: ErrorCodeUnprocessable,
+		},
+		{
+			name:             "KubernetesのTimeoutを渡した場合、504が返ること",
+			err:              apierrors.NewTimeoutError("request timed out", 1),
+			expectedCode:     http.StatusGatewayTimeout,
+			expectedMessage:  "request to kubernetes API timed out",
+			expectedRespCode: ErrorCodeTimeout,
+		},
+		{
+			name:             "contextがタイムアウトした場合、504が返ること",
+			err:              fmt.Errorf("list applications: %w", context.DeadlineExceeded),
+			expectedCode:     http.StatusGatewayTimeout,
+			expectedMessage:  "request to kubernetes API timed out",
+			expectedRespCode: ErrorCodeTimeout,
+		},
+		{
+			name:             "通常のerrorを渡した場合、エラーメッセージを含まない500が返ること",
+			err:              errors.New("dial tcp 10.0.0.1:6443: connection refused"),
 			expectedCode:     http.StatusInternalServerError,
-			expectedMessage:  "unexpected error",
+			expectedMessage:  "internal server error",
 			expectedRespCode: ErrorCodeUnknown,
 		},
 	}
@@ -49,3 +165,69 @@ func TestHandler_NewError(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_HandleError(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := k8sclient.NewScheme()
+	require.NoError(t, err)
+	tokens := newTestTokenService(t)
+	handler := NewHandler(newTestConfig(), fake.NewClientBuilder().WithScheme(scheme).Build(), nil, nil, nil, NewAuthService(nil, nil, nil, nil, tokens, nil, nil, nil), NewAdminService(nil, nil, nil, nil, nil), nil, nil)
+	srv, err := api.NewServer(handler, NewSecurityHandler(tokens, nil, nil), api.WithErrorHandler(handler.HandleError))
+	require.NoError(t, err)
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+
+	issued, err := tokens.IssueAccessToken(auth.TokenSubject{UserID: "42", Role: "writer"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		body             string
+		expectedRespCode int32
+		expectedDetails  []api.FieldViolation
+	}{
+		{
+			name:             "スキーマの制約に違反する場合はフィールドごとのエラーを返すこと",
+			body:             `{"name":"Invalid_Name","repository_url":"https://github.com/tacokumo/app.git","appconfig_path":"","appconfig_branch":"main"}`,
+			expectedRespCode: ErrorCodeValidationFailed,
+			expectedDetails: []api.FieldViolation{
+				{Field: "name", Message: "string: no regex match: ^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"},
+			},
+		},
+		{
+			name:             "サーバー側の検証に違反する場合はフィールドごとのエラーを返すこと",
+			body:             `{"name":"example-app","repository_url":"https://gitlab.com/tacokumo/app.git","appconfig_path":"../secret","appconfig_branch":"main"}`,
+			expectedRespCode: ErrorCodeValidationFailed,
+			expectedDetails: []api.FieldViolation{
+				{Field: "repository_url", Message: "host must be one of [github.com]"},
+				{Field: "appconfig_path", Message: "must not contain '..'"},
+			},
+		},
+		{
+			name:             "JSONとして不正な場合は400となること",
+			body:             `{"name":`,
+			expectedRespCode: ErrorCodeInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, ts.URL+"/v1alpha1/applications", strings.NewReader(tt.body))
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer "+issued.Token)
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := ts.Client().Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			body := api.Error{}
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, tt.expectedRespCode, body.Code)
+			assert.Equal(t, tt.expectedDetails, body.Details)
+		})
+	}
+}
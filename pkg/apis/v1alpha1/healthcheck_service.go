@@ -2,11 +2,25 @@ package v1alpha1
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
 )
 
-type HealthCheckService struct{}
+var errCacheNotSynced = &ErrorWithCode{
+	Code:    http.StatusServiceUnavailable,
+	Message: "kubernetes cache is not synced yet",
+}
+
+// cacheStatus はApplicationの読み取りに使うキャッシュの状態
+type cacheStatus interface {
+	Synced() bool
+}
+
+type HealthCheckService struct {
+	// cache はApplicationの読み取りに使うキャッシュ｡nilの場合はキャッシュを使わずにAPIサーバーから読み取る
+	cache cacheStatus
+}
 
 func (s *HealthCheckService) GetHealthLiveness(ctx context.Context) (*api.HealthCheckStatus, error) {
 	return &api.HealthCheckStatus{
@@ -14,7 +28,12 @@ func (s *HealthCheckService) GetHealthLiveness(ctx context.Context) (*api.Health
 	}, nil
 }
 
+// GetHealthReadiness はキャッシュの同期が完了するまでは503を返す
+// 同期前にリクエストを受け付けると､存在するApplicationを404として返してしまうため
 func (s *HealthCheckService) GetHealthReadiness(ctx context.Context) (*api.HealthCheckStatus, error) {
+	if s.cache != nil && !s.cache.Synced() {
+		return nil, errCacheNotSynced
+	}
 	return &api.HealthCheckStatus{
 		Status: "OK",
 	}, nil
@@ -27,3 +27,37 @@ func TestHealthCheckService_GetHealthReadiness(t *testing.T) {
 	assert.NotNil(t, ret)
 	assert.Equal(t, "OK", ret.Status)
 }
+
+type fakeCacheStatus bool
+
+func (s fakeCacheStatus) Synced() bool {
+	return bool(s)
+}
+
+func TestHealthCheckService_GetHealthReadiness_キャッシュ(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		synced   bool
+		expected error
+	}{
+		{name: "キャッシュの同期が完了している場合はOKを返すこと", synced: true},
+		{name: "キャッシュの同期が完了していない場合は503となること", synced: false, expected: errCacheNotSynced},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := &HealthCheckService{cache: fakeCacheStatus(tt.synced)}
+			ret, err := service.GetHealthReadiness(t.Context())
+			if tt.expected != nil {
+				assert.ErrorIs(t, err, tt.expected)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "OK", ret.Status)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/audit"
+	"github.com/tacokumo/portal-api/pkg/authz"
+	"github.com/tacokumo/portal-api/pkg/secret"
+)
+
+// SecretKeyService はSecretを暗号化する鍵のローテーションを管理する
+// rotatorがnilの場合はPostgreSQLが設定されていないため､全て503を返す
+type SecretKeyService struct {
+	rotator *secret.Rotator
+	audits  *audit.Logger
+}
+
+func NewSecretKeyService(rotator *secret.Rotator, audits *audit.Logger) *SecretKeyService {
+	return &SecretKeyService{
+		rotator: rotator,
+		audits:  audits,
+	}
+}
+
+// RotateSecretKey は新しい鍵を生成し､既存のSecretの再暗号化をバックグラウンドで開始する
+func (s *SecretKeyService) RotateSecretKey(ctx context.Context) (_ *api.SecretKeyRotation, err error) {
+	defer func() { s.audits.Record(ctx, audit.ActionRotateSecretKey, audit.Target{}, err) }()
+
+	if err := authorize(ctx, authz.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if s.rotator == nil {
+		return nil, errSecretStoreNotConfigured
+	}
+
+	status, err := s.rotator.Rotate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toAPISecretKeyRotation(status), nil
+}
+
+func (s *SecretKeyService) GetSecretKeyRotation(ctx context.Context) (*api.SecretKeyRotation, error) {
+	if err := authorize(ctx, authz.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if s.rotator == nil {
+		return nil, errSecretStoreNotConfigured
+	}
+
+	status, err := s.rotator.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toAPISecretKeyRotation(status), nil
+}
+
+func toAPISecretKeyRotation(status *secret.RotationStatus) *api.SecretKeyRotation {
+	ret := &api.SecretKeyRotation{
+		KeyID:     status.KeyID,
+		Total:     status.Total,
+		Remaining: status.Remaining,
+		Running:   status.Running,
+	}
+	// 管理者のみが参照できるため､再暗号化に失敗した原因をそのまま返す
+	if status.LastError != nil {
+		ret.LastError = api.NewOptString(status.LastError.Error())
+	}
+	return ret
+}
@@ -0,0 +1,97 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/audit"
+	"github.com/tacokumo/portal-api/pkg/authz"
+	"github.com/tacokumo/portal-api/pkg/secret"
+)
+
+func TestSecretKeyService_RotateSecretKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("adminは鍵をローテーションでき､監査ログに記録されること", func(t *testing.T) {
+		t.Parallel()
+
+		vault := newTestVault(t)
+		_, err := vault.Create(t.Context(), "example-app-secret", map[string]string{"DB_PASSWORD": "secret123"})
+		require.NoError(t, err)
+		audits, recorder := newTestAuditLogger()
+		// Runを起動しないため､再暗号化されずに残っていること
+		service := NewSecretKeyService(secret.NewRotator(vault, 100), audits)
+
+		ret, err := service.RotateSecretKey(withRole(t.Context(), authz.RoleAdmin))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), ret.KeyID)
+		assert.Equal(t, int64(1), ret.Total)
+		assert.Equal(t, int64(1), ret.Remaining)
+		assert.True(t, ret.Running)
+		assert.False(t, ret.LastError.IsSet())
+
+		events := recorder.Events()
+		require.Len(t, events, 1)
+		assert.Equal(t, audit.ActionRotateSecretKey, events[0].Action)
+		assert.Equal(t, audit.OutcomeSuccess, events[0].Outcome)
+	})
+
+	t.Run("writerの場合は403となること", func(t *testing.T) {
+		t.Parallel()
+
+		service := NewSecretKeyService(secret.NewRotator(newTestVault(t), 100), nil)
+		_, err := service.RotateSecretKey(withRole(t.Context(), authz.RoleWriter))
+		var ewc *ErrorWithCode
+		require.ErrorAs(t, err, &ewc)
+		assert.Equal(t, http.StatusForbidden, ewc.Code)
+	})
+
+	t.Run("PostgreSQLが設定されていない場合は503となること", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewSecretKeyService(nil, nil).RotateSecretKey(withRole(t.Context(), authz.RoleAdmin))
+		assert.ErrorIs(t, err, errSecretStoreNotConfigured)
+	})
+}
+
+func TestSecretKeyService_GetSecretKeyRotation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("再暗号化の完了後は残りが0となること", func(t *testing.T) {
+		t.Parallel()
+
+		vault := newTestVault(t)
+		_, err := vault.Create(t.Context(), "example-app-secret", map[string]string{"DB_PASSWORD": "secret123"})
+		require.NoError(t, err)
+		_, err = vault.Rotate(t.Context())
+		require.NoError(t, err)
+		require.NoError(t, vault.ReencryptAll(t.Context(), 100, nil))
+		service := NewSecretKeyService(secret.NewRotator(vault, 100), nil)
+
+		ret, err := service.GetSecretKeyRotation(withRole(t.Context(), authz.RoleAdmin))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), ret.KeyID)
+		assert.Equal(t, int64(1), ret.Total)
+		assert.Zero(t, ret.Remaining)
+		assert.False(t, ret.Running)
+	})
+
+	t.Run("viewerの場合は403となること", func(t *testing.T) {
+		t.Parallel()
+
+		service := NewSecretKeyService(secret.NewRotator(newTestVault(t), 100), nil)
+		_, err := service.GetSecretKeyRotation(withRole(t.Context(), authz.RoleViewer))
+		var ewc *ErrorWithCode
+		require.ErrorAs(t, err, &ewc)
+		assert.Equal(t, http.StatusForbidden, ewc.Code)
+	})
+
+	t.Run("PostgreSQLが設定されていない場合は503となること", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewSecretKeyService(nil, nil).GetSecretKeyRotation(withRole(t.Context(), authz.RoleAdmin))
+		assert.ErrorIs(t, err, errSecretStoreNotConfigured)
+	})
+}
@@ -0,0 +1,66 @@
+package v1alpha1
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/audit"
+	"github.com/tacokumo/portal-api/pkg/auth"
+)
+
+// SecurityHandler はAuthorizationヘッダーのトークンを検証し､呼び出し元のIdentityをcontextに紐付ける
+type SecurityHandler struct {
+	tokens      *auth.TokenService
+	revocations auth.RevocationList
+	audits      *audit.Logger
+}
+
+var _ api.SecurityHandler = &SecurityHandler{}
+
+// NewSecurityHandler はSecurityHandlerを生成する
+// tokensがnilの場合は全ての認証が必要なAPIが401を返す
+// revocationsがnilの場合は有効期限前のトークンの失効を確認しない
+func NewSecurityHandler(tokens *auth.TokenService, revocations auth.RevocationList, audits *audit.Logger) *SecurityHandler {
+	return &SecurityHandler{
+		tokens:      tokens,
+		revocations: revocations,
+		audits:      audits,
+	}
+}
+
+var errInvalidToken = &ErrorWithCode{
+	Code:    http.StatusUnauthorized,
+	Message: auth.ErrInvalidToken.Error(),
+}
+
+// errTokenRevoked は監査ログに失効したトークンによるアクセスであることを記録するためのもの
+var errTokenRevoked = errors.New("token has been revoked")
+
+func (h *SecurityHandler) HandleBearerAuth(ctx context.Context, operationName api.OperationName, t api.BearerAuth) (context.Context, error) {
+	if h.tokens == nil {
+		return nil, errAuthNotConfigured
+	}
+
+	claims, err := h.tokens.Verify(t.Token)
+	if err != nil {
+		// 成功は全てのリクエストで発生するため､監査ログには失敗のみを記録する
+		h.audits.Log(ctx, &audit.Event{Action: audit.ActionAuthenticate}, err)
+		return nil, errInvalidToken
+	}
+	if h.revocations != nil {
+		revoked, err := h.revocations.IsRevoked(ctx, claims)
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			h.audits.Log(ctx, &audit.Event{
+				Action: audit.ActionAuthenticate,
+				Actor:  audit.ActorFromIdentity(auth.IdentityFromClaims(claims)),
+			}, errTokenRevoked)
+			return nil, errInvalidToken
+		}
+	}
+	return auth.WithIdentity(ctx, auth.IdentityFromClaims(claims)), nil
+}
@@ -0,0 +1,249 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/audit"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/k8sclient"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSecurityHandler_HandleBearerAuth(t *testing.T) {
+	t.Parallel()
+
+	tokens := newTestTokenService(t)
+	issued, err := tokens.IssueAccessToken(auth.TokenSubject{
+		UserID:     "42",
+		Role:       "writer",
+		AuthMethod: auth.AuthMethodOAuth,
+		SessionID:  "session-id",
+	})
+	require.NoError(t, err)
+	otherIssued, err := newTestTokenService(t).IssueAccessToken(auth.TokenSubject{UserID: "42", Role: "writer"})
+	require.NoError(t, err)
+	revokedToken := auth.NewMemoryRevocationList()
+	require.NoError(t, revokedToken.RevokeToken(t.Context(), issued.Claims.ID, time.Hour))
+	revokedSession := auth.NewMemoryRevocationList()
+	require.NoError(t, revokedSession.RevokeSession(t.Context(), "session-id", time.Hour))
+	revokedAll := auth.NewMemoryRevocationList()
+	require.NoError(t, revokedAll.RevokeIssuedBefore(t.Context(), time.Now().Add(time.Minute)))
+
+	tests := []struct {
+		name         string
+		tokens       *auth.TokenService
+		revocations  auth.RevocationList
+		token        string
+		expectedCode int
+	}{
+		{
+			name:   "有効なアクセストークンの場合はIdentityがcontextに紐付くこと",
+			tokens: tokens,
+			token:  issued.Token,
+		},
+		{
+			name:        "失効していないアクセストークンの場合はIdentityがcontextに紐付くこと",
+			tokens:      tokens,
+			revocations: auth.NewMemoryRevocationList(),
+			token:       issued.Token,
+		},
+		{
+			name:         "JTIで失効したトークンの場合は401となること",
+			tokens:       tokens,
+			revocations:  revokedToken,
+			token:        issued.Token,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "失効したセッションのトークンの場合は401となること",
+			tokens:       tokens,
+			revocations:  revokedSession,
+			token:        issued.Token,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "全トークンの失効より前に発行されたトークンの場合は401となること",
+			tokens:       tokens,
+			revocations:  revokedAll,
+			token:        issued.Token,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "別の鍵で署名されたトークンの場合は401となること",
+			tokens:       tokens,
+			token:        otherIssued.Token,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "GitHubのトークンをそのまま使った場合は401となること",
+			tokens:       tokens,
+			token:        "ghp_xxxxxxxxxxxxxxxxxxxx",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "認証が設定されていない場合は503となること",
+			token:        issued.Token,
+			expectedCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewSecurityHandler(tt.tokens, tt.revocations, nil)
+			ctx, err := h.HandleBearerAuth(t.Context(), api.GetApplicationsOperation, api.BearerAuth{Token: tt.token})
+			if tt.expectedCode != 0 {
+				var ewc *ErrorWithCode
+				assert.ErrorAs(t, err, &ewc)
+				assert.Equal(t, tt.expectedCode, ewc.Code)
+				return
+			}
+
+			assert.NoError(t, err)
+			identity, ok := auth.IdentityFromContext(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, "42", identity.UserID)
+			assert.Equal(t, "writer", identity.Role)
+			assert.Equal(t, auth.AuthMethodOAuth, identity.AuthMethod)
+			assert.Equal(t, "session-id", identity.SessionID)
+			assert.Equal(t, issued.Claims.ID, identity.TokenID)
+		})
+	}
+}
+
+func TestSecurityHandler_HandleBearerAuth_監査ログ(t *testing.T) {
+	t.Parallel()
+
+	tokens := newTestTokenService(t)
+	issued, err := tokens.IssueAccessToken(auth.TokenSubject{UserID: "42", Role: "writer", AuthMethod: auth.AuthMethodOAuth})
+	require.NoError(t, err)
+	revocations := auth.NewMemoryRevocationList()
+	require.NoError(t, revocations.RevokeToken(t.Context(), issued.Claims.ID, time.Hour))
+	otherIssued, err := newTestTokenService(t).IssueAccessToken(auth.TokenSubject{UserID: "42", Role: "writer"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		token         string
+		expectedActor string
+	}{
+		{
+			name:          "失効したトークンによるアクセスは呼び出し元とともに記録されること",
+			token:         issued.Token,
+			expectedActor: "42",
+		},
+		{
+			name:  "検証に失敗したトークンによるアクセスが記録されること",
+			token: otherIssued.Token,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			audits, recorder := newTestAuditLogger()
+			h := NewSecurityHandler(tokens, revocations, audits)
+			_, err := h.HandleBearerAuth(t.Context(), api.GetApplicationsOperation, api.BearerAuth{Token: tt.token})
+			assert.ErrorIs(t, err, errInvalidToken)
+
+			events := recorder.Events()
+			require.Len(t, events, 1)
+			assert.Equal(t, audit.ActionAuthenticate, events[0].Action)
+			assert.Equal(t, audit.OutcomeFailure, events[0].Outcome)
+			assert.Equal(t, tt.expectedActor, events[0].Actor.UserID)
+		})
+	}
+}
+
+func TestSecurityHandler_Server(t *testing.T) {
+	t.Parallel()
+
+	scheme, err := k8sclient.NewScheme()
+	require.NoError(t, err)
+	cfg := &config.Config{PortalName: "portal-namespace"}
+	tokens := newTestTokenService(t)
+	srv, err := api.NewServer(
+		NewHandler(cfg, fake.NewClientBuilder().WithScheme(scheme).Build(), nil, nil, nil, NewAuthService(nil, nil, nil, nil, tokens, nil, nil, nil), NewAdminService(nil, nil, nil, nil, nil), nil, nil),
+		NewSecurityHandler(tokens, nil, nil),
+	)
+	require.NoError(t, err)
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+
+	issued, err := tokens.IssueAccessToken(auth.TokenSubject{UserID: "42", Role: "writer"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		path         string
+		token        string
+		expectedCode int
+	}{
+		{
+			name:         "ヘルスチェックは認証なしで呼び出せること",
+			path:         "/health/liveness",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "JWKSは認証なしで呼び出せること",
+			path:         "/.well-known/jwks.json",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "トークンなしでアプリケーションAPIを呼び出すと401となること",
+			path:         "/v1alpha1/applications",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "不正なトークンでアプリケーションAPIを呼び出すと401となること",
+			path:         "/v1alpha1/applications",
+			token:        "invalid.jwt.token",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "未対応の形式のトークンでアプリケーションAPIを呼び出すと401となること",
+			path:         "/v1alpha1/applications/example-app/secret",
+			token:        "opaque-token",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "有効なトークンでアプリケーションAPIを呼び出せること",
+			path:         "/v1alpha1/applications",
+			token:        issued.Token,
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, ts.URL+tt.path, nil)
+			require.NoError(t, err)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			resp, err := ts.Client().Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+			if tt.expectedCode == http.StatusUnauthorized {
+				body := api.Error{}
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+				assert.Equal(t, int32(ErrorCodeUnauthenticated), body.Code)
+				assert.NotEmpty(t, body.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// eventStreamHeartbeat はイベントがない間に接続を維持するために送るServer-Sent Eventsのコメント行
+var eventStreamHeartbeat = []byte(": heartbeat\n\n")
+
+type responseControllerKey struct{}
+
+// StreamingMiddleware はストリーミングのレスポンスを返すAPIがイベントごとにレスポンスをフラッシュできるように､
+// http.ResponseControllerをcontextに紐付ける
+// ogenが生成するハンドラーはレスポンスをio.Copyで書き込むだけであり､フラッシュしないため
+func StreamingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), responseControllerKey{}, http.NewResponseController(w))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// flusherFromContext はStreamingMiddlewareが紐付けたレスポンスのフラッシュ関数を返す
+// StreamingMiddlewareを経由していない場合はnilを返す
+func flusherFromContext(ctx context.Context) func() error {
+	rc, ok := ctx.Value(responseControllerKey{}).(*http.ResponseController)
+	if !ok {
+		return nil
+	}
+	return rc.Flush
+}
+
+// formatEvent はServer-Sent Eventsの1件のイベントを組み立てる
+// dataは改行を含まないJSONであること
+func formatEvent(event, id string, data []byte) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "event: %s\n", event)
+	if id != "" {
+		fmt.Fprintf(&b, "id: %s\n", id)
+	}
+	fmt.Fprintf(&b, "data: %s\n\n", data)
+	return b.Bytes()
+}
+
+// eventStreamReader はeventsに送られたイベントを順に読み出すio.Reader
+// 次のイベントを待つ前にレスポンスをフラッシュし､書き込んだイベントがすぐにクライアントに届くようにする
+// eventsが閉じられるとio.EOFを返し､Closeでイベントを送る側を止める
+type eventStreamReader struct {
+	events <-chan []byte
+	flush  func() error
+	cancel context.CancelFunc
+	buf    []byte
+}
+
+var _ io.ReadCloser = &eventStreamReader{}
+
+func (r *eventStreamReader) Read(p []byte) (int, error) {
+	if len(r.buf) == 0 {
+		var (
+			event []byte
+			ok    bool
+		)
+		select {
+		case event, ok = <-r.events:
+		default:
+			if r.flush != nil {
+				if err := r.flush(); err != nil {
+					return 0, err
+				}
+			}
+			event, ok = <-r.events
+		}
+		if !ok {
+			return 0, io.EOF
+		}
+		r.buf = event
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *eventStreamReader) Close() error {
+	r.cancel()
+	return nil
+}
@@ -0,0 +1,89 @@
+package v1alpha1
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatEvent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		event    string
+		id       string
+		data     string
+		expected string
+	}{
+		{
+			name:     "idを持つイベントを組み立てられること",
+			event:    "ADDED",
+			id:       "12",
+			data:     `{"name":"example-app"}`,
+			expected: "event: ADDED\nid: 12\ndata: {\"name\":\"example-app\"}\n\n",
+		},
+		{
+			name:     "idが空の場合はidを含めないこと",
+			event:    "ERROR",
+			data:     `{"code":0}`,
+			expected: "event: ERROR\ndata: {\"code\":0}\n\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, string(formatEvent(tt.event, tt.id, []byte(tt.data))))
+		})
+	}
+}
+
+func TestEventStreamReader(t *testing.T) {
+	t.Parallel()
+
+	events := make(chan []byte, 2)
+	flushed := make(chan struct{}, 1)
+	canceled := false
+	r := &eventStreamReader{
+		events: events,
+		flush: func() error {
+			flushed <- struct{}{}
+			return nil
+		},
+		cancel: func() { canceled = true },
+	}
+
+	// 送信待ちのイベントがある間はフラッシュしないこと
+	events <- []byte("event: ADDED\n\n")
+	events <- []byte("event: MODIFIED\n\n")
+	buf := make([]byte, 32)
+	n, err := r.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "event: ADDED\n\n", string(buf[:n]))
+	n, err = r.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "event: MODIFIED\n\n", string(buf[:n]))
+	assert.Empty(t, flushed)
+
+	// 次のイベントを待つ前にフラッシュすること
+	read := make(chan string)
+	go func() {
+		n, _ := r.Read(buf)
+		read <- string(buf[:n])
+	}()
+	<-flushed
+	events <- []byte("event: DELETED\n\n")
+	assert.Equal(t, "event: DELETED\n\n", <-read)
+
+	// イベントが閉じられた場合はEOFを返すこと
+	close(events)
+	_, err = r.Read(buf)
+	assert.ErrorIs(t, err, io.EOF)
+
+	require.NoError(t, r.Close())
+	assert.True(t, canceled)
+}
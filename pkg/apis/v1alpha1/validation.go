@@ -0,0 +1,101 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// reservedApplicationNames は/v1alpha1/applications/{name}と衝突するパスのため､Applicationの名前に使えない名前
+var reservedApplicationNames = []string{"watch"}
+
+const (
+	maxRepositoryURLLength = 2048
+	maxAppconfigPathLength = 1024
+)
+
+// ValidationError は入力値の検証に失敗したフィールドごとのエラー
+// NewErrorで400と､detailsにフィールドごとのエラーを持つレスポンスに変換される
+type ValidationError struct {
+	Violations []api.FieldViolation
+}
+
+var _ error = &ValidationError{}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		messages = append(messages, fmt.Sprintf("%s: %s", v.Field, v.Message))
+	}
+	return "invalid request: " + strings.Join(messages, "; ")
+}
+
+// fieldViolations は検証エラーを収集し､全てのフィールドのエラーをまとめて返すためのもの
+type fieldViolations []api.FieldViolation
+
+func (v *fieldViolations) add(field, message string) {
+	*v = append(*v, api.FieldViolation{Field: field, Message: message})
+}
+
+func (v fieldViolations) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return &ValidationError{Violations: v}
+}
+
+// validateApplicationName はnameがApplicationの名前として使えることを確認する
+// 名前はKubernetesのオブジェクト名や<name>-secretのSecret名に使うため､DNS-1123ラベルに制限する
+func validateApplicationName(v *fieldViolations, name string) {
+	for _, msg := range validation.IsDNS1123Label(name) {
+		v.add("name", msg)
+	}
+	if slices.Contains(reservedApplicationNames, name) {
+		v.add("name", fmt.Sprintf("%q is reserved", name))
+	}
+}
+
+// validateRepositoryURL はrepoURLが許可されたホストのGitリポジトリを指すhttpsのURLであることを確認する
+func validateRepositoryURL(v *fieldViolations, repoURL *url.URL, allowedHosts []string) {
+	const field = "repository_url"
+	raw := repoURL.String()
+	switch {
+	case len(raw) > maxRepositoryURLLength:
+		v.add(field, fmt.Sprintf("must be no more than %d characters", maxRepositoryURLLength))
+	case repoURL.Scheme != "https":
+		v.add(field, "must be an https URL")
+	case repoURL.User != nil:
+		// 認証情報はApplicationに平文で保存されてしまうため受け付けない
+		v.add(field, "must not contain credentials")
+	case !slices.ContainsFunc(allowedHosts, func(host string) bool {
+		return strings.EqualFold(host, repoURL.Hostname())
+	}):
+		v.add(field, fmt.Sprintf("host must be one of %v", allowedHosts))
+	default:
+		if _, ok := auth.RepositoryKey(raw); !ok || repoURL.RawQuery != "" || repoURL.Fragment != "" {
+			v.add(field, "must be a repository URL such as https://github.com/owner/repo.git")
+		}
+	}
+}
+
+// validateAppconfigPath はappconfigPathがリポジトリ内の相対パスであることを確認する
+// コントローラーがリポジトリの外のファイルを読み込まないように､絶対パスや..を含むパスは受け付けない
+func validateAppconfigPath(v *fieldViolations, appconfigPath string) {
+	const field = "appconfig_path"
+	switch {
+	case len(appconfigPath) > maxAppconfigPathLength:
+		v.add(field, fmt.Sprintf("must be no more than %d characters", maxAppconfigPathLength))
+	case strings.ContainsAny(appconfigPath, "\\\x00"):
+		v.add(field, "must not contain backslashes or NUL characters")
+	case path.IsAbs(appconfigPath):
+		v.add(field, "must be a relative path")
+	case slices.Contains(strings.Split(appconfigPath, "/"), ".."):
+		v.add(field, "must not contain '..'")
+	}
+}
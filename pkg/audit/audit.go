@@ -0,0 +1,163 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tacokumo/portal-api/pkg/auth"
+)
+
+// Action は監査ログに記録する操作の種類
+type Action string
+
+const (
+	// 認証イベント
+	ActionAuthenticate                Action = "auth.authenticate"
+	ActionLogin                       Action = "auth.login"
+	ActionLogout                      Action = "auth.logout"
+	ActionRefreshToken                Action = "auth.token.refresh"
+	ActionExchangePersonalAccessToken Action = "auth.token.exchange_pat"
+	ActionExchangeInstallationToken   Action = "auth.token.exchange_installation"
+
+	// Application･Secretの変更
+	ActionCreateApplication Action = "application.create"
+	ActionUpdateApplication Action = "application.update"
+	ActionDeleteApplication Action = "application.delete"
+	ActionCreateSecret      Action = "secret.create"
+	ActionUpdateSecret      Action = "secret.update"
+	ActionDeleteSecret      Action = "secret.delete"
+	ActionDeleteSecretKey   Action = "secret.key.delete"
+
+	// 管理操作
+	ActionInvalidatePermissions Action = "admin.permissions.invalidate"
+	ActionRevokeSession         Action = "admin.session.revoke"
+	ActionRevokeAccessToken     Action = "admin.token.revoke"
+	ActionRevokeAllTokens       Action = "admin.token.revoke_all"
+	ActionRotateSecretKey       Action = "admin.secret_key.rotate"
+)
+
+// Outcome は操作の結果
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+)
+
+// Actor は操作を行った呼び出し元
+type Actor struct {
+	UserID     string
+	Role       string
+	AuthMethod string
+	SessionID  string
+}
+
+// Target は操作の対象
+// Secretは常にApplicationに属するため､Secretを対象とする場合はApplicationも設定する
+type Target struct {
+	Namespace   string
+	Application string
+	Secret      string
+	// ID は対象がApplication･Secret以外の場合の識別子（ユーザーID､セッションID､JTIなど）
+	// Secretのキーを操作した場合はキーの名前
+	ID string
+}
+
+// Event は監査ログの1レコード
+type Event struct {
+	Time      time.Time
+	RequestID string
+	Actor     Actor
+	Action    Action
+	Target    Target
+	Outcome   Outcome
+	// Reason は失敗した場合のエラー
+	Reason string
+}
+
+// Sink は監査ログの出力先
+type Sink interface {
+	Write(ctx context.Context, event *Event) error
+}
+
+// Logger は監査ログを全てのSinkに出力する
+// nilのLoggerは何も記録しない
+type Logger struct {
+	sinks  []Sink
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewLogger はLoggerを生成する
+// loggerはSinkへの書き込みに失敗した場合のエラーの出力先
+func NewLogger(logger *slog.Logger, sinks ...Sink) *Logger {
+	return &Logger{
+		sinks:  sinks,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Record はctxに紐付いた呼び出し元によるactionの結果を記録する
+// errがnilでない場合は失敗として記録する
+func (l *Logger) Record(ctx context.Context, action Action, target Target, err error) {
+	event := &Event{
+		Action: action,
+		Target: target,
+	}
+	if identity, ok := auth.IdentityFromContext(ctx); ok {
+		event.Actor = ActorFromIdentity(identity)
+	}
+	l.Log(ctx, event, err)
+}
+
+// Log はeventを記録する
+// 認証前のイベントのようにctxに呼び出し元が紐付いていない場合に使う
+func (l *Logger) Log(ctx context.Context, event *Event, err error) {
+	if l == nil {
+		return
+	}
+
+	if event.Time.IsZero() {
+		event.Time = l.now()
+	}
+	if event.RequestID == "" {
+		event.RequestID = RequestIDFromContext(ctx)
+	}
+	event.Outcome = OutcomeSuccess
+	if err != nil {
+		event.Outcome = OutcomeFailure
+		event.Reason = err.Error()
+	}
+
+	for _, sink := range l.sinks {
+		// 監査ログの出力に失敗しても操作自体は失敗させない
+		if err := sink.Write(ctx, event); err != nil {
+			l.logger.ErrorContext(ctx, "failed to write audit event", "action", event.Action, "error", err)
+		}
+	}
+}
+
+// ActorFromIdentity はIdentityからActorを生成する
+func ActorFromIdentity(identity *auth.Identity) Actor {
+	return Actor{
+		UserID:     identity.UserID,
+		Role:       identity.Role,
+		AuthMethod: identity.AuthMethod,
+		SessionID:  identity.SessionID,
+	}
+}
+
+type requestIDKey struct{}
+
+// WithRequestID はctxにリクエストIDを紐付ける
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext はctxに紐付けられたリクエストIDを返す
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/auth"
+)
+
+type recordingSink struct {
+	mu     sync.Mutex
+	events []Event
+	err    error
+}
+
+func (s *recordingSink) Write(ctx context.Context, event *Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, *event)
+	return s.err
+}
+
+func TestLogger_Record(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := WithRequestID(t.Context(), "request-id")
+	ctx = auth.WithIdentity(ctx, &auth.Identity{
+		UserID:     "42",
+		Role:       "writer",
+		AuthMethod: auth.AuthMethodOAuth,
+		SessionID:  "session-id",
+	})
+	target := Target{Namespace: "portal-namespace", Application: "example-app"}
+
+	tests := []struct {
+		name            string
+		err             error
+		expectedOutcome Outcome
+		expectedReason  string
+	}{
+		{
+			name:            "成功した操作を記録できること",
+			expectedOutcome: OutcomeSuccess,
+		},
+		{
+			name:            "失敗した操作はエラーとともに記録されること",
+			err:             errors.New("forbidden"),
+			expectedOutcome: OutcomeFailure,
+			expectedReason:  "forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sink := &recordingSink{}
+			l := NewLogger(slog.Default(), sink)
+			l.now = func() time.Time { return now }
+
+			l.Record(ctx, ActionCreateApplication, target, tt.err)
+
+			require.Len(t, sink.events, 1)
+			assert.Equal(t, Event{
+				Time:      now,
+				RequestID: "request-id",
+				Actor: Actor{
+					UserID:     "42",
+					Role:       "writer",
+					AuthMethod: auth.AuthMethodOAuth,
+					SessionID:  "session-id",
+				},
+				Action:  ActionCreateApplication,
+				Target:  target,
+				Outcome: tt.expectedOutcome,
+				Reason:  tt.expectedReason,
+			}, sink.events[0])
+		})
+	}
+}
+
+func TestLogger_Log(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Sinkへの書き込みに失敗しても他のSinkには記録されること", func(t *testing.T) {
+		t.Parallel()
+
+		failing := &recordingSink{err: errors.New("unavailable")}
+		sink := &recordingSink{}
+		l := NewLogger(slog.Default(), failing, sink)
+
+		l.Log(t.Context(), &Event{Action: ActionLogin, Actor: Actor{UserID: "42"}}, nil)
+
+		require.Len(t, sink.events, 1)
+		assert.Equal(t, "42", sink.events[0].Actor.UserID)
+		assert.Equal(t, OutcomeSuccess, sink.events[0].Outcome)
+	})
+
+	t.Run("nilのLoggerは何も記録しないこと", func(t *testing.T) {
+		t.Parallel()
+
+		var l *Logger
+		assert.NotPanics(t, func() {
+			l.Log(t.Context(), &Event{Action: ActionLogin}, nil)
+			l.Record(t.Context(), ActionLogout, Target{}, nil)
+		})
+	})
+}
@@ -0,0 +1,101 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// eventSourceComponent はKubernetes Eventの発行元
+const eventSourceComponent = "portal-api"
+
+// KubernetesEventSink は対象のApplicationにKubernetes Eventを記録するSink
+// `kubectl describe application` で変更履歴を確認できるようにするためのものであり､
+// Applicationを対象としないイベントは記録しない
+type KubernetesEventSink struct {
+	client client.Client
+}
+
+var _ Sink = &KubernetesEventSink{}
+
+func NewKubernetesEventSink(client client.Client) *KubernetesEventSink {
+	return &KubernetesEventSink{
+		client: client,
+	}
+}
+
+func (s *KubernetesEventSink) Write(ctx context.Context, event *Event) error {
+	if event.Target.Application == "" || event.Target.Namespace == "" {
+		return nil
+	}
+
+	eventType := corev1.EventTypeNormal
+	if event.Outcome == OutcomeFailure {
+		eventType = corev1.EventTypeWarning
+	}
+	timestamp := metav1.NewTime(event.Time)
+	k8sEvent := corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			// client-goのEventRecorderと同じ命名規則に従う
+			Name:      fmt.Sprintf("%s.%x", event.Target.Application, event.Time.UnixNano()),
+			Namespace: event.Target.Namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: tacokumov1alpha1.GroupVersion.String(),
+			Kind:       "Application",
+			Namespace:  event.Target.Namespace,
+			Name:       event.Target.Application,
+		},
+		Reason:         eventReason(event.Action),
+		Message:        eventMessage(event),
+		Type:           eventType,
+		Source:         corev1.EventSource{Component: eventSourceComponent},
+		FirstTimestamp: timestamp,
+		LastTimestamp:  timestamp,
+		Count:          1,
+	}
+	if err := s.client.Create(ctx, &k8sEvent); err != nil {
+		return errors.Wrap(err, "failed to create kubernetes event")
+	}
+	return nil
+}
+
+// eventReason はaction（例: secret.update）をEventのReasonの慣習に沿ったUpperCamelCase（例: SecretUpdate）にする
+func eventReason(action Action) string {
+	var b strings.Builder
+	for _, word := range strings.FieldsFunc(string(action), func(r rune) bool {
+		return r == '.' || r == '_'
+	}) {
+		b.WriteString(strings.ToUpper(word[:1]))
+		b.WriteString(word[1:])
+	}
+	return b.String()
+}
+
+func eventMessage(event *Event) string {
+	actor := event.Actor.UserID
+	if actor == "" {
+		actor = "anonymous"
+	}
+	if event.Actor.AuthMethod != "" {
+		actor = fmt.Sprintf("%s (%s)", actor, event.Actor.AuthMethod)
+	}
+
+	message := fmt.Sprintf("%s by %s: %s", event.Action, actor, event.Outcome)
+	if event.Target.Secret != "" {
+		message += fmt.Sprintf(" [secret=%s]", event.Target.Secret)
+	}
+	if event.RequestID != "" {
+		message += fmt.Sprintf(" [request_id=%s]", event.RequestID)
+	}
+	if event.Reason != "" {
+		message += ": " + event.Reason
+	}
+	return message
+}
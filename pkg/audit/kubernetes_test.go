@@ -0,0 +1,96 @@
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/k8sclient"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestKubernetesEventSink_Write(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		event           *Event
+		expectedType    string
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name: "成功した操作はNormalのEventとなること",
+			event: &Event{
+				Time:      now,
+				RequestID: "request-id",
+				Actor:     Actor{UserID: "42", AuthMethod: "oauth"},
+				Action:    ActionUpdateSecret,
+				Target:    Target{Namespace: "portal-namespace", Application: "example-app", Secret: "example-app-secret"},
+				Outcome:   OutcomeSuccess,
+			},
+			expectedType:    corev1.EventTypeNormal,
+			expectedReason:  "SecretUpdate",
+			expectedMessage: "secret.update by 42 (oauth): success [secret=example-app-secret] [request_id=request-id]",
+		},
+		{
+			name: "失敗した操作はWarningのEventとなること",
+			event: &Event{
+				Time:    now,
+				Action:  ActionCreateApplication,
+				Target:  Target{Namespace: "portal-namespace", Application: "example-app"},
+				Outcome: OutcomeFailure,
+				Reason:  "forbidden",
+			},
+			expectedType:    corev1.EventTypeWarning,
+			expectedReason:  "ApplicationCreate",
+			expectedMessage: "application.create by anonymous: failure: forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme, err := k8sclient.NewScheme()
+			require.NoError(t, err)
+			c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			require.NoError(t, NewKubernetesEventSink(c).Write(t.Context(), tt.event))
+
+			events := corev1.EventList{}
+			require.NoError(t, c.List(t.Context(), &events, client.InNamespace("portal-namespace")))
+			require.Len(t, events.Items, 1)
+			event := events.Items[0]
+			assert.Equal(t, "Application", event.InvolvedObject.Kind)
+			assert.Equal(t, "example-app", event.InvolvedObject.Name)
+			assert.Equal(t, tt.expectedType, event.Type)
+			assert.Equal(t, tt.expectedReason, event.Reason)
+			assert.Equal(t, tt.expectedMessage, event.Message)
+			assert.Equal(t, eventSourceComponent, event.Source.Component)
+		})
+	}
+
+	t.Run("Applicationを対象としないイベントは記録しないこと", func(t *testing.T) {
+		t.Parallel()
+
+		scheme, err := k8sclient.NewScheme()
+		require.NoError(t, err)
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		require.NoError(t, NewKubernetesEventSink(c).Write(t.Context(), &Event{
+			Time:    now,
+			Action:  ActionLogin,
+			Outcome: OutcomeSuccess,
+		}))
+
+		events := corev1.EventList{}
+		require.NoError(t, c.List(t.Context(), &events))
+		assert.Empty(t, events.Items)
+	})
+}
@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SlogSink は監査ログを構造化ログとして出力するSink
+// JSONで出力する場合はslog.JSONHandlerを使うloggerを渡す
+type SlogSink struct {
+	logger *slog.Logger
+}
+
+var _ Sink = &SlogSink{}
+
+func NewSlogSink(logger *slog.Logger) *SlogSink {
+	return &SlogSink{
+		logger: logger,
+	}
+}
+
+func (s *SlogSink) Write(ctx context.Context, event *Event) error {
+	level := slog.LevelInfo
+	if event.Outcome == OutcomeFailure {
+		level = slog.LevelWarn
+	}
+
+	attrs := []slog.Attr{
+		slog.String("log_type", "audit"),
+		slog.String("action", string(event.Action)),
+		slog.String("outcome", string(event.Outcome)),
+		slog.Group("actor",
+			slog.String("user_id", event.Actor.UserID),
+			slog.String("role", event.Actor.Role),
+			slog.String("auth_method", event.Actor.AuthMethod),
+			slog.String("session_id", event.Actor.SessionID),
+		),
+		slog.Group("target",
+			slog.String("namespace", event.Target.Namespace),
+			slog.String("application", event.Target.Application),
+			slog.String("secret", event.Target.Secret),
+			slog.String("id", event.Target.ID),
+		),
+	}
+	if event.RequestID != "" {
+		attrs = append(attrs, slog.String("request_id", event.RequestID))
+	}
+	if event.Reason != "" {
+		attrs = append(attrs, slog.String("reason", event.Reason))
+	}
+
+	// 記録時刻ではなく操作の時刻をログの時刻にする
+	handler := s.logger.Handler()
+	if !handler.Enabled(ctx, level) {
+		return nil
+	}
+	record := slog.NewRecord(event.Time, level, "audit", 0)
+	record.AddAttrs(attrs...)
+	return handler.Handle(ctx, record)
+}
@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlogSink_Write(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		event         *Event
+		expectedLevel string
+	}{
+		{
+			name: "成功した操作はINFOで出力されること",
+			event: &Event{
+				Time:      now,
+				RequestID: "request-id",
+				Actor:     Actor{UserID: "42", Role: "writer", AuthMethod: "oauth"},
+				Action:    ActionUpdateSecret,
+				Target:    Target{Namespace: "portal-namespace", Application: "example-app", Secret: "example-app-secret"},
+				Outcome:   OutcomeSuccess,
+			},
+			expectedLevel: "INFO",
+		},
+		{
+			name: "失敗した操作はWARNで出力されること",
+			event: &Event{
+				Time:    now,
+				Action:  ActionLogin,
+				Outcome: OutcomeFailure,
+				Reason:  "organization_not_member",
+			},
+			expectedLevel: "WARN",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := &bytes.Buffer{}
+			sink := NewSlogSink(slog.New(slog.NewJSONHandler(buf, nil)))
+			require.NoError(t, sink.Write(t.Context(), tt.event))
+
+			record := map[string]any{}
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+			assert.Equal(t, tt.expectedLevel, record["level"])
+			assert.Equal(t, "audit", record["msg"])
+			assert.Equal(t, "audit", record["log_type"])
+			assert.Equal(t, now.Format(time.RFC3339), record["time"], "操作の時刻が出力されること")
+			assert.Equal(t, string(tt.event.Action), record["action"])
+			assert.Equal(t, string(tt.event.Outcome), record["outcome"])
+
+			actor, ok := record["actor"].(map[string]any)
+			require.True(t, ok)
+			assert.Equal(t, tt.event.Actor.UserID, actor["user_id"])
+			target, ok := record["target"].(map[string]any)
+			require.True(t, ok)
+			assert.Equal(t, tt.event.Target.Application, target["application"])
+
+			if tt.event.RequestID != "" {
+				assert.Equal(t, tt.event.RequestID, record["request_id"])
+			}
+			if tt.event.Reason != "" {
+				assert.Equal(t, tt.event.Reason, record["reason"])
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+// Identity は認証済みの呼び出し元を表す
+type Identity struct {
+	UserID     string
+	Role       string
+	AuthMethod string
+	SessionID  string
+	// TokenID はアクセストークンのJTI
+	TokenID string
+	// Repositories はInstallation Access Tokenで認証された場合に操作できるリポジトリ
+	Repositories []string
+	// ExpiresAt はアクセストークンの有効期限｡ゼロ値の場合は期限を持たない
+	// ストリーミングのように長く続くリクエストを期限で打ち切るために使う
+	ExpiresAt time.Time
+	// IssuedAt はアクセストークンの発行時刻｡RevokeIssuedBeforeによる失効の確認に使う
+	IssuedAt time.Time
+}
+
+// RepositoryScoped は操作できるApplicationがRepositoriesに限定されるかどうかを返す
+func (i *Identity) RepositoryScoped() bool {
+	return i.AuthMethod == AuthMethodInstallation
+}
+
+type identityKey struct{}
+
+// WithIdentity はctxに呼び出し元のIdentityを紐付ける
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext はctxに紐付けられたIdentityを返す
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	return identity, ok && identity != nil
+}
+
+// IdentityFromClaims はPortal APIが発行したアクセストークンのClaimsからIdentityを生成する
+func IdentityFromClaims(claims *Claims) *Identity {
+	identity := &Identity{
+		UserID:       claims.Subject,
+		Role:         claims.Role,
+		AuthMethod:   claims.AuthMethod,
+		SessionID:    claims.SessionID,
+		TokenID:      claims.ID,
+		Repositories: claims.Repositories,
+	}
+	if claims.ExpiresAt != nil {
+		identity.ExpiresAt = claims.ExpiresAt.Time
+	}
+	if claims.IssuedAt != nil {
+		identity.IssuedAt = claims.IssuedAt.Time
+	}
+	return identity
+}
@@ -0,0 +1,185 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/github"
+)
+
+// ADR004 で定義されたInstallation Access Tokenによる認証のエラー
+var (
+	ErrInvalidInstallationToken = errors.New("invalid_installation_token")
+	ErrInstallationSuspended    = errors.New("installation_suspended")
+	ErrInsufficientPermissions  = errors.New("insufficient_permissions")
+)
+
+// AuthMethodInstallation はGitHub AppのInstallation Access Tokenで認証されたことを表す（ADR004 の auth_method）
+const AuthMethodInstallation = "installation"
+
+const (
+	// appJWTDuration はGitHub AppのJWTの有効期間（GitHubの上限は10分）
+	appJWTDuration = 9 * time.Minute
+	// appJWTClockSkew はGitHubとの時刻のずれを考慮してiatを過去にずらす幅
+	appJWTClockSkew = 60 * time.Second
+)
+
+// InstallationVerifier はGitHub AppのInstallation Access Tokenを検証する
+type InstallationVerifier struct {
+	appID      string
+	privateKey *rsa.PrivateKey
+	github     *github.Client
+	now        func() time.Time
+}
+
+// NewInstallationVerifier はGitHubAppConfigで指定されたPEMファイルからAppの秘密鍵を読み込んでInstallationVerifierを生成する
+func NewInstallationVerifier(cfg config.GitHubAppConfig, githubClient *github.Client) (*InstallationVerifier, error) {
+	privateKey, err := loadRSAPrivateKey(cfg.PrivateKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	return NewInstallationVerifierWithKey(cfg.AppID, privateKey, githubClient), nil
+}
+
+// NewInstallationVerifierWithKey は読み込み済みのAppの秘密鍵からInstallationVerifierを生成する
+func NewInstallationVerifierWithKey(appID string, privateKey *rsa.PrivateKey, githubClient *github.Client) *InstallationVerifier {
+	return &InstallationVerifier{
+		appID:      appID,
+		privateKey: privateKey,
+		github:     githubClient,
+		now:        time.Now,
+	}
+}
+
+// InstallationResult は検証したInstallationとアクセスできるリポジトリ
+type InstallationResult struct {
+	Installation *github.Installation
+	// Repositories はRepositoryKeyで正規化したリポジトリ
+	Repositories []string
+}
+
+// Verify はtokenでアクセスできるリポジトリを取得し､それらにこのAppのInstallationが有効であることを確認する
+func (v *InstallationVerifier) Verify(ctx context.Context, token string) (*InstallationResult, error) {
+	if token == "" {
+		return nil, errors.Mark(errors.New("installation token is empty"), ErrInvalidInstallationToken)
+	}
+
+	repos, err := v.github.ListInstallationRepositories(ctx, token)
+	if err != nil {
+		return nil, markInstallationError(err)
+	}
+	if len(repos) == 0 {
+		return nil, errors.Mark(errors.New("installation token cannot access any repository"), ErrInsufficientPermissions)
+	}
+
+	appJWT, err := v.appJWT()
+	if err != nil {
+		return nil, err
+	}
+	// 他のAppのトークンを受け付けないよう､リポジトリにこのAppがインストールされていることを確認する
+	installation, err := v.github.GetRepositoryInstallation(ctx, appJWT, repos[0].FullName)
+	if err != nil {
+		var apiErr *github.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return nil, errors.Mark(err, ErrInvalidInstallationToken)
+		}
+		return nil, markInstallationError(err)
+	}
+	if installation.SuspendedAt != nil {
+		return nil, errors.Mark(
+			errors.Newf("installation %d is suspended", installation.ID),
+			ErrInstallationSuspended,
+		)
+	}
+
+	keys := make([]string, 0, len(repos))
+	for _, repo := range repos {
+		owner, _, _ := strings.Cut(repo.FullName, "/")
+		if !strings.EqualFold(owner, installation.Account.Login) {
+			return nil, errors.Mark(
+				errors.Newf("repository %s does not belong to installation %d", repo.FullName, installation.ID),
+				ErrInvalidInstallationToken,
+			)
+		}
+		key, ok := RepositoryKey(repo.HTMLURL)
+		if !ok {
+			return nil, errors.Mark(errors.Newf("invalid repository url: %s", repo.HTMLURL), ErrGitHubAPI)
+		}
+		keys = append(keys, key)
+	}
+	return &InstallationResult{
+		Installation: installation,
+		Repositories: keys,
+	}, nil
+}
+
+// appJWT はGitHub AppとしてAPIを呼び出すためのJWTを発行する
+func (v *InstallationVerifier) appJWT() (string, error) {
+	now := v.now()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{
+		Issuer:    v.appID,
+		IssuedAt:  jwt.NewNumericDate(now.Add(-appJWTClockSkew)),
+		ExpiresAt: jwt.NewNumericDate(now.Add(appJWTDuration)),
+	})
+	signed, err := token.SignedString(v.privateKey)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign github app jwt")
+	}
+	return signed, nil
+}
+
+// markInstallationError はInstallation Access Tokenで呼び出したGitHub APIのエラーをADR004のエラーに対応付ける
+func markInstallationError(err error) error {
+	var apiErr *github.APIError
+	switch {
+	case errors.As(err, &apiErr) && apiErr.RateLimited:
+		return errors.Mark(err, ErrRateLimitExceeded)
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized:
+		return errors.Mark(err, ErrInvalidInstallationToken)
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden &&
+		strings.Contains(strings.ToLower(apiErr.Message), "suspended"):
+		return errors.Mark(err, ErrInstallationSuspended)
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden:
+		return errors.Mark(err, ErrInsufficientPermissions)
+	default:
+		return errors.Mark(err, ErrGitHubAPI)
+	}
+}
+
+// InstallationSubject はInstallation Access Tokenに対して発行するアクセストークンのsub
+func InstallationSubject(installationID int64) string {
+	return "installation:" + strconv.FormatInt(installationID, 10)
+}
+
+// RepositoryKey はリポジトリのURLを比較可能な「ホスト/オーナー/リポジトリ」の形式に正規化する
+// https://github.com/owner/repo.git や git@github.com:owner/repo.git のいずれも github.com/owner/repo となる
+func RepositoryKey(rawURL string) (string, bool) {
+	rawURL = strings.TrimSpace(rawURL)
+	var host, path string
+	if u, err := url.Parse(rawURL); err == nil && u.Host != "" {
+		host, path = u.Hostname(), u.Path
+	} else if user, rest, ok := strings.Cut(rawURL, "@"); ok && !strings.Contains(user, "/") {
+		// scp形式（git@github.com:owner/repo.git）
+		host, path, ok = strings.Cut(rest, ":")
+		if !ok {
+			return "", false
+		}
+	} else {
+		return "", false
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	owner, repo, ok := strings.Cut(path, "/")
+	if !ok || owner == "" || repo == "" || strings.Contains(repo, "/") {
+		return "", false
+	}
+	return strings.ToLower(host + "/" + owner + "/" + repo), true
+}
@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/github"
+	"github.com/tacokumo/portal-api/pkg/github/githubtest"
+)
+
+func TestInstallationVerifier_Verify(t *testing.T) {
+	installation := github.Installation{ID: 100, Account: github.Account{Login: "tacokumo"}}
+	suspendedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	repos := []github.Repository{
+		{FullName: "tacokumo/app-1", HTMLURL: "https://github.com/tacokumo/app-1"},
+		{FullName: "tacokumo/App-2", HTMLURL: "https://github.com/tacokumo/App-2"},
+	}
+
+	tests := []struct {
+		name                 string
+		token                string
+		setupFn              func(srv *githubtest.Server)
+		expectedRepositories []string
+		expectedErr          error
+	}{
+		{
+			name:  "Installationがアクセスできるリポジトリを取得できること",
+			token: "ghs_valid",
+			setupFn: func(srv *githubtest.Server) {
+				srv.AddInstallation("ghs_valid", installation, repos...)
+			},
+			expectedRepositories: []string{"github.com/tacokumo/app-1", "github.com/tacokumo/app-2"},
+		},
+		{
+			name:  "停止されたInstallationの場合はErrInstallationSuspendedとなること",
+			token: "ghs_valid",
+			setupFn: func(srv *githubtest.Server) {
+				suspended := installation
+				suspended.SuspendedAt = &suspendedAt
+				srv.AddInstallation("ghs_valid", suspended, repos...)
+			},
+			expectedErr: ErrInstallationSuspended,
+		},
+		{
+			name:  "このAppがインストールされていないリポジトリの場合はErrInvalidInstallationTokenとなること",
+			token: "ghs_valid",
+			setupFn: func(srv *githubtest.Server) {
+				srv.AddInstallation("ghs_valid", installation, repos...)
+				srv.RemoveAppInstallation("tacokumo/app-1")
+			},
+			expectedErr: ErrInvalidInstallationToken,
+		},
+		{
+			name:  "Installationに属さないリポジトリが含まれる場合はErrInvalidInstallationTokenとなること",
+			token: "ghs_valid",
+			setupFn: func(srv *githubtest.Server) {
+				srv.AddInstallation("ghs_valid", installation, repos...)
+				srv.AddInstallation("ghs_valid", installation, github.Repository{FullName: "other/app", HTMLURL: "https://github.com/other/app"})
+			},
+			expectedErr: ErrInvalidInstallationToken,
+		},
+		{
+			name:        "不正なトークンの場合はErrInvalidInstallationTokenとなること",
+			token:       "ghs_invalid",
+			setupFn:     func(srv *githubtest.Server) {},
+			expectedErr: ErrInvalidInstallationToken,
+		},
+		{
+			name:  "アクセスできるリポジトリがない場合はErrInsufficientPermissionsとなること",
+			token: "ghs_valid",
+			setupFn: func(srv *githubtest.Server) {
+				srv.AddInstallation("ghs_valid", installation)
+			},
+			expectedErr: ErrInsufficientPermissions,
+		},
+		{
+			name:  "レート制限の場合はErrRateLimitExceededとなること",
+			token: "ghs_valid",
+			setupFn: func(srv *githubtest.Server) {
+				srv.AddInstallation("ghs_valid", installation, repos...)
+				srv.RateLimit()
+			},
+			expectedErr: ErrRateLimitExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := githubtest.NewServer(t)
+			tt.setupFn(srv)
+			key := newTestRSAKey(t)
+
+			v := NewInstallationVerifierWithKey("12345", key, github.NewClient(srv.URL, srv.Client()))
+			result, err := v.Verify(t.Context(), tt.token)
+			if tt.expectedErr != nil {
+				assert.True(t, errors.Is(err, tt.expectedErr), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, installation.ID, result.Installation.ID)
+			assert.Equal(t, tt.expectedRepositories, result.Repositories)
+
+			// GitHub AppのJWTはAppの秘密鍵で署名され､issがApp IDであること
+			appJWTs := srv.AppJWTs()
+			require.Len(t, appJWTs, 1)
+			claims := jwt.RegisteredClaims{}
+			_, err = jwt.ParseWithClaims(appJWTs[0], &claims, func(*jwt.Token) (any, error) {
+				return &key.PublicKey, nil
+			}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}))
+			assert.NoError(t, err)
+			assert.Equal(t, "12345", claims.Issuer)
+			assert.LessOrEqual(t, claims.ExpiresAt.Sub(claims.IssuedAt.Time), 10*time.Minute)
+		})
+	}
+}
+
+func TestRepositoryKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		url      string
+		expected string
+		ok       bool
+	}{
+		{
+			name:     "HTTPSのURLを正規化できること",
+			url:      "https://github.com/tacokumo/app-1",
+			expected: "github.com/tacokumo/app-1",
+			ok:       true,
+		},
+		{
+			name:     ".gitの接尾辞と大文字小文字を無視すること",
+			url:      "https://GitHub.com/Tacokumo/App-1.git",
+			expected: "github.com/tacokumo/app-1",
+			ok:       true,
+		},
+		{
+			name:     "scp形式のURLを正規化できること",
+			url:      "git@github.com:tacokumo/app-1.git",
+			expected: "github.com/tacokumo/app-1",
+			ok:       true,
+		},
+		{
+			name:     "ssh://形式のURLを正規化できること",
+			url:      "ssh://git@github.com/tacokumo/app-1.git",
+			expected: "github.com/tacokumo/app-1",
+			ok:       true,
+		},
+		{
+			name: "リポジトリを含まないURLは正規化できないこと",
+			url:  "https://github.com/tacokumo",
+		},
+		{
+			name: "URLではない文字列は正規化できないこと",
+			url:  "tacokumo/app-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			key, ok := RepositoryKey(tt.url)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, key)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/github"
+	"golang.org/x/oauth2"
+)
+
+// ADR004 で定義されたOAuthフローのエラー
+var (
+	ErrInvalidRequest = errors.New("invalid_request")
+	ErrAccessDenied   = errors.New("access_denied")
+	ErrStateMismatch  = errors.New("state_mismatch")
+	ErrGitHubAPI      = errors.New("github_api_error")
+)
+
+// AuthMethodOAuth はGitHub OAuthで認証されたことを表す（ADR004 の auth_method）
+const AuthMethodOAuth = "oauth"
+
+const (
+	// stateTTL は認可コードを受け付ける期間（ADR004: 5分以内）
+	stateTTL = 5 * time.Minute
+	// stateBytes はstateパラメータのエントロピー（ADR004: 128bit）
+	stateBytes = 16
+)
+
+var oauthScopes = []string{"read:user", "read:org"}
+
+// OAuth はGitHub OAuthのAuthorization Code Flow（state + PKCE）を扱う
+type OAuth struct {
+	config *oauth2.Config
+	states StateStore
+	github *github.Client
+}
+
+func NewOAuth(
+	cfg config.GitHubConfig,
+	states StateStore,
+	githubClient *github.Client,
+) *OAuth {
+	baseURL := strings.TrimSuffix(cfg.BaseURL, "/")
+	return &OAuth{
+		config: &oauth2.Config{
+			ClientID:     cfg.OAuth.ClientID,
+			ClientSecret: cfg.OAuth.ClientSecret,
+			RedirectURL:  cfg.OAuth.RedirectURL,
+			Scopes:       oauthScopes,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   baseURL + "/login/oauth/authorize",
+				TokenURL:  baseURL + "/login/oauth/access_token",
+				AuthStyle: oauth2.AuthStyleInParams,
+			},
+		},
+		states: states,
+		github: githubClient,
+	}
+}
+
+// AuthCodeURL はstateとPKCEのverifierを発行･保存し､GitHubの認可URLを返す
+func (o *OAuth) AuthCodeURL(ctx context.Context) (string, error) {
+	state, err := randomString(stateBytes)
+	if err != nil {
+		return "", err
+	}
+	verifier := oauth2.GenerateVerifier()
+	if err := o.states.Save(ctx, state, verifier, stateTTL); err != nil {
+		return "", errors.Wrap(err, "failed to save oauth state")
+	}
+	return o.config.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier)), nil
+}
+
+// CallbackRequest はGitHubからのコールバックで受け取るパラメータ
+type CallbackRequest struct {
+	Code  string
+	State string
+	Error string
+}
+
+// LoginResult はOAuthフローが完了したユーザーの情報
+type LoginResult struct {
+	User  *github.User
+	Token *oauth2.Token
+}
+
+// HandleCallback はstateを検証し､認可コードをアクセストークンに交換してユーザー情報を取得する
+func (o *OAuth) HandleCallback(ctx context.Context, req CallbackRequest) (*LoginResult, error) {
+	if req.State == "" {
+		return nil, errors.Mark(errors.New("state parameter is missing"), ErrStateMismatch)
+	}
+	verifier, err := o.states.Consume(ctx, req.State)
+	if err != nil {
+		if errors.Is(err, ErrStateNotFound) {
+			return nil, errors.Mark(err, ErrStateMismatch)
+		}
+		return nil, errors.Wrap(err, "failed to consume oauth state")
+	}
+
+	switch req.Error {
+	case "":
+	case "access_denied":
+		return nil, errors.Mark(errors.New("user denied the authorization request"), ErrAccessDenied)
+	default:
+		return nil, errors.Mark(errors.Newf("github returned error: %s", req.Error), ErrInvalidRequest)
+	}
+	if req.Code == "" {
+		return nil, errors.Mark(errors.New("code parameter is missing"), ErrInvalidRequest)
+	}
+
+	token, err := o.config.Exchange(ctx, req.Code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		var retrieveErr *oauth2.RetrieveError
+		if errors.As(err, &retrieveErr) && retrieveErr.ErrorCode != "" {
+			// bad_verification_code などGitHubが認可コードを拒否したケース
+			return nil, errors.Mark(err, ErrInvalidRequest)
+		}
+		return nil, errors.Mark(err, ErrGitHubAPI)
+	}
+
+	user, err := o.github.GetUser(ctx, token.AccessToken)
+	if err != nil {
+		return nil, errors.Mark(err, ErrGitHubAPI)
+	}
+
+	return &LoginResult{
+		User:  user,
+		Token: token,
+	}, nil
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate random bytes")
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
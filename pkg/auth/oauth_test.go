@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/github"
+	"github.com/tacokumo/portal-api/pkg/github/githubtest"
+)
+
+func newTestOAuth(t *testing.T, srv *githubtest.Server) *OAuth {
+	t.Helper()
+
+	return NewOAuth(config.GitHubConfig{
+		BaseURL:    srv.URL,
+		APIBaseURL: srv.URL,
+		OAuth: config.GitHubOAuthConfig{
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			RedirectURL:  "http://localhost:8080/auth/github/callback",
+		},
+	}, NewMemoryStateStore(), github.NewClient(srv.URL, srv.Client()))
+}
+
+// startLogin はログインを開始し､発行されたstateを返す
+func startLogin(t *testing.T, o *OAuth) string {
+	t.Helper()
+
+	authURL, err := o.AuthCodeURL(t.Context())
+	require.NoError(t, err)
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	return u.Query().Get("state")
+}
+
+func TestOAuth_AuthCodeURL(t *testing.T) {
+	t.Parallel()
+
+	srv := githubtest.NewServer(t)
+	o := newTestOAuth(t, srv)
+
+	authURL, err := o.AuthCodeURL(t.Context())
+	assert.NoError(t, err)
+
+	u, err := url.Parse(authURL)
+	assert.NoError(t, err)
+	assert.Equal(t, "/login/oauth/authorize", u.Path)
+	q := u.Query()
+	assert.Equal(t, "client-id", q.Get("client_id"))
+	assert.Equal(t, "http://localhost:8080/auth/github/callback", q.Get("redirect_uri"))
+	assert.Equal(t, "read:user read:org", q.Get("scope"))
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+	assert.NotEmpty(t, q.Get("code_challenge"))
+	assert.NotEmpty(t, q.Get("state"))
+
+	another, err := o.AuthCodeURL(t.Context())
+	assert.NoError(t, err)
+	assert.NotEqual(t, authURL, another, "stateはリクエストごとに異なること")
+}
+
+func TestOAuth_HandleCallback(t *testing.T) {
+	tests := []struct {
+		name     string
+		reqFn    func(state string) CallbackRequest
+		setup    func(srv *githubtest.Server)
+		expected error
+	}{
+		{
+			name: "認可コードを交換してユーザー情報を取得できること",
+			reqFn: func(state string) CallbackRequest {
+				return CallbackRequest{Code: "valid-code", State: state}
+			},
+		},
+		{
+			name: "stateが空の場合はstate_mismatchとなること",
+			reqFn: func(state string) CallbackRequest {
+				return CallbackRequest{Code: "valid-code"}
+			},
+			expected: ErrStateMismatch,
+		},
+		{
+			name: "発行していないstateの場合はstate_mismatchとなること",
+			reqFn: func(state string) CallbackRequest {
+				return CallbackRequest{Code: "valid-code", State: "forged-state"}
+			},
+			expected: ErrStateMismatch,
+		},
+		{
+			name: "ユーザーが認可を拒否した場合はaccess_deniedとなること",
+			reqFn: func(state string) CallbackRequest {
+				return CallbackRequest{State: state, Error: "access_denied"}
+			},
+			expected: ErrAccessDenied,
+		},
+		{
+			name: "codeが空の場合はinvalid_requestとなること",
+			reqFn: func(state string) CallbackRequest {
+				return CallbackRequest{State: state}
+			},
+			expected: ErrInvalidRequest,
+		},
+		{
+			name: "GitHubが認可コードを拒否した場合はinvalid_requestとなること",
+			reqFn: func(state string) CallbackRequest {
+				return CallbackRequest{Code: "unknown-code", State: state}
+			},
+			expected: ErrInvalidRequest,
+		},
+		{
+			name: "GitHub APIが障害の場合はgithub_api_errorとなること",
+			reqFn: func(state string) CallbackRequest {
+				return CallbackRequest{Code: "valid-code", State: state}
+			},
+			setup: func(srv *githubtest.Server) {
+				srv.Fail(http.StatusInternalServerError)
+			},
+			expected: ErrGitHubAPI,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := githubtest.NewServer(t)
+			srv.AddCode("valid-code", "gho_token")
+			srv.AddUser("gho_token", github.User{ID: 42, Login: "octocat"})
+			o := newTestOAuth(t, srv)
+			state := startLogin(t, o)
+			if tt.setup != nil {
+				tt.setup(srv)
+			}
+
+			ret, err := o.HandleCallback(t.Context(), tt.reqFn(state))
+			if tt.expected != nil {
+				assert.True(t, errors.Is(err, tt.expected), "expected %v, got %v", tt.expected, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(42), ret.User.ID)
+			assert.Equal(t, "octocat", ret.User.Login)
+			assert.Equal(t, "gho_token", ret.Token.AccessToken)
+			assert.Len(t, srv.Verifiers(), 1)
+			assert.NotEmpty(t, srv.Verifiers()[0], "PKCEのverifierが送信されること")
+		})
+	}
+}
+
+func TestOAuth_HandleCallback_stateは再利用できないこと(t *testing.T) {
+	t.Parallel()
+
+	srv := githubtest.NewServer(t)
+	srv.AddCode("valid-code", "gho_token")
+	srv.AddUser("gho_token", github.User{ID: 42, Login: "octocat"})
+	o := newTestOAuth(t, srv)
+	state := startLogin(t, o)
+
+	_, err := o.HandleCallback(t.Context(), CallbackRequest{Code: "valid-code", State: state})
+	assert.NoError(t, err)
+
+	_, err = o.HandleCallback(t.Context(), CallbackRequest{Code: "valid-code", State: state})
+	assert.True(t, errors.Is(err, ErrStateMismatch))
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+	"github.com/tacokumo/portal-api/pkg/github"
+)
+
+// ADR004 で定義されたPATによる認証のエラー
+// 不正なPATはErrInvalidTokenとなる
+var (
+	ErrInsufficientScope = errors.New("insufficient_scope")
+	ErrRateLimitExceeded = errors.New("rate_limit_exceeded")
+)
+
+// AuthMethodPAT はGitHub Personal Access Tokenで認証されたことを表す（ADR004 の auth_method）
+const AuthMethodPAT = "pat"
+
+// requiredPATScopes はPATに必要なスコープ
+// 各要素のいずれかのスコープが付与されていれば良い（上位のスコープは下位のスコープを含む）
+var requiredPATScopes = [][]string{
+	{"read:user", "user"},
+	{"read:org", "write:org", "admin:org"},
+}
+
+// PATVerifier はGitHub Personal Access Tokenを検証する
+type PATVerifier struct {
+	github *github.Client
+}
+
+func NewPATVerifier(githubClient *github.Client) *PATVerifier {
+	return &PATVerifier{
+		github: githubClient,
+	}
+}
+
+// Verify はtokenでGitHub APIを呼び出し､必要なスコープを持つことを確認して所有者を返す
+// Fine-grained PATはスコープを返さないため､権限の不足はOrganization･Teamの参照時に検出される
+func (v *PATVerifier) Verify(ctx context.Context, token string) (*github.User, error) {
+	if token == "" {
+		return nil, errors.Mark(errors.New("personal access token is empty"), ErrInvalidToken)
+	}
+
+	user, scopes, err := v.github.GetUserWithScopes(ctx, token)
+	if err != nil {
+		return nil, markGitHubError(err)
+	}
+	if scopes != nil {
+		for _, candidates := range requiredPATScopes {
+			if !slices.ContainsFunc(candidates, func(scope string) bool { return slices.Contains(scopes, scope) }) {
+				return nil, errors.Mark(
+					errors.Newf("personal access token is missing scope %s", candidates[0]),
+					ErrInsufficientScope,
+				)
+			}
+		}
+	}
+	return user, nil
+}
+
+// markGitHubError はGitHub APIのエラーをADR004のエラーに対応付ける
+func markGitHubError(err error) error {
+	var apiErr *github.APIError
+	switch {
+	case errors.As(err, &apiErr) && apiErr.RateLimited:
+		return errors.Mark(err, ErrRateLimitExceeded)
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized:
+		return errors.Mark(err, ErrInvalidToken)
+	default:
+		return errors.Mark(err, ErrGitHubAPI)
+	}
+}
@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tacokumo/portal-api/pkg/github"
+	"github.com/tacokumo/portal-api/pkg/github/githubtest"
+)
+
+func TestPATVerifier_Verify(t *testing.T) {
+	tests := []struct {
+		name        string
+		token       string
+		scopes      []string
+		rateLimited bool
+		expectedErr error
+	}{
+		{
+			name:   "必要なスコープを持つPATを検証できること",
+			token:  "ghp_valid",
+			scopes: []string{"read:org", "read:user"},
+		},
+		{
+			name:   "上位のスコープを持つPATを検証できること",
+			token:  "ghp_valid",
+			scopes: []string{"admin:org", "repo", "user"},
+		},
+		{
+			name:  "スコープが返らないFine-grained PATを検証できること",
+			token: "ghp_valid",
+		},
+		{
+			name:        "read:orgが付与されていない場合はErrInsufficientScopeとなること",
+			token:       "ghp_valid",
+			scopes:      []string{"read:user"},
+			expectedErr: ErrInsufficientScope,
+		},
+		{
+			name:        "不正なPATの場合はErrInvalidTokenとなること",
+			token:       "ghp_invalid",
+			expectedErr: ErrInvalidToken,
+		},
+		{
+			name:        "空のPATの場合はErrInvalidTokenとなること",
+			token:       "",
+			expectedErr: ErrInvalidToken,
+		},
+		{
+			name:        "レート制限の場合はErrRateLimitExceededとなること",
+			token:       "ghp_valid",
+			rateLimited: true,
+			expectedErr: ErrRateLimitExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := githubtest.NewServer(t)
+			srv.AddUser("ghp_valid", github.User{ID: 42, Login: "octocat"})
+			if tt.scopes != nil {
+				srv.SetScopes("ghp_valid", tt.scopes...)
+			}
+			if tt.rateLimited {
+				srv.RateLimit()
+			}
+
+			v := NewPATVerifier(github.NewClient(srv.URL, srv.Client()))
+			user, err := v.Verify(t.Context(), tt.token)
+			if tt.expectedErr != nil {
+				assert.True(t, errors.Is(err, tt.expectedErr), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, int64(42), user.ID)
+		})
+	}
+}
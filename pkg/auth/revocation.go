@@ -0,0 +1,244 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+// RevocationList は有効期限前に失効させたアクセストークンを管理する
+//
+// JTI･セッション単位の失効はアクセストークンの有効期間だけ保持すれば十分であり､
+// 全トークンの失効は「この時刻より前に発行されたトークンは無効」というエポックで表す
+type RevocationList interface {
+	// RevokeToken はjtiのアクセストークンをttlの間失効させる
+	RevokeToken(ctx context.Context, jti string, ttl time.Duration) error
+	// RevokeSession はsessionIDのセッションで発行されたアクセストークンをttlの間失効させる
+	RevokeSession(ctx context.Context, sessionID string, ttl time.Duration) error
+	// RevokeIssuedBefore はtより前に発行された全てのトークンを失効させる
+	// 既に設定されているエポックより前の時刻は無視する
+	RevokeIssuedBefore(ctx context.Context, t time.Time) error
+	// RevokedBefore は現在のエポックを返す｡未設定の場合はゼロ値を返す
+	RevokedBefore(ctx context.Context) (time.Time, error)
+	// IsRevoked はclaimsのアクセストークンが失効しているかどうかを返す
+	IsRevoked(ctx context.Context, claims *Claims) (bool, error)
+}
+
+// IsIdentityRevoked はidentityのアクセストークンがlistで失効しているかどうかを返す
+// ストリーミングのように認証後も続くリクエストで､接続中に失効したことを確認するために使う
+func IsIdentityRevoked(ctx context.Context, list RevocationList, identity *Identity) (bool, error) {
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{ID: identity.TokenID},
+		SessionID:        identity.SessionID,
+	}
+	if !identity.IssuedAt.IsZero() {
+		claims.IssuedAt = jwt.NewNumericDate(identity.IssuedAt)
+	}
+	return list.IsRevoked(ctx, claims)
+}
+
+// revokedBeforeEpoch はJWTのiatと同じ秒単位の精度でエポックを扱うための丸め
+// iatは秒単位に切り捨てられるため､エポックと同じ秒に発行されたトークンは失効させない
+func revokedBeforeEpoch(t time.Time) time.Time {
+	return t.Truncate(time.Second)
+}
+
+// issuedBefore はclaimsのiatがepochより前かどうかを返す
+func issuedBefore(claims *Claims, epoch time.Time) bool {
+	if epoch.IsZero() || claims.IssuedAt == nil {
+		return false
+	}
+	return claims.IssuedAt.Before(epoch)
+}
+
+// MemoryRevocationList はプロセス内で失効情報を保持するRevocationList
+type MemoryRevocationList struct {
+	mu       sync.Mutex
+	tokens   map[string]time.Time
+	sessions map[string]time.Time
+	epoch    time.Time
+	now      func() time.Time
+}
+
+var _ RevocationList = &MemoryRevocationList{}
+
+func NewMemoryRevocationList() *MemoryRevocationList {
+	return &MemoryRevocationList{
+		tokens:   make(map[string]time.Time),
+		sessions: make(map[string]time.Time),
+		now:      time.Now,
+	}
+}
+
+func (l *MemoryRevocationList) RevokeToken(ctx context.Context, jti string, ttl time.Duration) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweepLocked()
+	l.tokens[jti] = l.now().Add(ttl)
+	return nil
+}
+
+func (l *MemoryRevocationList) RevokeSession(ctx context.Context, sessionID string, ttl time.Duration) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweepLocked()
+	l.sessions[sessionID] = l.now().Add(ttl)
+	return nil
+}
+
+func (l *MemoryRevocationList) RevokeIssuedBefore(ctx context.Context, t time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if epoch := revokedBeforeEpoch(t); epoch.After(l.epoch) {
+		l.epoch = epoch
+	}
+	return nil
+}
+
+func (l *MemoryRevocationList) RevokedBefore(ctx context.Context) (time.Time, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.epoch, nil
+}
+
+func (l *MemoryRevocationList) IsRevoked(ctx context.Context, claims *Claims) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if expiresAt, ok := l.tokens[claims.ID]; ok && now.Before(expiresAt) {
+		return true, nil
+	}
+	if claims.SessionID != "" {
+		if expiresAt, ok := l.sessions[claims.SessionID]; ok && now.Before(expiresAt) {
+			return true, nil
+		}
+	}
+	return issuedBefore(claims, l.epoch), nil
+}
+
+// sweepLocked は期限切れのエントリが溜まり続けないように掃除する
+func (l *MemoryRevocationList) sweepLocked() {
+	now := l.now()
+	for _, entries := range []map[string]time.Time{l.tokens, l.sessions} {
+		for k, expiresAt := range entries {
+			if !now.Before(expiresAt) {
+				delete(entries, k)
+			}
+		}
+	}
+}
+
+// ValkeyRevocationList はValkeyに失効情報を保存するRevocationList
+//
+// キーは以下の通り
+//   - revoked:jti:{jti} 失効したアクセストークン
+//   - revoked:session:{session_id} 失効したセッション
+//   - revoked:before 全トークン失効のエポック（Unix秒）
+type ValkeyRevocationList struct {
+	client redis.UniversalClient
+}
+
+var _ RevocationList = &ValkeyRevocationList{}
+
+func NewValkeyRevocationList(client redis.UniversalClient) *ValkeyRevocationList {
+	return &ValkeyRevocationList{
+		client: client,
+	}
+}
+
+const revokedBeforeKey = "revoked:before"
+
+// revokeIssuedBeforeScript はエポックが後退しないように大きい場合のみ更新する
+var revokeIssuedBeforeScript = redis.NewScript(`
+local current = tonumber(redis.call("GET", KEYS[1]) or "0")
+if tonumber(ARGV[1]) > current then
+	redis.call("SET", KEYS[1], ARGV[1])
+end
+return 0
+`)
+
+func revokedTokenKey(jti string) string {
+	return "revoked:jti:" + jti
+}
+
+func revokedSessionKey(sessionID string) string {
+	return "revoked:session:" + sessionID
+}
+
+func (l *ValkeyRevocationList) RevokeToken(ctx context.Context, jti string, ttl time.Duration) error {
+	if err := l.client.Set(ctx, revokedTokenKey(jti), "1", ttl).Err(); err != nil {
+		return errors.Wrap(err, "failed to revoke token")
+	}
+	return nil
+}
+
+func (l *ValkeyRevocationList) RevokeSession(ctx context.Context, sessionID string, ttl time.Duration) error {
+	if err := l.client.Set(ctx, revokedSessionKey(sessionID), "1", ttl).Err(); err != nil {
+		return errors.Wrap(err, "failed to revoke session")
+	}
+	return nil
+}
+
+func (l *ValkeyRevocationList) RevokeIssuedBefore(ctx context.Context, t time.Time) error {
+	epoch := revokedBeforeEpoch(t).Unix()
+	if err := revokeIssuedBeforeScript.Run(ctx, l.client, []string{revokedBeforeKey}, epoch).Err(); err != nil {
+		return errors.Wrap(err, "failed to revoke tokens")
+	}
+	return nil
+}
+
+func (l *ValkeyRevocationList) RevokedBefore(ctx context.Context) (time.Time, error) {
+	value, err := l.client.Get(ctx, revokedBeforeKey).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, errors.Wrap(err, "failed to get revocation epoch")
+	}
+	return parseEpoch(value)
+}
+
+func (l *ValkeyRevocationList) IsRevoked(ctx context.Context, claims *Claims) (bool, error) {
+	keys := []string{revokedTokenKey(claims.ID), revokedBeforeKey}
+	if claims.SessionID != "" {
+		keys = append(keys, revokedSessionKey(claims.SessionID))
+	}
+	values, err := l.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check revocation")
+	}
+
+	if values[0] != nil {
+		return true, nil
+	}
+	if len(values) > 2 && values[2] != nil {
+		return true, nil
+	}
+	value, ok := values[1].(string)
+	if !ok {
+		return false, nil
+	}
+	epoch, err := parseEpoch(value)
+	if err != nil {
+		return false, err
+	}
+	return issuedBefore(claims, epoch), nil
+}
+
+func parseEpoch(value string) (time.Time, error) {
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to parse revocation epoch")
+	}
+	return time.Unix(seconds, 0), nil
+}
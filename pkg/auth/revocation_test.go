@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// revocationListFactories はRevocationListの各実装に対して同じテストを実行するためのもの
+var revocationListFactories = map[string]func(t *testing.T) RevocationList{
+	"memory": func(t *testing.T) RevocationList {
+		return NewMemoryRevocationList()
+	},
+	"valkey": func(t *testing.T) RevocationList {
+		mr := miniredis.RunT(t)
+		client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+		t.Cleanup(func() { _ = client.Close() })
+		return NewValkeyRevocationList(client)
+	},
+}
+
+func newTestClaims(jti, sessionID string, issuedAt time.Time) *Claims {
+	return &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:  "42",
+			ID:       jti,
+			IssuedAt: jwt.NewNumericDate(issuedAt),
+		},
+		SessionID: sessionID,
+	}
+}
+
+func TestRevocationList(t *testing.T) {
+	for name, newList := range revocationListFactories {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("失効していないトークンは有効であること", func(t *testing.T) {
+				t.Parallel()
+
+				l := newList(t)
+				revoked, err := l.IsRevoked(t.Context(), newTestClaims("jti", "sid", time.Now()))
+				assert.NoError(t, err)
+				assert.False(t, revoked)
+			})
+
+			t.Run("JTIを指定して失効できること", func(t *testing.T) {
+				t.Parallel()
+
+				l := newList(t)
+				require.NoError(t, l.RevokeToken(t.Context(), "jti", time.Minute))
+
+				revoked, err := l.IsRevoked(t.Context(), newTestClaims("jti", "", time.Now()))
+				assert.NoError(t, err)
+				assert.True(t, revoked)
+
+				revoked, err = l.IsRevoked(t.Context(), newTestClaims("other", "", time.Now()))
+				assert.NoError(t, err)
+				assert.False(t, revoked, "他のトークンには影響しないこと")
+			})
+
+			t.Run("セッションを指定して失効できること", func(t *testing.T) {
+				t.Parallel()
+
+				l := newList(t)
+				require.NoError(t, l.RevokeSession(t.Context(), "sid", time.Minute))
+
+				revoked, err := l.IsRevoked(t.Context(), newTestClaims("jti", "sid", time.Now()))
+				assert.NoError(t, err)
+				assert.True(t, revoked)
+
+				revoked, err = l.IsRevoked(t.Context(), newTestClaims("jti", "", time.Now()))
+				assert.NoError(t, err)
+				assert.False(t, revoked, "セッションを持たないトークンには影響しないこと")
+			})
+
+			t.Run("指定した時刻より前に発行されたトークンを失効できること", func(t *testing.T) {
+				t.Parallel()
+
+				l := newList(t)
+				epoch := time.Now().Truncate(time.Second)
+				require.NoError(t, l.RevokeIssuedBefore(t.Context(), epoch))
+
+				revoked, err := l.IsRevoked(t.Context(), newTestClaims("old", "", epoch.Add(-time.Second)))
+				assert.NoError(t, err)
+				assert.True(t, revoked)
+
+				revoked, err = l.IsRevoked(t.Context(), newTestClaims("new", "", epoch))
+				assert.NoError(t, err)
+				assert.False(t, revoked, "エポック以降に発行されたトークンは有効であること")
+
+				before, err := l.RevokedBefore(t.Context())
+				assert.NoError(t, err)
+				assert.True(t, epoch.Equal(before))
+			})
+
+			t.Run("エポックは後退しないこと", func(t *testing.T) {
+				t.Parallel()
+
+				l := newList(t)
+				epoch := time.Now().Truncate(time.Second)
+				require.NoError(t, l.RevokeIssuedBefore(t.Context(), epoch))
+				require.NoError(t, l.RevokeIssuedBefore(t.Context(), epoch.Add(-time.Hour)))
+
+				before, err := l.RevokedBefore(t.Context())
+				assert.NoError(t, err)
+				assert.True(t, epoch.Equal(before))
+			})
+		})
+	}
+}
+
+func TestMemoryRevocationList_有効期限切れの失効情報は破棄されること(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	l := NewMemoryRevocationList()
+	l.now = func() time.Time { return now }
+	require.NoError(t, l.RevokeToken(t.Context(), "jti", time.Minute))
+
+	l.now = func() time.Time { return now.Add(2 * time.Minute) }
+	revoked, err := l.IsRevoked(t.Context(), newTestClaims("jti", "", now))
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+}
+
+func TestIsIdentityRevoked(t *testing.T) {
+	t.Parallel()
+
+	epoch := time.Now().Truncate(time.Second)
+	l := NewMemoryRevocationList()
+	require.NoError(t, l.RevokeToken(t.Context(), "revoked-jti", time.Minute))
+	require.NoError(t, l.RevokeSession(t.Context(), "revoked-sid", time.Minute))
+	require.NoError(t, l.RevokeIssuedBefore(t.Context(), epoch))
+
+	tests := []struct {
+		name     string
+		identity *Identity
+		expected bool
+	}{
+		{
+			name:     "失効していないトークンは有効であること",
+			identity: &Identity{TokenID: "jti", SessionID: "sid", IssuedAt: epoch},
+			expected: false,
+		},
+		{
+			name:     "JTIで失効したトークンは失効していること",
+			identity: &Identity{TokenID: "revoked-jti", IssuedAt: epoch},
+			expected: true,
+		},
+		{
+			name:     "セッションで失効したトークンは失効していること",
+			identity: &Identity{TokenID: "jti", SessionID: "revoked-sid", IssuedAt: epoch},
+			expected: true,
+		},
+		{
+			name:     "エポックより前に発行されたトークンは失効していること",
+			identity: &Identity{TokenID: "jti", IssuedAt: epoch.Add(-time.Second)},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			revoked, err := IsIdentityRevoked(t.Context(), l, tt.identity)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, revoked)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrStateNotFound はstateが未発行､使用済み､または期限切れであることを表す
+var ErrStateNotFound = errors.New("oauth state not found")
+
+// StateStore はOAuthのstateパラメータとPKCEのverifierを一時的に保存する
+type StateStore interface {
+	// Save はstateに紐づくverifierをttlの間保存する
+	Save(ctx context.Context, state, verifier string, ttl time.Duration) error
+	// Consume はstateに紐づくverifierを取り出して削除する
+	// stateは一度しか使えない
+	Consume(ctx context.Context, state string) (string, error)
+}
+
+type memoryStateEntry struct {
+	verifier  string
+	expiresAt time.Time
+}
+
+// MemoryStateStore はプロセス内でstateを保持するStateStore
+type MemoryStateStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryStateEntry
+	now     func() time.Time
+}
+
+var _ StateStore = &MemoryStateStore{}
+
+func NewMemoryStateStore() *MemoryStateStore {
+	return &MemoryStateStore{
+		entries: make(map[string]memoryStateEntry),
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStateStore) Save(ctx context.Context, state, verifier string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	// 期限切れのエントリが溜まり続けないように保存時に掃除する
+	for k, v := range s.entries {
+		if !now.Before(v.expiresAt) {
+			delete(s.entries, k)
+		}
+	}
+	s.entries[state] = memoryStateEntry{
+		verifier:  verifier,
+		expiresAt: now.Add(ttl),
+	}
+	return nil
+}
+
+func (s *MemoryStateStore) Consume(ctx context.Context, state string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[state]
+	if !ok {
+		return "", ErrStateNotFound
+	}
+	delete(s.entries, state)
+	if !s.now().Before(entry.expiresAt) {
+		return "", ErrStateNotFound
+	}
+	return entry.verifier, nil
+}
+
+// ValkeyStateStore はValkeyにstateを保存するStateStore
+// 複数のレプリカで動作する場合も､ログインを開始したものと異なるレプリカでコールバックを受け付けられる
+//
+// キーは oauth:state:{state} であり､値はPKCEのverifier
+type ValkeyStateStore struct {
+	client redis.UniversalClient
+}
+
+var _ StateStore = &ValkeyStateStore{}
+
+func NewValkeyStateStore(client redis.UniversalClient) *ValkeyStateStore {
+	return &ValkeyStateStore{
+		client: client,
+	}
+}
+
+func stateKey(state string) string {
+	return "oauth:state:" + state
+}
+
+func (s *ValkeyStateStore) Save(ctx context.Context, state, verifier string, ttl time.Duration) error {
+	if err := s.client.Set(ctx, stateKey(state), verifier, ttl).Err(); err != nil {
+		return errors.Wrap(err, "failed to save oauth state")
+	}
+	return nil
+}
+
+func (s *ValkeyStateStore) Consume(ctx context.Context, state string) (string, error) {
+	// 同じstateで同時にコールバックされても一方のみが取り出せるように､取得と削除を1回で行う
+	verifier, err := s.client.GetDel(ctx, stateKey(state)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", ErrStateNotFound
+		}
+		return "", errors.Wrap(err, "failed to consume oauth state")
+	}
+	return verifier, nil
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// stateStoreFactories はStateStoreの各実装に対して同じテストを実行するためのもの
+// 2つ目の戻り値は保存したstateの経過時間を進める
+var stateStoreFactories = map[string]func(t *testing.T) (StateStore, func(time.Duration)){
+	"memory": func(t *testing.T) (StateStore, func(time.Duration)) {
+		now := time.Now()
+		s := NewMemoryStateStore()
+		s.now = func() time.Time { return now }
+		return s, func(d time.Duration) { now = now.Add(d) }
+	},
+	"valkey": func(t *testing.T) (StateStore, func(time.Duration)) {
+		mr := miniredis.RunT(t)
+		client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+		t.Cleanup(func() { _ = client.Close() })
+		return NewValkeyStateStore(client), mr.FastForward
+	},
+}
+
+func TestStateStore(t *testing.T) {
+	for name, newStore := range stateStoreFactories {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("保存したstateからverifierを取り出せること", func(t *testing.T) {
+				t.Parallel()
+
+				s, _ := newStore(t)
+				assert.NoError(t, s.Save(t.Context(), "state", "verifier", time.Minute))
+
+				verifier, err := s.Consume(t.Context(), "state")
+				assert.NoError(t, err)
+				assert.Equal(t, "verifier", verifier)
+			})
+
+			t.Run("stateは一度しか使えないこと", func(t *testing.T) {
+				t.Parallel()
+
+				s, _ := newStore(t)
+				assert.NoError(t, s.Save(t.Context(), "state", "verifier", time.Minute))
+
+				_, err := s.Consume(t.Context(), "state")
+				assert.NoError(t, err)
+				_, err = s.Consume(t.Context(), "state")
+				assert.ErrorIs(t, err, ErrStateNotFound)
+			})
+
+			t.Run("保存していないstateは使えないこと", func(t *testing.T) {
+				t.Parallel()
+
+				s, _ := newStore(t)
+				_, err := s.Consume(t.Context(), "forged-state")
+				assert.ErrorIs(t, err, ErrStateNotFound)
+			})
+
+			t.Run("期限切れのstateは使えないこと", func(t *testing.T) {
+				t.Parallel()
+
+				s, advance := newStore(t)
+				assert.NoError(t, s.Save(t.Context(), "state", "verifier", time.Minute))
+
+				advance(2 * time.Minute)
+				_, err := s.Consume(t.Context(), "state")
+				assert.ErrorIs(t, err, ErrStateNotFound)
+			})
+		})
+	}
+}
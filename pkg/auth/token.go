@@ -0,0 +1,244 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/tacokumo/portal-api/pkg/config"
+)
+
+// ErrInvalidToken は署名･有効期限などの検証に失敗したトークンであることを表す
+var ErrInvalidToken = errors.New("invalid_token")
+
+// jtiBytes はJWT ID のエントロピー
+const jtiBytes = 16
+
+// Claims はPortal APIが発行するJWTのペイロード
+// ADR004 の最小限ペイロード（ユーザーID､ロール､有効期限､JTI）に従う
+type Claims struct {
+	jwt.RegisteredClaims
+	Role string `json:"role"`
+	// AuthMethod はoauth､pat､installationのいずれか
+	AuthMethod string `json:"auth_method,omitempty"`
+	// SessionID はリフレッシュトークンを伴うセッションで発行された場合のセッションID
+	SessionID string `json:"sid,omitempty"`
+	// Repositories はInstallation Access Tokenで発行された場合に操作できるリポジトリ
+	Repositories []string `json:"repos,omitempty"`
+}
+
+// TokenSubject はアクセストークンを発行する対象
+type TokenSubject struct {
+	UserID       string
+	Role         string
+	AuthMethod   string
+	SessionID    string
+	Repositories []string
+}
+
+// IssuedToken は発行したアクセストークンとそのClaims
+type IssuedToken struct {
+	Token  string
+	Claims *Claims
+}
+
+// TokenService はRS256によるJWTの発行と検証を行う
+type TokenService struct {
+	privateKey          *rsa.PrivateKey
+	publicKey           *rsa.PublicKey
+	keyID               string
+	accessTokenDuration time.Duration
+	now                 func() time.Time
+}
+
+// NewTokenService はJWTConfigで指定されたPEMファイルから鍵を読み込んでTokenServiceを生成する
+func NewTokenService(cfg config.JWTConfig) (*TokenService, error) {
+	privateKey, err := loadRSAPrivateKey(cfg.PrivateKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := loadRSAPublicKey(cfg.PublicKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	return NewTokenServiceWithKeys(privateKey, publicKey, cfg.AccessTokenDuration)
+}
+
+// NewTokenServiceWithKeys は読み込み済みの鍵からTokenServiceを生成する
+func NewTokenServiceWithKeys(
+	privateKey *rsa.PrivateKey,
+	publicKey *rsa.PublicKey,
+	accessTokenDuration time.Duration,
+) (*TokenService, error) {
+	if !privateKey.PublicKey.Equal(publicKey) {
+		return nil, errors.New("JWT public key does not match the private key")
+	}
+	if accessTokenDuration <= 0 {
+		return nil, errors.New("JWT access token duration must be positive")
+	}
+	return &TokenService{
+		privateKey:          privateKey,
+		publicKey:           publicKey,
+		keyID:               thumbprint(publicKey),
+		accessTokenDuration: accessTokenDuration,
+		now:                 time.Now,
+	}, nil
+}
+
+// AccessTokenDuration はアクセストークンの有効期間を返す
+func (s *TokenService) AccessTokenDuration() time.Duration {
+	return s.accessTokenDuration
+}
+
+// IssueAccessToken はsubjectに対するアクセストークンを発行する
+func (s *TokenService) IssueAccessToken(subject TokenSubject) (*IssuedToken, error) {
+	jti, err := randomString(jtiBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject.UserID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.accessTokenDuration)),
+			ID:        jti,
+		},
+		Role:         subject.Role,
+		AuthMethod:   subject.AuthMethod,
+		SessionID:    subject.SessionID,
+		Repositories: subject.Repositories,
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = s.keyID
+
+	signed, err := token.SignedString(s.privateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign access token")
+	}
+	return &IssuedToken{
+		Token:  signed,
+		Claims: claims,
+	}, nil
+}
+
+// Verify はトークンの署名と有効期限を検証し､Claimsを返す
+// 検証に失敗した場合はErrInvalidTokenでマークされたエラーを返す
+func (s *TokenService) Verify(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
+		return s.publicKey, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithTimeFunc(s.now),
+	)
+	if err != nil {
+		return nil, errors.Mark(err, ErrInvalidToken)
+	}
+	if claims.Subject == "" || claims.ID == "" {
+		return nil, errors.Mark(errors.New("token is missing sub or jti"), ErrInvalidToken)
+	}
+	return claims, nil
+}
+
+// JWK はRFC 7517 のJSON Web Key（RSA公開鍵）
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// JWKS はトークン検証用の公開鍵を返す
+// フロントエンドやCLIはこれを使ってオフラインでトークンを検証できる
+func (s *TokenService) JWKS() []JWK {
+	return []JWK{
+		{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: jwt.SigningMethodRS256.Alg(),
+			Kid: s.keyID,
+			N:   base64.RawURLEncoding.EncodeToString(s.publicKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.publicKey.E)).Bytes()),
+		},
+	}
+}
+
+// thumbprint はRFC 7638 のJWK Thumbprintを鍵IDとして計算する
+func thumbprint(key *rsa.PublicKey) string {
+	// メンバーは辞書順に並べる必要がある
+	b, _ := json.Marshal(struct {
+		E   string `json:"e"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+	}{
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		Kty: "RSA",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+	})
+	sum := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func loadRSAPrivateKey(path string) (*rsa.PrivateKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse JWT private key: %s", path)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.Errorf("JWT private key is not an RSA key: %s", path)
+	}
+	return rsaKey, nil
+}
+
+func loadRSAPublicKey(path string) (*rsa.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse JWT public key: %s", path)
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.Errorf("JWT public key is not an RSA key: %s", path)
+	}
+	return rsaKey, nil
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read key file: %s", path)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.Errorf("no PEM block found in key file: %s", path)
+	}
+	return block, nil
+}
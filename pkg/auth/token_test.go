@@ -0,0 +1,264 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/config"
+)
+
+func newTestRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func newTestTokenService(t *testing.T) *TokenService {
+	t.Helper()
+
+	key := newTestRSAKey(t)
+	s, err := NewTokenServiceWithKeys(key, &key.PublicKey, time.Hour)
+	require.NoError(t, err)
+	return s
+}
+
+func writePEM(t *testing.T, blockType string, der []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+	return path
+}
+
+func TestNewTokenService(t *testing.T) {
+	t.Parallel()
+
+	key := newTestRSAKey(t)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	pkix, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	otherKey := newTestRSAKey(t)
+
+	tests := []struct {
+		name    string
+		cfgFn   func(t *testing.T) config.JWTConfig
+		isError bool
+	}{
+		{
+			name: "PKCS#1形式の鍵ファイルを読み込めること",
+			cfgFn: func(t *testing.T) config.JWTConfig {
+				return config.JWTConfig{
+					PrivateKeyPath:      writePEM(t, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key)),
+					PublicKeyPath:       writePEM(t, "RSA PUBLIC KEY", x509.MarshalPKCS1PublicKey(&key.PublicKey)),
+					AccessTokenDuration: time.Hour,
+				}
+			},
+		},
+		{
+			name: "PKCS#8/PKIX形式の鍵ファイルを読み込めること",
+			cfgFn: func(t *testing.T) config.JWTConfig {
+				return config.JWTConfig{
+					PrivateKeyPath:      writePEM(t, "PRIVATE KEY", pkcs8),
+					PublicKeyPath:       writePEM(t, "PUBLIC KEY", pkix),
+					AccessTokenDuration: time.Hour,
+				}
+			},
+		},
+		{
+			name: "公開鍵と秘密鍵が対応していない場合はエラーとなること",
+			cfgFn: func(t *testing.T) config.JWTConfig {
+				return config.JWTConfig{
+					PrivateKeyPath:      writePEM(t, "PRIVATE KEY", pkcs8),
+					PublicKeyPath:       writePEM(t, "RSA PUBLIC KEY", x509.MarshalPKCS1PublicKey(&otherKey.PublicKey)),
+					AccessTokenDuration: time.Hour,
+				}
+			},
+			isError: true,
+		},
+		{
+			name: "PEMではないファイルの場合はエラーとなること",
+			cfgFn: func(t *testing.T) config.JWTConfig {
+				path := filepath.Join(t.TempDir(), "key.pem")
+				require.NoError(t, os.WriteFile(path, []byte("dummy private key"), 0600))
+				return config.JWTConfig{
+					PrivateKeyPath:      path,
+					PublicKeyPath:       path,
+					AccessTokenDuration: time.Hour,
+				}
+			},
+			isError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := NewTokenService(tt.cfgFn(t))
+			if tt.isError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, s)
+		})
+	}
+}
+
+func TestTokenService_IssueAndVerify(t *testing.T) {
+	t.Parallel()
+
+	s := newTestTokenService(t)
+	issued, err := s.IssueAccessToken(TokenSubject{UserID: "12345", Role: "writer"})
+	require.NoError(t, err)
+
+	claims, err := s.Verify(issued.Token)
+	assert.NoError(t, err)
+	assert.Equal(t, "12345", claims.Subject)
+	assert.Equal(t, "writer", claims.Role)
+	assert.NotEmpty(t, claims.ID)
+	assert.Equal(t, issued.Claims.ID, claims.ID)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), claims.ExpiresAt.Time, time.Minute)
+	assert.Equal(t, claims.ExpiresAt.Time, IdentityFromClaims(claims).ExpiresAt, "Identityにも有効期限を引き継ぐこと")
+	assert.Equal(t, claims.IssuedAt.Time, IdentityFromClaims(claims).IssuedAt, "Identityにも発行時刻を引き継ぐこと")
+
+	another, err := s.IssueAccessToken(TokenSubject{UserID: "12345", Role: "writer"})
+	require.NoError(t, err)
+	assert.NotEqual(t, issued.Claims.ID, another.Claims.ID, "jtiはトークンごとに異なること")
+}
+
+func TestTokenService_Verify(t *testing.T) {
+	t.Parallel()
+
+	s := newTestTokenService(t)
+	other := newTestTokenService(t)
+
+	tests := []struct {
+		name    string
+		tokenFn func(t *testing.T) string
+	}{
+		{
+			name: "有効期限切れのトークンは拒否されること",
+			tokenFn: func(t *testing.T) string {
+				expired := *s
+				expired.now = func() time.Time { return time.Now().Add(-2 * time.Hour) }
+				issued, err := expired.IssueAccessToken(TokenSubject{UserID: "12345", Role: "viewer"})
+				require.NoError(t, err)
+				return issued.Token
+			},
+		},
+		{
+			name: "別の鍵で署名されたトークンは拒否されること",
+			tokenFn: func(t *testing.T) string {
+				issued, err := other.IssueAccessToken(TokenSubject{UserID: "12345", Role: "viewer"})
+				require.NoError(t, err)
+				return issued.Token
+			},
+		},
+		{
+			name: "改ざんされたトークンは拒否されること",
+			tokenFn: func(t *testing.T) string {
+				issued, err := s.IssueAccessToken(TokenSubject{UserID: "12345", Role: "viewer"})
+				require.NoError(t, err)
+				forged, err := s.IssueAccessToken(TokenSubject{UserID: "12345", Role: "writer"})
+				require.NoError(t, err)
+				// 署名はそのままにペイロードだけ差し替える
+				return forged.Token[:len(forged.Token)-len(signature(issued.Token))] + signature(issued.Token)
+			},
+		},
+		{
+			name: "alg=noneのトークンは拒否されること",
+			tokenFn: func(t *testing.T) string {
+				token := jwt.NewWithClaims(jwt.SigningMethodNone, &Claims{
+					RegisteredClaims: jwt.RegisteredClaims{
+						Subject:   "12345",
+						ID:        "jti",
+						ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+					},
+					Role: "writer",
+				})
+				signed, err := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+				require.NoError(t, err)
+				return signed
+			},
+		},
+		{
+			name: "有効期限のないトークンは拒否されること",
+			tokenFn: func(t *testing.T) string {
+				token := jwt.NewWithClaims(jwt.SigningMethodRS256, &Claims{
+					RegisteredClaims: jwt.RegisteredClaims{Subject: "12345", ID: "jti"},
+					Role:             "writer",
+				})
+				signed, err := token.SignedString(s.privateKey)
+				require.NoError(t, err)
+				return signed
+			},
+		},
+		{
+			name: "JWTではない文字列は拒否されること",
+			tokenFn: func(t *testing.T) string {
+				return "not-a-jwt"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := s.Verify(tt.tokenFn(t))
+			assert.True(t, errors.Is(err, ErrInvalidToken), "expected ErrInvalidToken, got %v", err)
+		})
+	}
+}
+
+func TestTokenService_JWKS(t *testing.T) {
+	t.Parallel()
+
+	s := newTestTokenService(t)
+	keys := s.JWKS()
+	require.Len(t, keys, 1)
+	assert.Equal(t, "RSA", keys[0].Kty)
+	assert.Equal(t, "RS256", keys[0].Alg)
+	assert.Equal(t, "sig", keys[0].Use)
+
+	// JWKSから復元した公開鍵で発行したトークンを検証できること
+	n, err := base64.RawURLEncoding.DecodeString(keys[0].N)
+	require.NoError(t, err)
+	e, err := base64.RawURLEncoding.DecodeString(keys[0].E)
+	require.NoError(t, err)
+	publicKey := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+
+	issued, err := s.IssueAccessToken(TokenSubject{UserID: "12345", Role: "viewer"})
+	require.NoError(t, err)
+	token, err := jwt.Parse(issued.Token, func(token *jwt.Token) (any, error) {
+		assert.Equal(t, keys[0].Kid, token.Header["kid"])
+		return publicKey, nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, token.Valid)
+}
+
+func signature(token string) string {
+	for i := len(token) - 1; i >= 0; i-- {
+		if token[i] == '.' {
+			return token[i+1:]
+		}
+	}
+	return ""
+}
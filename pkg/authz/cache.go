@@ -0,0 +1,106 @@
+package authz
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrCacheMiss はキャッシュにユーザーの権限が存在しないことを表す
+var ErrCacheMiss = errors.New("permission cache miss")
+
+// permissionCacheTTL はADR004の権限キャッシュの期間
+const permissionCacheTTL = 15 * time.Minute
+
+// Permissions はGitHubのOrganization･Teamのメンバーシップから決定したユーザーの権限
+type Permissions struct {
+	Role         string   `json:"role"`
+	Organization string   `json:"organization,omitempty"`
+	Teams        []string `json:"teams,omitempty"`
+}
+
+// PermissionCache はユーザーごとの権限をキャッシュする
+type PermissionCache interface {
+	// Get はuserIDの権限を返す｡存在しない場合はErrCacheMissを返す
+	Get(ctx context.Context, userID string) (*Permissions, error)
+	// Set はuserIDの権限をttlの間保存する
+	Set(ctx context.Context, userID string, permissions *Permissions, ttl time.Duration) error
+	// Delete はuserIDの権限を削除する｡存在しない場合もエラーにしない
+	Delete(ctx context.Context, userID string) error
+}
+
+// CacheStats は権限キャッシュのヒット･ミスの回数
+type CacheStats struct {
+	Hits   int64
+	Misses int64
+}
+
+type memoryPermissions struct {
+	permissions Permissions
+	expiresAt   time.Time
+}
+
+// MemoryPermissionCache はプロセス内で権限を保持するPermissionCache
+// Valkeyを利用しない環境向けのフォールバック
+type MemoryPermissionCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryPermissions
+	now     func() time.Time
+}
+
+var _ PermissionCache = &MemoryPermissionCache{}
+
+func NewMemoryPermissionCache() *MemoryPermissionCache {
+	return &MemoryPermissionCache{
+		entries: make(map[string]memoryPermissions),
+		now:     time.Now,
+	}
+}
+
+func (c *MemoryPermissionCache) Get(ctx context.Context, userID string) (*Permissions, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[userID]
+	if !ok {
+		return nil, ErrCacheMiss
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, userID)
+		return nil, ErrCacheMiss
+	}
+	permissions := entry.permissions
+	permissions.Teams = append([]string(nil), entry.permissions.Teams...)
+	return &permissions, nil
+}
+
+func (c *MemoryPermissionCache) Set(ctx context.Context, userID string, permissions *Permissions, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	// 期限切れのエントリが溜まり続けないように保存時に掃除する
+	for id, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, id)
+		}
+	}
+
+	copied := *permissions
+	copied.Teams = append([]string(nil), permissions.Teams...)
+	c.entries[userID] = memoryPermissions{
+		permissions: copied,
+		expiresAt:   now.Add(ttl),
+	}
+	return nil
+}
+
+func (c *MemoryPermissionCache) Delete(ctx context.Context, userID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, userID)
+	return nil
+}
@@ -0,0 +1,84 @@
+package authz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cacheFactories はPermissionCacheの各実装に対して同じテストを実行するためのもの
+// 有効期限を進めるための関数も合わせて返す
+var cacheFactories = map[string]func(t *testing.T) (PermissionCache, func(time.Duration)){
+	"memory": func(t *testing.T) (PermissionCache, func(time.Duration)) {
+		c := NewMemoryPermissionCache()
+		now := time.Now()
+		c.now = func() time.Time { return now }
+		return c, func(d time.Duration) { now = now.Add(d) }
+	},
+	"valkey": func(t *testing.T) (PermissionCache, func(time.Duration)) {
+		mr := miniredis.RunT(t)
+		client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+		t.Cleanup(func() { _ = client.Close() })
+		return NewValkeyPermissionCache(client), mr.FastForward
+	},
+}
+
+func TestPermissionCache(t *testing.T) {
+	for cacheName, newCache := range cacheFactories {
+		t.Run(cacheName, func(t *testing.T) {
+			t.Parallel()
+
+			permissions := &Permissions{
+				Role:         RoleWriter,
+				Organization: "tacokumo",
+				Teams:        []string{"maintainers"},
+			}
+
+			t.Run("保存した権限を取得できること", func(t *testing.T) {
+				t.Parallel()
+
+				c, _ := newCache(t)
+				require.NoError(t, c.Set(t.Context(), "42", permissions, time.Minute))
+
+				got, err := c.Get(t.Context(), "42")
+				assert.NoError(t, err)
+				assert.Equal(t, permissions, got)
+			})
+
+			t.Run("保存されていない場合はErrCacheMissとなること", func(t *testing.T) {
+				t.Parallel()
+
+				c, _ := newCache(t)
+				_, err := c.Get(t.Context(), "42")
+				assert.ErrorIs(t, err, ErrCacheMiss)
+			})
+
+			t.Run("有効期限が切れた場合はErrCacheMissとなること", func(t *testing.T) {
+				t.Parallel()
+
+				c, advance := newCache(t)
+				require.NoError(t, c.Set(t.Context(), "42", permissions, time.Minute))
+				advance(time.Minute)
+
+				_, err := c.Get(t.Context(), "42")
+				assert.ErrorIs(t, err, ErrCacheMiss)
+			})
+
+			t.Run("削除した場合はErrCacheMissとなること", func(t *testing.T) {
+				t.Parallel()
+
+				c, _ := newCache(t)
+				require.NoError(t, c.Set(t.Context(), "42", permissions, time.Minute))
+				require.NoError(t, c.Delete(t.Context(), "42"))
+				assert.NoError(t, c.Delete(t.Context(), "42"), "存在しない場合も削除できること")
+
+				_, err := c.Get(t.Context(), "42")
+				assert.ErrorIs(t, err, ErrCacheMiss)
+			})
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package authz
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/cockroachdb/errors"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/github"
+)
+
+// ErrNotOrgMember は設定されたOrganizationのメンバーではないことを表す（ADR004 の organization_not_member）
+var ErrNotOrgMember = errors.New("organization_not_member")
+
+// RoleResolver はGitHubのOrganization･Teamのメンバーシップからロールを決定する
+// GitHub APIのレート制限を避けるため､決定した権限はユーザーごとにキャッシュする
+type RoleResolver struct {
+	org    config.OrganizationConfig
+	github *github.Client
+	cache  PermissionCache
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+func NewRoleResolver(
+	org config.OrganizationConfig,
+	githubClient *github.Client,
+	cache PermissionCache,
+) *RoleResolver {
+	return &RoleResolver{
+		org:    org,
+		github: githubClient,
+		cache:  cache,
+	}
+}
+
+// Resolve はGitHubのアクセストークンtokenの所有者であるuserIDのロールを返す
+//
+// Organizationのメンバーであることを確認し､所属するTeamに対応付けられたロールのうち最も強いものを返す
+// いずれのTeamにも対応付けがない場合はデフォルトロールとなる
+// Organizationが設定されていない場合は全てのGitHubユーザーがメンバーとなってしまうため､誰にもロールを与えない
+//
+// キャッシュの障害時はGitHub APIを直接参照する
+func (r *RoleResolver) Resolve(ctx context.Context, userID, token string) (string, error) {
+	if permissions, err := r.cache.Get(ctx, userID); err == nil {
+		r.hits.Add(1)
+		return permissions.Role, nil
+	}
+	r.misses.Add(1)
+
+	permissions, err := r.lookup(ctx, token)
+	if err != nil {
+		return "", err
+	}
+	_ = r.cache.Set(ctx, userID, permissions, permissionCacheTTL)
+	return permissions.Role, nil
+}
+
+// Invalidate はuserIDのキャッシュされた権限を削除し､次回のResolveでGitHub APIを参照させる
+func (r *RoleResolver) Invalidate(ctx context.Context, userID string) error {
+	return r.cache.Delete(ctx, userID)
+}
+
+// Stats は権限キャッシュのヒット･ミスの回数を返す
+func (r *RoleResolver) Stats() CacheStats {
+	return CacheStats{
+		Hits:   r.hits.Load(),
+		Misses: r.misses.Load(),
+	}
+}
+
+func (r *RoleResolver) lookup(ctx context.Context, token string) (*Permissions, error) {
+	if r.org.Name == "" {
+		return nil, errors.Mark(errors.New("organization is not configured"), ErrNotOrgMember)
+	}
+
+	membership, err := r.github.GetOrgMembership(ctx, token, r.org.Name)
+	if err != nil {
+		var apiErr *github.APIError
+		if errors.As(err, &apiErr) && apiErr.RateLimited {
+			return nil, errors.Mark(err, auth.ErrRateLimitExceeded)
+		}
+		if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode == http.StatusForbidden) {
+			return nil, errors.Mark(err, ErrNotOrgMember)
+		}
+		return nil, errors.Mark(err, auth.ErrGitHubAPI)
+	}
+	if membership.State != "active" {
+		return nil, errors.Mark(
+			errors.Newf("membership of %s is %s", r.org.Name, membership.State),
+			ErrNotOrgMember,
+		)
+	}
+
+	teams, err := r.github.ListUserTeams(ctx, token)
+	if err != nil {
+		var apiErr *github.APIError
+		if errors.As(err, &apiErr) && apiErr.RateLimited {
+			return nil, errors.Mark(err, auth.ErrRateLimitExceeded)
+		}
+		return nil, errors.Mark(err, auth.ErrGitHubAPI)
+	}
+
+	permissions := &Permissions{
+		Role:         r.org.DefaultRole,
+		Organization: r.org.Name,
+	}
+	for _, team := range teams {
+		if !strings.EqualFold(team.Organization.Login, r.org.Name) {
+			continue
+		}
+		permissions.Teams = append(permissions.Teams, team.Slug)
+		for _, m := range r.org.TeamMappings {
+			if strings.EqualFold(m.Team, team.Slug) || strings.EqualFold(m.Team, team.Name) {
+				permissions.Role = higher(permissions.Role, m.Role)
+			}
+		}
+	}
+	return permissions, nil
+}
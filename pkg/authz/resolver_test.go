@@ -0,0 +1,197 @@
+package authz_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/authz"
+	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/github"
+	"github.com/tacokumo/portal-api/pkg/github/githubtest"
+)
+
+func TestRoleResolver_Resolve(t *testing.T) {
+	t.Parallel()
+
+	orgConfig := config.OrganizationConfig{
+		Name:        "tacokumo",
+		DefaultRole: authz.RoleViewer,
+		TeamMappings: []config.TeamMapping{
+			{Team: "maintainers", Role: authz.RoleWriter},
+			{Team: "developers", Role: authz.RoleViewer},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		org          config.OrganizationConfig
+		isMember     bool
+		teams        []github.Team
+		failure      int
+		rateLimited  bool
+		expectedRole string
+		expectedErr  error
+	}{
+		{
+			name:        "Organizationが設定されていない場合はロールを与えないこと",
+			org:         config.OrganizationConfig{DefaultRole: authz.RoleViewer},
+			isMember:    true,
+			expectedErr: authz.ErrNotOrgMember,
+		},
+		{
+			name:         "対応付けのあるTeamに所属していない場合はデフォルトロールとなること",
+			org:          orgConfig,
+			isMember:     true,
+			expectedRole: authz.RoleViewer,
+		},
+		{
+			name:     "writerに対応付けられたTeamに所属している場合はwriterとなること",
+			org:      orgConfig,
+			isMember: true,
+			teams: []github.Team{
+				{Slug: "developers", Name: "Developers", Organization: github.Organization{Login: "tacokumo"}},
+				{Slug: "maintainers", Name: "Maintainers", Organization: github.Organization{Login: "tacokumo"}},
+			},
+			expectedRole: authz.RoleWriter,
+		},
+		{
+			name:     "Team名でも対応付けられること",
+			org:      config.OrganizationConfig{Name: "tacokumo", DefaultRole: authz.RoleViewer, TeamMappings: []config.TeamMapping{{Team: "Core Maintainers", Role: authz.RoleWriter}}},
+			isMember: true,
+			teams: []github.Team{
+				{Slug: "core-maintainers", Name: "Core Maintainers", Organization: github.Organization{Login: "tacokumo"}},
+			},
+			expectedRole: authz.RoleWriter,
+		},
+		{
+			name:     "他のOrganizationの同名Teamは考慮しないこと",
+			org:      orgConfig,
+			isMember: true,
+			teams: []github.Team{
+				{Slug: "maintainers", Organization: github.Organization{Login: "other-org"}},
+			},
+			expectedRole: authz.RoleViewer,
+		},
+		{
+			name:        "Organizationのメンバーではない場合はErrNotOrgMemberとなること",
+			org:         orgConfig,
+			expectedErr: authz.ErrNotOrgMember,
+		},
+		{
+			name:        "GitHubが障害の場合はErrGitHubAPIとなること",
+			org:         orgConfig,
+			isMember:    true,
+			failure:     http.StatusServiceUnavailable,
+			expectedErr: auth.ErrGitHubAPI,
+		},
+		{
+			name:        "レート制限の場合はErrRateLimitExceededとなること",
+			org:         orgConfig,
+			isMember:    true,
+			rateLimited: true,
+			expectedErr: auth.ErrRateLimitExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := githubtest.NewServer(t)
+			srv.AddUser("valid-token", github.User{ID: 1, Login: "octocat"})
+			if tt.isMember {
+				srv.AddMembership("valid-token", "tacokumo")
+			}
+			for _, team := range tt.teams {
+				srv.AddTeam("valid-token", team)
+			}
+			if tt.failure != 0 {
+				srv.Fail(tt.failure)
+			}
+			if tt.rateLimited {
+				srv.RateLimit()
+			}
+
+			r := authz.NewRoleResolver(tt.org, github.NewClient(srv.URL, srv.Client()), authz.NewMemoryPermissionCache())
+			role, err := r.Resolve(t.Context(), "1", "valid-token")
+			if tt.expectedErr != nil {
+				assert.True(t, errors.Is(err, tt.expectedErr), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRole, role)
+		})
+	}
+}
+
+func TestRoleResolver_Cache(t *testing.T) {
+	t.Parallel()
+
+	orgConfig := config.OrganizationConfig{
+		Name:         "tacokumo",
+		DefaultRole:  authz.RoleViewer,
+		TeamMappings: []config.TeamMapping{{Team: "maintainers", Role: authz.RoleWriter}},
+	}
+	newServer := func(t *testing.T) *githubtest.Server {
+		t.Helper()
+
+		srv := githubtest.NewServer(t)
+		srv.AddUser("valid-token", github.User{ID: 1, Login: "octocat"})
+		srv.AddMembership("valid-token", "tacokumo")
+		srv.AddTeam("valid-token", github.Team{Slug: "maintainers", Organization: github.Organization{Login: "tacokumo"}})
+		return srv
+	}
+
+	t.Run("キャッシュされた権限はGitHub APIを呼び出さずに返すこと", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newServer(t)
+		r := authz.NewRoleResolver(orgConfig, github.NewClient(srv.URL, srv.Client()), authz.NewMemoryPermissionCache())
+
+		role, err := r.Resolve(t.Context(), "1", "valid-token")
+		require.NoError(t, err)
+		assert.Equal(t, authz.RoleWriter, role)
+
+		srv.Fail(http.StatusServiceUnavailable)
+		role, err = r.Resolve(t.Context(), "1", "valid-token")
+		assert.NoError(t, err)
+		assert.Equal(t, authz.RoleWriter, role)
+		assert.Equal(t, authz.CacheStats{Hits: 1, Misses: 1}, r.Stats())
+	})
+
+	t.Run("無効化した場合は再度GitHub APIを参照すること", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newServer(t)
+		r := authz.NewRoleResolver(orgConfig, github.NewClient(srv.URL, srv.Client()), authz.NewMemoryPermissionCache())
+
+		_, err := r.Resolve(t.Context(), "1", "valid-token")
+		require.NoError(t, err)
+		require.NoError(t, r.Invalidate(t.Context(), "1"))
+
+		srv.Fail(http.StatusServiceUnavailable)
+		_, err = r.Resolve(t.Context(), "1", "valid-token")
+		assert.True(t, errors.Is(err, auth.ErrGitHubAPI))
+		assert.Equal(t, authz.CacheStats{Hits: 0, Misses: 2}, r.Stats())
+	})
+
+	t.Run("エラーとなった結果はキャッシュしないこと", func(t *testing.T) {
+		t.Parallel()
+
+		srv := githubtest.NewServer(t)
+		srv.AddUser("valid-token", github.User{ID: 1, Login: "octocat"})
+		r := authz.NewRoleResolver(orgConfig, github.NewClient(srv.URL, srv.Client()), authz.NewMemoryPermissionCache())
+
+		_, err := r.Resolve(t.Context(), "1", "valid-token")
+		require.True(t, errors.Is(err, authz.ErrNotOrgMember))
+
+		srv.AddMembership("valid-token", "tacokumo")
+		role, err := r.Resolve(t.Context(), "1", "valid-token")
+		assert.NoError(t, err)
+		assert.Equal(t, authz.RoleViewer, role)
+	})
+}
@@ -0,0 +1,93 @@
+// Package authz はADR004のGitHub Teamベースの認可を提供する
+package authz
+
+import (
+	"context"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+	"github.com/tacokumo/portal-api/pkg/auth"
+)
+
+// ADR004 で定義された固定ロール
+const (
+	// RoleViewer は読み取り専用のロール
+	RoleViewer = "viewer"
+	// RoleWriter は読み書きができるロール
+	RoleWriter = "writer"
+	// RoleAdmin は読み書きに加えて権限キャッシュの無効化などの管理操作ができるロール
+	RoleAdmin = "admin"
+)
+
+var (
+	// ErrUnauthenticated はcontextに呼び出し元のIdentityが紐付いていないことを表す
+	ErrUnauthenticated = errors.New("unauthenticated")
+	// ErrForbidden は呼び出し元のロールでは操作が許可されていないことを表す
+	ErrForbidden = errors.New("forbidden")
+)
+
+// roleLevels はロールの強さ｡上位のロールは下位のロールの権限を全て持つ
+var roleLevels = map[string]int{
+	RoleViewer: 1,
+	RoleWriter: 2,
+	RoleAdmin:  3,
+}
+
+// Allows はroleがrequiredの権限を持つかどうかを返す
+// 未定義のロールはいずれの権限も持たない
+func Allows(role, required string) bool {
+	level, ok := roleLevels[role]
+	if !ok {
+		return false
+	}
+	return level >= roleLevels[required]
+}
+
+// higher はaとbのうち強い方のロールを返す
+func higher(a, b string) string {
+	if roleLevels[b] > roleLevels[a] {
+		return b
+	}
+	return a
+}
+
+// Authorize はctxの呼び出し元がrequiredのロールを持つことを確認する
+func Authorize(ctx context.Context, required string) error {
+	identity, ok := auth.IdentityFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	if !Allows(identity.Role, required) {
+		return errors.Mark(
+			errors.Newf("role %q is not allowed to perform %q operations", identity.Role, required),
+			ErrForbidden,
+		)
+	}
+	return nil
+}
+
+// IsRepositoryScoped はctxの呼び出し元が操作できるApplicationがリポジトリで限定されているかどうかを返す
+func IsRepositoryScoped(ctx context.Context) bool {
+	identity, ok := auth.IdentityFromContext(ctx)
+	return ok && identity.RepositoryScoped()
+}
+
+// AuthorizeRepository はctxの呼び出し元がrepoURLをリポジトリとするApplicationを操作できることを確認する
+// Installation Access Tokenで認証された呼び出し元はInstallationがアクセスできるリポジトリに限定される
+func AuthorizeRepository(ctx context.Context, repoURL string) error {
+	identity, ok := auth.IdentityFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	if !identity.RepositoryScoped() {
+		return nil
+	}
+	key, ok := auth.RepositoryKey(repoURL)
+	if !ok || !slices.Contains(identity.Repositories, key) {
+		return errors.Mark(
+			errors.Newf("repository %q is not accessible by the installation", repoURL),
+			ErrForbidden,
+		)
+	}
+	return nil
+}
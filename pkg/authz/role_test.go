@@ -0,0 +1,163 @@
+package authz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/authz"
+)
+
+func TestAllows(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		role     string
+		required string
+		expected bool
+	}{
+		{
+			name:     "viewerは読み取りができること",
+			role:     authz.RoleViewer,
+			required: authz.RoleViewer,
+			expected: true,
+		},
+		{
+			name:     "viewerは書き込みができないこと",
+			role:     authz.RoleViewer,
+			required: authz.RoleWriter,
+			expected: false,
+		},
+		{
+			name:     "writerは読み取りができること",
+			role:     authz.RoleWriter,
+			required: authz.RoleViewer,
+			expected: true,
+		},
+		{
+			name:     "writerは書き込みができること",
+			role:     authz.RoleWriter,
+			required: authz.RoleWriter,
+			expected: true,
+		},
+		{
+			name:     "未定義のロールは読み取りもできないこと",
+			role:     "",
+			required: authz.RoleViewer,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, authz.Allows(tt.role, tt.required))
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		required    string
+		expectedErr error
+	}{
+		{
+			name:     "必要なロールを持つ場合は許可されること",
+			ctx:      auth.WithIdentity(context.Background(), &auth.Identity{UserID: "1", Role: authz.RoleWriter}),
+			required: authz.RoleWriter,
+		},
+		{
+			name:        "必要なロールを持たない場合はErrForbiddenとなること",
+			ctx:         auth.WithIdentity(context.Background(), &auth.Identity{UserID: "1", Role: authz.RoleViewer}),
+			required:    authz.RoleWriter,
+			expectedErr: authz.ErrForbidden,
+		},
+		{
+			name:        "Identityが紐付いていない場合はErrUnauthenticatedとなること",
+			ctx:         context.Background(),
+			required:    authz.RoleViewer,
+			expectedErr: authz.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := authz.Authorize(tt.ctx, tt.required)
+			if tt.expectedErr != nil {
+				assert.True(t, errors.Is(err, tt.expectedErr))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthorizeRepository(t *testing.T) {
+	t.Parallel()
+
+	installation := &auth.Identity{
+		UserID:       "installation:100",
+		Role:         authz.RoleWriter,
+		AuthMethod:   auth.AuthMethodInstallation,
+		Repositories: []string{"github.com/tacokumo/app-1"},
+	}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		repoURL     string
+		expectedErr error
+	}{
+		{
+			name:    "リポジトリで限定されていない場合は全てのリポジトリを操作できること",
+			ctx:     auth.WithIdentity(context.Background(), &auth.Identity{UserID: "1", Role: authz.RoleWriter, AuthMethod: auth.AuthMethodOAuth}),
+			repoURL: "https://github.com/tacokumo/app-2.git",
+		},
+		{
+			name:    "Installationがアクセスできるリポジトリは操作できること",
+			ctx:     auth.WithIdentity(context.Background(), installation),
+			repoURL: "https://github.com/tacokumo/app-1.git",
+		},
+		{
+			name:        "Installationがアクセスできないリポジトリの場合はErrForbiddenとなること",
+			ctx:         auth.WithIdentity(context.Background(), installation),
+			repoURL:     "https://github.com/tacokumo/app-2.git",
+			expectedErr: authz.ErrForbidden,
+		},
+		{
+			name:        "別のホストの同名リポジトリの場合はErrForbiddenとなること",
+			ctx:         auth.WithIdentity(context.Background(), installation),
+			repoURL:     "https://example.com/tacokumo/app-1.git",
+			expectedErr: authz.ErrForbidden,
+		},
+		{
+			name:        "Identityが紐付いていない場合はErrUnauthenticatedとなること",
+			ctx:         context.Background(),
+			repoURL:     "https://github.com/tacokumo/app-1.git",
+			expectedErr: authz.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := authz.AuthorizeRepository(tt.ctx, tt.repoURL)
+			if tt.expectedErr != nil {
+				assert.True(t, errors.Is(err, tt.expectedErr))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
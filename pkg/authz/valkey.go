@@ -0,0 +1,60 @@
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// ValkeyPermissionCache はADR004のキー設計（user:{user_id}:permissions）に従ってValkeyに権限を保存するPermissionCache
+type ValkeyPermissionCache struct {
+	client redis.UniversalClient
+}
+
+var _ PermissionCache = &ValkeyPermissionCache{}
+
+func NewValkeyPermissionCache(client redis.UniversalClient) *ValkeyPermissionCache {
+	return &ValkeyPermissionCache{
+		client: client,
+	}
+}
+
+func permissionsKey(userID string) string {
+	return "user:" + userID + ":permissions"
+}
+
+func (c *ValkeyPermissionCache) Get(ctx context.Context, userID string) (*Permissions, error) {
+	data, err := c.client.Get(ctx, permissionsKey(userID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrCacheMiss
+		}
+		return nil, errors.Wrap(err, "failed to get permissions")
+	}
+	permissions := Permissions{}
+	if err := json.Unmarshal(data, &permissions); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal permissions")
+	}
+	return &permissions, nil
+}
+
+func (c *ValkeyPermissionCache) Set(ctx context.Context, userID string, permissions *Permissions, ttl time.Duration) error {
+	data, err := json.Marshal(permissions)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal permissions")
+	}
+	if err := c.client.Set(ctx, permissionsKey(userID), data, ttl).Err(); err != nil {
+		return errors.Wrap(err, "failed to set permissions")
+	}
+	return nil
+}
+
+func (c *ValkeyPermissionCache) Delete(ctx context.Context, userID string) error {
+	if err := c.client.Del(ctx, permissionsKey(userID)).Err(); err != nil {
+		return errors.Wrap(err, "failed to delete permissions")
+	}
+	return nil
+}
@@ -100,6 +100,9 @@ func newTestConfig() *Config {
 				Address: "localhost:6379",
 				DB:      0,
 			},
+			Organization: OrganizationConfig{
+				DefaultRole: "viewer",
+			},
 		},
 		Security: SecurityConfig{
 			CORS: CORSConfig{
@@ -116,5 +119,6 @@ func newAuthEnabledConfig() *Config {
 	cfg.Auth.GitHub.OAuth.ClientSecret = "test-client-secret"
 	cfg.Auth.JWT.PrivateKeyPath = "/path/to/private.key"
 	cfg.Auth.JWT.PublicKeyPath = "/path/to/public.key"
+	cfg.Auth.Organization.Name = "tacokumo"
 	return cfg
 }
@@ -30,11 +30,17 @@ func GenerateDefaultConfig(filename string) error {
 # - GITHUB_CLIENT_SECRET
 # - JWT_PRIVATE_KEY_PATH
 # - JWT_PUBLIC_KEY_PATH
+# - GITHUB_ORGANIZATION
 #
 # オプション環境変数:
 # - GITHUB_APP_ID
 # - GITHUB_APP_PRIVATE_KEY_PATH
 # - VALKEY_PASSWORD
+# - DATABASE_URL
+# - SECRET_MASTER_KEY_PATH（DATABASE_URL設定時､KEY_MANAGER_URLを設定しない場合は必須）
+# - KEY_MANAGER_TOKEN（KEY_MANAGER_URL設定時は必須）
+# - KEY_MANAGER_CA_PATH
+# - KEY_MANAGER_TLS_CERT_PATH､KEY_MANAGER_TLS_KEY_PATH（server keymanagerで使用､両方を設定する）
 #
 `
 
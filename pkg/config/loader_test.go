@@ -475,6 +475,8 @@ func clearAllEnvVars(t *testing.T) {
 		"PORTAL_NAME",
 		"SERVER_PORT",
 		"LOG_LEVEL",
+		"GITHUB_BASE_URL",
+		"GITHUB_API_BASE_URL",
 		"GITHUB_CLIENT_ID",
 		"GITHUB_CLIENT_SECRET",
 		"GITHUB_OAUTH_REDIRECT_URL",
@@ -486,8 +488,12 @@ func clearAllEnvVars(t *testing.T) {
 		"JWT_REFRESH_TOKEN_DURATION",
 		"VALKEY_ADDRESS",
 		"VALKEY_PASSWORD",
+		"GITHUB_ORGANIZATION",
+		"DEFAULT_ROLE",
 		"VALKEY_DB",
 		"CORS_ALLOWED_ORIGINS",
+		"AUDIT_KUBERNETES_EVENTS",
+		"APPLICATION_ALLOWED_GIT_HOSTS",
 	}
 
 	for _, env := range envVars {
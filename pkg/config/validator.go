@@ -2,7 +2,10 @@ package config
 
 import (
 	"github.com/cockroachdb/errors"
+	"net"
+	"net/url"
 	"os"
+	"slices"
 )
 
 func (c *Config) Validate() error {
@@ -24,6 +27,98 @@ func (c *Config) validateBasic() error {
 		return errors.New("server port must be between 1 and 65535")
 	}
 
+	if err := c.validateSecret(); err != nil {
+		return err
+	}
+
+	return c.validateOrganization()
+}
+
+func (c *Config) validateSecret() error {
+	if c.Secret.KeyManager.URL != "" {
+		if c.Secret.KeyManager.Token == "" {
+			return errors.New("KEY_MANAGER_TOKEN is required when KEY_MANAGER_URL is set")
+		}
+		if c.Secret.KeyManager.Timeout <= 0 {
+			return errors.New("KEY_MANAGER_TIMEOUT must be positive")
+		}
+		return validateKeyManagerURL(c.Secret.KeyManager.URL)
+	}
+	if c.Database.URL != "" && c.Secret.MasterKeyPath == "" {
+		return errors.New("SECRET_MASTER_KEY_PATH is required when DATABASE_URL is set")
+	}
+	return nil
+}
+
+// ValidateKeyManager はserver keymanagerで起動するための設定を検証する
+// KeyManagerはportal apiの認証やKubernetesの設定を使わないため､Validateとは別に検証する
+func (c *Config) ValidateKeyManager() error {
+	if c.Database.URL == "" {
+		return errors.New("DATABASE_URL is required for key manager")
+	}
+	if c.Secret.MasterKeyPath == "" {
+		return errors.New("SECRET_MASTER_KEY_PATH is required for key manager")
+	}
+	if c.Secret.KeyManager.Token == "" {
+		return errors.New("KEY_MANAGER_TOKEN is required for key manager")
+	}
+	if c.Secret.KeyManager.Port < 1 || c.Secret.KeyManager.Port > 65535 {
+		return errors.New("key manager port must be between 1 and 65535")
+	}
+	if (c.Secret.KeyManager.TLSCertPath == "") != (c.Secret.KeyManager.TLSKeyPath == "") {
+		return errors.New("KEY_MANAGER_TLS_CERT_PATH and KEY_MANAGER_TLS_KEY_PATH must be set together")
+	}
+	return nil
+}
+
+// validateKeyManagerURL はKEY_MANAGER_URLがhttps､またはループバックアドレスへのhttpであることを確認する
+// KeyManagerとのRPCにはトークンと平文のデータ鍵が含まれるため､ホストの外へ平文で送らない
+func validateKeyManagerURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return errors.New("KEY_MANAGER_URL must be an absolute URL")
+	}
+	switch u.Scheme {
+	case "https":
+		return nil
+	case "http":
+		if isLoopbackHost(u.Hostname()) {
+			return nil
+		}
+		return errors.New("KEY_MANAGER_URL must use https unless the host is a loopback address")
+	default:
+		return errors.Newf("KEY_MANAGER_URL has unsupported scheme %q", u.Scheme)
+	}
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// validRoles はADR004で定義された固定ロールと管理用のadmin
+var validRoles = []string{"viewer", "writer", "admin"}
+
+// validDefaultRoles はデフォルトロールに設定できるロール
+// adminはセッションの失効や鍵のローテーションを行えるため､team_mappingsでのみ与える
+var validDefaultRoles = []string{"viewer", "writer"}
+
+func (c *Config) validateOrganization() error {
+	org := c.Auth.Organization
+	if !slices.Contains(validDefaultRoles, org.DefaultRole) {
+		return errors.Errorf("DEFAULT_ROLE must be one of %v: %q", validDefaultRoles, org.DefaultRole)
+	}
+	for _, m := range org.TeamMappings {
+		if m.Team == "" {
+			return errors.New("team_mappings entry must have a team")
+		}
+		if !slices.Contains(validRoles, m.Role) {
+			return errors.Errorf("role of team %s must be one of %v: %q", m.Team, validRoles, m.Role)
+		}
+	}
 	return nil
 }
 
@@ -46,6 +141,10 @@ func (c *Config) validateAuth() error {
 	if c.Auth.JWT.PublicKeyPath == "" {
 		return errors.New("JWT_PUBLIC_KEY_PATH is required for authentication")
 	}
+	// Organizationを設定しない場合は全てのGitHubユーザーにロールを与えることになるため､必須とする
+	if c.Auth.Organization.Name == "" {
+		return errors.New("GITHUB_ORGANIZATION is required for authentication")
+	}
 
 	// ファイル存在確認
 	if _, err := os.Stat(c.Auth.JWT.PrivateKeyPath); os.IsNotExist(err) {
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -124,6 +125,122 @@ func TestConfig_validateBasic(t *testing.T) {
 			}(),
 			wantErr: false,
 		},
+		{
+			name: "Teamとロールの対応が有効な場合は成功",
+			config: func() *Config {
+				cfg := newTestConfig()
+				cfg.Auth.Organization.TeamMappings = []TeamMapping{
+					{Team: "admins", Role: "admin"},
+					{Team: "maintainers", Role: "writer"},
+					{Team: "developers", Role: "viewer"},
+				}
+				return cfg
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "DefaultRoleが未定義のロールの場合はエラー",
+			config: func() *Config {
+				cfg := newTestConfig()
+				cfg.Auth.Organization.DefaultRole = "owner"
+				return cfg
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "DefaultRoleがadminの場合はエラー",
+			config: func() *Config {
+				cfg := newTestConfig()
+				cfg.Auth.Organization.DefaultRole = "admin"
+				return cfg
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "Teamに未定義のロールが対応付けられている場合はエラー",
+			config: func() *Config {
+				cfg := newTestConfig()
+				cfg.Auth.Organization.TeamMappings = []TeamMapping{{Team: "maintainers", Role: "owner"}}
+				return cfg
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "Teamが空の対応がある場合はエラー",
+			config: func() *Config {
+				cfg := newTestConfig()
+				cfg.Auth.Organization.TeamMappings = []TeamMapping{{Role: "writer"}}
+				return cfg
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "DATABASE_URLとマスター鍵が設定されている場合は成功",
+			config: func() *Config {
+				cfg := newTestConfig()
+				cfg.Database.URL = "postgres://portal@localhost:5432/portal"
+				cfg.Secret.MasterKeyPath = "/etc/portal-api/master.key"
+				return cfg
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "DATABASE_URLが設定されていてマスター鍵がない場合はエラー",
+			config: func() *Config {
+				cfg := newTestConfig()
+				cfg.Database.URL = "postgres://portal@localhost:5432/portal"
+				return cfg
+			}(),
+			wantErr: true,
+		},
+		{
+			name:    "KeyManagerを使う場合はマスター鍵がなくても成功",
+			config:  newKeyManagerClientConfig("https://keymanager:8081"),
+			wantErr: false,
+		},
+		{
+			name: "KeyManagerのトークンがない場合はエラー",
+			config: func() *Config {
+				cfg := newKeyManagerClientConfig("https://keymanager:8081")
+				cfg.Secret.KeyManager.Token = ""
+				return cfg
+			}(),
+			wantErr: true,
+		},
+		{
+			name:    "KeyManagerのURLがループバックアドレスへのhttpの場合は成功",
+			config:  newKeyManagerClientConfig("http://127.0.0.1:8081"),
+			wantErr: false,
+		},
+		{
+			name:    "KeyManagerのURLがlocalhostへのhttpの場合は成功",
+			config:  newKeyManagerClientConfig("http://localhost:8081"),
+			wantErr: false,
+		},
+		{
+			name:    "KeyManagerのURLがループバックアドレス以外へのhttpの場合はエラー",
+			config:  newKeyManagerClientConfig("http://keymanager:8081"),
+			wantErr: true,
+		},
+		{
+			name:    "KeyManagerのURLのスキームが不正な場合はエラー",
+			config:  newKeyManagerClientConfig("ftp://keymanager:8081"),
+			wantErr: true,
+		},
+		{
+			name:    "KeyManagerのURLが相対URLの場合はエラー",
+			config:  newKeyManagerClientConfig("keymanager:8081"),
+			wantErr: true,
+		},
+		{
+			name: "KeyManagerのタイムアウトが0の場合はエラー",
+			config: func() *Config {
+				cfg := newKeyManagerClientConfig("https://keymanager:8081")
+				cfg.Secret.KeyManager.Timeout = 0
+				return cfg
+			}(),
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -140,6 +257,107 @@ func TestConfig_validateBasic(t *testing.T) {
 	}
 }
 
+// newKeyManagerClientConfig はurlのKeyManagerを呼び出すportal apiの設定を生成する
+func newKeyManagerClientConfig(url string) *Config {
+	cfg := newTestConfig()
+	cfg.Database.URL = "postgres://portal@localhost:5432/portal"
+	cfg.Secret.KeyManager.URL = url
+	cfg.Secret.KeyManager.Token = "token"
+	cfg.Secret.KeyManager.Timeout = 10 * time.Second
+	return cfg
+}
+
+func TestConfig_ValidateKeyManager(t *testing.T) {
+	newKeyManagerConfig := func() *Config {
+		cfg := newTestConfig()
+		cfg.Database.URL = "postgres://portal@localhost:5432/portal"
+		cfg.Secret.MasterKeyPath = "/etc/portal-api/master.key"
+		cfg.Secret.KeyManager.Token = "token"
+		cfg.Secret.KeyManager.Port = 8081
+		return cfg
+	}
+
+	tests := []struct {
+		name    string
+		config  *Config
+		wantErr bool
+	}{
+		{
+			name:    "DATABASE_URLとマスター鍵とトークンが設定されている場合は成功",
+			config:  newKeyManagerConfig(),
+			wantErr: false,
+		},
+		{
+			name: "DATABASE_URLがない場合はエラー",
+			config: func() *Config {
+				cfg := newKeyManagerConfig()
+				cfg.Database.URL = ""
+				return cfg
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "マスター鍵がない場合はエラー",
+			config: func() *Config {
+				cfg := newKeyManagerConfig()
+				cfg.Secret.MasterKeyPath = ""
+				return cfg
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "トークンがない場合はエラー",
+			config: func() *Config {
+				cfg := newKeyManagerConfig()
+				cfg.Secret.KeyManager.Token = ""
+				return cfg
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "ポートが範囲外の場合はエラー",
+			config: func() *Config {
+				cfg := newKeyManagerConfig()
+				cfg.Secret.KeyManager.Port = 0
+				return cfg
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "サーバー証明書と秘密鍵が設定されている場合は成功",
+			config: func() *Config {
+				cfg := newKeyManagerConfig()
+				cfg.Secret.KeyManager.TLSCertPath = "/etc/portal-api/tls.crt"
+				cfg.Secret.KeyManager.TLSKeyPath = "/etc/portal-api/tls.key"
+				return cfg
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "サーバー証明書のみ設定されている場合はエラー",
+			config: func() *Config {
+				cfg := newKeyManagerConfig()
+				cfg.Secret.KeyManager.TLSCertPath = "/etc/portal-api/tls.crt"
+				return cfg
+			}(),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.config.ValidateKeyManager()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestConfig_validateAuth(t *testing.T) {
 	t.Parallel()
 
@@ -199,6 +417,18 @@ func TestConfig_validateAuth(t *testing.T) {
 			wantErr: true,
 			errMsg:  "JWT_PUBLIC_KEY_PATH is required",
 		},
+		{
+			name: "GITHUB_ORGANIZATIONが空の場合はエラー",
+			config: func(t *testing.T) *Config {
+				cfg := newAuthEnabledConfig()
+				cfg.Auth.JWT.PrivateKeyPath = createTempKeyFile(t, "dummy private key")
+				cfg.Auth.JWT.PublicKeyPath = createTempKeyFile(t, "dummy public key")
+				cfg.Auth.Organization.Name = ""
+				return cfg
+			},
+			wantErr: true,
+			errMsg:  "GITHUB_ORGANIZATION is required",
+		},
 		{
 			name: "JWT秘密鍵ファイルが存在しない場合はエラー",
 			config: func(t *testing.T) *Config {
@@ -352,6 +582,7 @@ func TestConfig_Validate_統合テスト(t *testing.T) {
 		t.Setenv("GITHUB_CLIENT_SECRET", "integration-client-secret")
 		t.Setenv("JWT_PRIVATE_KEY_PATH", privateKeyFile)
 		t.Setenv("JWT_PUBLIC_KEY_PATH", publicKeyFile)
+		t.Setenv("GITHUB_ORGANIZATION", "tacokumo")
 
 		cfg, err := LoadWithConfigPath("")
 		assert.NoError(t, err)
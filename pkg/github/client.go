@@ -0,0 +1,244 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Client はPortal APIが利用するGitHub REST APIの最小限のクライアント
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient はbaseURLに対してリクエストを送るClientを生成する
+// テストではhttptest.Serverのアドレスを渡すことでGitHubを差し替えられる
+func NewClient(baseURL string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// User はGitHubのユーザー
+type User struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+	Name  string `json:"name"`
+}
+
+// APIError はGitHub APIが2xx以外のステータスを返したことを表す
+type APIError struct {
+	StatusCode int
+	Message    string
+	// RateLimited はレート制限によって拒否されたかどうか
+	RateLimited bool
+}
+
+var _ error = &APIError{}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("github api error: status=%d message=%s", e.StatusCode, e.Message)
+}
+
+// GetUser はtokenの所有者のユーザー情報を取得する
+func (c *Client) GetUser(ctx context.Context, token string) (*User, error) {
+	user := User{}
+	if _, err := c.get(ctx, token, "/user", &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetUserWithScopes はtokenの所有者のユーザー情報とトークンに付与されたOAuthスコープを取得する
+// Fine-grained PATなどX-OAuth-Scopesヘッダーが返らないトークンの場合､スコープはnilとなる
+func (c *Client) GetUserWithScopes(ctx context.Context, token string) (*User, []string, error) {
+	user := User{}
+	resp, err := c.get(ctx, token, "/user", &user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values, ok := resp.Header[http.CanonicalHeaderKey("X-OAuth-Scopes")]
+	if !ok {
+		return &user, nil, nil
+	}
+	scopes := []string{}
+	for _, value := range values {
+		for _, scope := range strings.Split(value, ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return &user, scopes, nil
+}
+
+// Organization はGitHubのOrganization
+type Organization struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+}
+
+// Membership はOrganizationにおけるユーザーのメンバーシップ
+type Membership struct {
+	// State はactiveまたはpending
+	State        string       `json:"state"`
+	Role         string       `json:"role"`
+	Organization Organization `json:"organization"`
+}
+
+// Team はGitHubのTeam
+type Team struct {
+	ID           int64        `json:"id"`
+	Name         string       `json:"name"`
+	Slug         string       `json:"slug"`
+	Organization Organization `json:"organization"`
+}
+
+// teamsPerPage はTeam一覧を取得する際の1ページあたりの件数（GitHub APIの上限）
+const teamsPerPage = 100
+
+// GetOrgMembership はtokenの所有者のorgにおけるメンバーシップを取得する
+// メンバーではない場合は404のAPIErrorを返す
+func (c *Client) GetOrgMembership(ctx context.Context, token, org string) (*Membership, error) {
+	membership := Membership{}
+	if _, err := c.get(ctx, token, "/user/memberships/orgs/"+url.PathEscape(org), &membership); err != nil {
+		return nil, err
+	}
+	return &membership, nil
+}
+
+// ListUserTeams はtokenの所有者が所属する全てのOrganizationのTeamを取得する
+func (c *Client) ListUserTeams(ctx context.Context, token string) ([]Team, error) {
+	var teams []Team
+	for page := 1; ; page++ {
+		var pageTeams []Team
+		path := fmt.Sprintf("/user/teams?per_page=%d&page=%d", teamsPerPage, page)
+		if _, err := c.get(ctx, token, path, &pageTeams); err != nil {
+			return nil, err
+		}
+		teams = append(teams, pageTeams...)
+		if len(pageTeams) < teamsPerPage {
+			return teams, nil
+		}
+	}
+}
+
+// Account はInstallationが紐付くユーザーまたはOrganization
+type Account struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+}
+
+// Installation はGitHub Appのインストール
+type Installation struct {
+	ID      int64   `json:"id"`
+	Account Account `json:"account"`
+	// SuspendedAt はInstallationが停止された日時｡停止されていない場合はnil
+	SuspendedAt *time.Time `json:"suspended_at"`
+}
+
+// Repository はGitHubのリポジトリ
+type Repository struct {
+	ID       int64  `json:"id"`
+	FullName string `json:"full_name"`
+	HTMLURL  string `json:"html_url"`
+}
+
+// reposPerPage はリポジトリ一覧を取得する際の1ページあたりの件数（GitHub APIの上限）
+const reposPerPage = 100
+
+// ListInstallationRepositories はInstallation Access Tokenでアクセスできるリポジトリを取得する
+func (c *Client) ListInstallationRepositories(ctx context.Context, token string) ([]Repository, error) {
+	var repos []Repository
+	for page := 1; ; page++ {
+		body := struct {
+			TotalCount   int          `json:"total_count"`
+			Repositories []Repository `json:"repositories"`
+		}{}
+		path := fmt.Sprintf("/installation/repositories?per_page=%d&page=%d", reposPerPage, page)
+		if _, err := c.get(ctx, token, path, &body); err != nil {
+			return nil, err
+		}
+		repos = append(repos, body.Repositories...)
+		if len(body.Repositories) < reposPerPage || len(repos) >= body.TotalCount {
+			return repos, nil
+		}
+	}
+}
+
+// GetRepositoryInstallation はリポジトリにインストールされたGitHub AppのInstallationを取得する
+// appJWTはGitHub Appの秘密鍵で署名したJWTであり､Appがインストールされていない場合は404のAPIErrorを返す
+func (c *Client) GetRepositoryInstallation(ctx context.Context, appJWT, fullName string) (*Installation, error) {
+	owner, repo, ok := strings.Cut(fullName, "/")
+	if !ok {
+		return nil, errors.Errorf("invalid repository name: %s", fullName)
+	}
+	installation := Installation{}
+	path := fmt.Sprintf("/repos/%s/%s/installation", url.PathEscape(owner), url.PathEscape(repo))
+	if _, err := c.get(ctx, appJWT, path, &installation); err != nil {
+		return nil, err
+	}
+	return &installation, nil
+}
+
+func (c *Client) get(ctx context.Context, token, path string, out any) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build github request")
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to call github api: %s", path)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body := struct {
+			Message string `json:"message"`
+		}{}
+		_ = json.NewDecoder(resp.Body).Decode(&body)
+		return resp, &APIError{
+			StatusCode:  resp.StatusCode,
+			Message:     body.Message,
+			RateLimited: isRateLimited(resp),
+		}
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return resp, errors.Wrapf(err, "failed to decode github response: %s", path)
+		}
+	}
+	return resp, nil
+}
+
+// isRateLimited はレスポンスがプライマリ･セカンダリレート制限によるものかどうかを返す
+// GitHubはレート制限を403または429で返す
+func isRateLimited(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusForbidden:
+		return resp.Header.Get("X-RateLimit-Remaining") == "0" || resp.Header.Get("Retry-After") != ""
+	default:
+		return false
+	}
+}
@@ -0,0 +1,284 @@
+package github_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tacokumo/portal-api/pkg/github"
+	"github.com/tacokumo/portal-api/pkg/github/githubtest"
+)
+
+func TestClient_GetUser(t *testing.T) {
+	tests := []struct {
+		name       string
+		token      string
+		failure    int
+		isError    bool
+		statusCode int
+	}{
+		{
+			name:  "有効なトークンでユーザーを取得できること",
+			token: "valid-token",
+		},
+		{
+			name:       "不正なトークンの場合､401のAPIErrorとなること",
+			token:      "invalid-token",
+			isError:    true,
+			statusCode: http.StatusUnauthorized,
+		},
+		{
+			name:       "GitHubが障害の場合､そのステータスのAPIErrorとなること",
+			token:      "valid-token",
+			failure:    http.StatusServiceUnavailable,
+			isError:    true,
+			statusCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := githubtest.NewServer(t)
+			srv.AddUser("valid-token", github.User{ID: 1, Login: "octocat", Name: "The Octocat"})
+			if tt.failure != 0 {
+				srv.Fail(tt.failure)
+			}
+
+			c := github.NewClient(srv.URL, srv.Client())
+			user, err := c.GetUser(t.Context(), tt.token)
+			if tt.isError {
+				var apiErr *github.APIError
+				assert.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, tt.statusCode, apiErr.StatusCode)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), user.ID)
+			assert.Equal(t, "octocat", user.Login)
+		})
+	}
+}
+
+func TestClient_GetOrgMembership(t *testing.T) {
+	tests := []struct {
+		name       string
+		org        string
+		isError    bool
+		statusCode int
+	}{
+		{
+			name: "メンバーであるOrganizationのメンバーシップを取得できること",
+			org:  "tacokumo",
+		},
+		{
+			name:       "メンバーではないOrganizationの場合､404のAPIErrorとなること",
+			org:        "other-org",
+			isError:    true,
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := githubtest.NewServer(t)
+			srv.AddUser("valid-token", github.User{ID: 1, Login: "octocat"})
+			srv.AddMembership("valid-token", "tacokumo")
+
+			c := github.NewClient(srv.URL, srv.Client())
+			membership, err := c.GetOrgMembership(t.Context(), "valid-token", tt.org)
+			if tt.isError {
+				var apiErr *github.APIError
+				assert.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, tt.statusCode, apiErr.StatusCode)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "active", membership.State)
+			assert.Equal(t, "tacokumo", membership.Organization.Login)
+		})
+	}
+}
+
+func TestClient_ListUserTeams(t *testing.T) {
+	tests := []struct {
+		name      string
+		teamCount int
+	}{
+		{
+			name:      "Teamに所属していない場合､空の一覧となること",
+			teamCount: 0,
+		},
+		{
+			name:      "1ページに収まる場合､全てのTeamを取得できること",
+			teamCount: 3,
+		},
+		{
+			name:      "複数ページにまたがる場合､全てのTeamを取得できること",
+			teamCount: 150,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := githubtest.NewServer(t)
+			srv.AddUser("valid-token", github.User{ID: 1, Login: "octocat"})
+			for i := range tt.teamCount {
+				srv.AddTeam("valid-token", github.Team{
+					ID:           int64(i),
+					Slug:         fmt.Sprintf("team-%d", i),
+					Organization: github.Organization{Login: "tacokumo"},
+				})
+			}
+
+			c := github.NewClient(srv.URL, srv.Client())
+			teams, err := c.ListUserTeams(t.Context(), "valid-token")
+			assert.NoError(t, err)
+			assert.Len(t, teams, tt.teamCount)
+		})
+	}
+}
+
+func TestClient_GetUserWithScopes(t *testing.T) {
+	tests := []struct {
+		name           string
+		scopes         []string
+		rateLimited    bool
+		expectedScopes []string
+		isError        bool
+	}{
+		{
+			name:           "X-OAuth-Scopesヘッダーのスコープを取得できること",
+			scopes:         []string{"read:org", "read:user"},
+			expectedScopes: []string{"read:org", "read:user"},
+		},
+		{
+			name:           "スコープが付与されていない場合は空のスコープとなること",
+			scopes:         []string{},
+			expectedScopes: []string{},
+		},
+		{
+			name:           "X-OAuth-Scopesヘッダーが返らない場合はnilとなること",
+			expectedScopes: nil,
+		},
+		{
+			name:        "レート制限の場合はRateLimitedなAPIErrorとなること",
+			rateLimited: true,
+			isError:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := githubtest.NewServer(t)
+			srv.AddUser("valid-token", github.User{ID: 1, Login: "octocat"})
+			if tt.scopes != nil {
+				srv.SetScopes("valid-token", tt.scopes...)
+			}
+			if tt.rateLimited {
+				srv.RateLimit()
+			}
+
+			c := github.NewClient(srv.URL, srv.Client())
+			user, scopes, err := c.GetUserWithScopes(t.Context(), "valid-token")
+			if tt.isError {
+				var apiErr *github.APIError
+				assert.ErrorAs(t, err, &apiErr)
+				assert.True(t, apiErr.RateLimited)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "octocat", user.Login)
+			assert.Equal(t, tt.expectedScopes, scopes)
+		})
+	}
+}
+
+func TestClient_ListInstallationRepositories(t *testing.T) {
+	tests := []struct {
+		name      string
+		repoCount int
+	}{
+		{
+			name:      "1ページに収まる場合､全てのリポジトリを取得できること",
+			repoCount: 3,
+		},
+		{
+			name:      "複数ページにまたがる場合､全てのリポジトリを取得できること",
+			repoCount: 250,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := githubtest.NewServer(t)
+			repos := make([]github.Repository, 0, tt.repoCount)
+			for i := range tt.repoCount {
+				repos = append(repos, github.Repository{ID: int64(i), FullName: fmt.Sprintf("tacokumo/app-%d", i)})
+			}
+			srv.AddInstallation("ghs_valid", github.Installation{ID: 1}, repos...)
+
+			c := github.NewClient(srv.URL, srv.Client())
+			ret, err := c.ListInstallationRepositories(t.Context(), "ghs_valid")
+			assert.NoError(t, err)
+			assert.Len(t, ret, tt.repoCount)
+		})
+	}
+}
+
+func TestClient_GetRepositoryInstallation(t *testing.T) {
+	tests := []struct {
+		name       string
+		fullName   string
+		isError    bool
+		statusCode int
+	}{
+		{
+			name:     "リポジトリのInstallationを取得できること",
+			fullName: "tacokumo/app-1",
+		},
+		{
+			name:       "Appがインストールされていないリポジトリの場合､404のAPIErrorとなること",
+			fullName:   "tacokumo/unknown",
+			isError:    true,
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := githubtest.NewServer(t)
+			srv.AddInstallation("ghs_valid", github.Installation{ID: 100, Account: github.Account{Login: "tacokumo"}},
+				github.Repository{FullName: "tacokumo/app-1"})
+
+			c := github.NewClient(srv.URL, srv.Client())
+			installation, err := c.GetRepositoryInstallation(t.Context(), "header.payload.signature", tt.fullName)
+			if tt.isError {
+				var apiErr *github.APIError
+				assert.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, tt.statusCode, apiErr.StatusCode)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(100), installation.ID)
+			assert.Equal(t, "tacokumo", installation.Account.Login)
+			assert.Nil(t, installation.SuspendedAt)
+		})
+	}
+}
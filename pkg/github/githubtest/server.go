@@ -0,0 +1,332 @@
+// Package githubtest はテスト用にGitHubのOAuth/REST APIを模倣するサーバーを提供する
+package githubtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tacokumo/portal-api/pkg/github"
+)
+
+// Server はGitHubのOAuthエンドポイントとREST APIのスタンドイン
+// OAuthのベースURLとAPIのベースURLのどちらにもServer.URLを指定できる
+type Server struct {
+	*httptest.Server
+
+	mu sync.Mutex
+	// codes は認可コードと発行するアクセストークンの対応
+	codes map[string]string
+	// users はアクセストークンとユーザーの対応
+	users map[string]github.User
+	// scopes はアクセストークンとX-OAuth-Scopesヘッダーで返すスコープの対応
+	scopes map[string][]string
+	// memberships はアクセストークンとOrganizationごとのメンバーシップの対応
+	memberships map[string]map[string]github.Membership
+	// teams はアクセストークンと所属Teamの対応
+	teams map[string][]github.Team
+	// installations はInstallation Access TokenとInstallationの対応
+	installations map[string]github.Installation
+	// installationRepos はInstallation Access Tokenとアクセスできるリポジトリの対応
+	installationRepos map[string][]github.Repository
+	// repoInstallations はリポジトリのフルネームとインストールされたInstallationの対応
+	repoInstallations map[string]github.Installation
+	// appJWTs はGitHub Appとして呼び出されたAPIで受け取ったJWT
+	appJWTs []string
+	// verifiers はトークン交換時に受け取ったPKCEのverifier
+	verifiers []string
+	// failure が設定されている場合､全てのAPIはこのステータスを返す
+	failure int
+	// rateLimited が設定されている場合､全てのAPIはレート制限のレスポンスを返す
+	rateLimited bool
+}
+
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		codes:       make(map[string]string),
+		users:       make(map[string]github.User),
+		scopes:      make(map[string][]string),
+		memberships: make(map[string]map[string]github.Membership),
+		teams:       make(map[string][]github.Team),
+
+		installations:     make(map[string]github.Installation),
+		installationRepos: make(map[string][]github.Repository),
+		repoInstallations: make(map[string]github.Installation),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /login/oauth/access_token", s.handleAccessToken)
+	mux.HandleFunc("GET /user", s.handleUser)
+	mux.HandleFunc("GET /user/memberships/orgs/{org}", s.handleOrgMembership)
+	mux.HandleFunc("GET /user/teams", s.handleUserTeams)
+	mux.HandleFunc("GET /installation/repositories", s.handleInstallationRepositories)
+	mux.HandleFunc("GET /repos/{owner}/{repo}/installation", s.handleRepositoryInstallation)
+	s.Server = httptest.NewServer(s.withFailure(mux))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// AddUser はtokenで認証できるユーザーを登録する
+func (s *Server) AddUser(token string, user github.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users[token] = user
+}
+
+// SetScopes はtokenに付与されたOAuthスコープを登録する
+// 登録しない場合､Fine-grained PATと同様にX-OAuth-Scopesヘッダーを返さない
+func (s *Server) SetScopes(token string, scopes ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scopes[token] = scopes
+}
+
+// AddMembership はtokenの所有者をorgのactiveなメンバーとして登録する
+func (s *Server) AddMembership(token, org string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.memberships[token] == nil {
+		s.memberships[token] = make(map[string]github.Membership)
+	}
+	s.memberships[token][org] = github.Membership{
+		State:        "active",
+		Role:         "member",
+		Organization: github.Organization{Login: org},
+	}
+}
+
+// AddTeam はtokenの所有者をteamのメンバーとして登録する
+func (s *Server) AddTeam(token string, team github.Team) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.teams[token] = append(s.teams[token], team)
+}
+
+// AddInstallation はtokenをinstallationのInstallation Access Tokenとして登録し､
+// reposにinstallationがインストールされているものとする
+func (s *Server) AddInstallation(token string, installation github.Installation, repos ...github.Repository) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.installations[token] = installation
+	s.installationRepos[token] = append(s.installationRepos[token], repos...)
+	for _, repo := range repos {
+		s.repoInstallations[repo.FullName] = installation
+	}
+}
+
+// RemoveAppInstallation はfullNameのリポジトリにGitHub Appがインストールされていないものとする
+// 他のGitHub AppのInstallation Access Tokenを模倣するために使う
+func (s *Server) RemoveAppInstallation(fullName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.repoInstallations, fullName)
+}
+
+// AppJWTs はGitHub Appとして呼び出されたAPIで受け取ったJWTを返す
+func (s *Server) AppJWTs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.appJWTs...)
+}
+
+// AddCode は交換するとtokenが発行される認可コードを登録する
+func (s *Server) AddCode(code, token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.codes[code] = token
+}
+
+// Verifiers はトークン交換時に受け取ったPKCEのverifierを返す
+func (s *Server) Verifiers() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.verifiers...)
+}
+
+// Fail は以降の全てのリクエストをstatusで失敗させる
+func (s *Server) Fail(status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failure = status
+}
+
+// RateLimit は以降の全てのリクエストをレート制限で失敗させる
+func (s *Server) RateLimit() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimited = true
+}
+
+func (s *Server) withFailure(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		failure, rateLimited := s.failure, s.rateLimited
+		s.mu.Unlock()
+		if rateLimited {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			writeJSON(w, http.StatusForbidden, map[string]string{"message": "API rate limit exceeded"})
+			return
+		}
+		if failure != 0 {
+			writeJSON(w, failure, map[string]string{"message": http.StatusText(failure)})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleAccessToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	s.mu.Lock()
+	token, ok := s.codes[r.PostForm.Get("code")]
+	if ok {
+		delete(s.codes, r.PostForm.Get("code"))
+		s.verifiers = append(s.verifiers, r.PostForm.Get("code_verifier"))
+	}
+	s.mu.Unlock()
+
+	// GitHubは認可コードが不正な場合も200でerrorを返す
+	if !ok {
+		writeJSON(w, http.StatusOK, map[string]string{
+			"error":             "bad_verification_code",
+			"error_description": "The code passed is incorrect or expired.",
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{
+		"access_token": token,
+		"token_type":   "bearer",
+		"scope":        "read:org,read:user",
+	})
+}
+
+func (s *Server) handleUser(w http.ResponseWriter, r *http.Request) {
+	user, ok := s.lookupUser(r)
+	if !ok {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"message": "Bad credentials"})
+		return
+	}
+
+	s.mu.Lock()
+	scopes, ok := s.scopes[bearerToken(r)]
+	s.mu.Unlock()
+	if ok {
+		w.Header().Set("X-OAuth-Scopes", strings.Join(scopes, ", "))
+	}
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) handleOrgMembership(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.lookupUser(r); !ok {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"message": "Bad credentials"})
+		return
+	}
+
+	s.mu.Lock()
+	membership, ok := s.memberships[bearerToken(r)][r.PathValue("org")]
+	s.mu.Unlock()
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "Not Found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, membership)
+}
+
+func (s *Server) handleUserTeams(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.lookupUser(r); !ok {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"message": "Bad credentials"})
+		return
+	}
+
+	perPage, err := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if err != nil || perPage <= 0 {
+		perPage = 30
+	}
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+
+	s.mu.Lock()
+	teams := s.teams[bearerToken(r)]
+	s.mu.Unlock()
+
+	start := min((page-1)*perPage, len(teams))
+	end := min(start+perPage, len(teams))
+	writeJSON(w, http.StatusOK, append([]github.Team{}, teams[start:end]...))
+}
+
+func (s *Server) handleInstallationRepositories(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	installation, ok := s.installations[bearerToken(r)]
+	repos := s.installationRepos[bearerToken(r)]
+	s.mu.Unlock()
+	if !ok {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"message": "Bad credentials"})
+		return
+	}
+	if installation.SuspendedAt != nil {
+		writeJSON(w, http.StatusForbidden, map[string]string{"message": "This installation has been suspended"})
+		return
+	}
+
+	perPage, err := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if err != nil || perPage <= 0 {
+		perPage = 30
+	}
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+	start := min((page-1)*perPage, len(repos))
+	end := min(start+perPage, len(repos))
+	writeJSON(w, http.StatusOK, map[string]any{
+		"total_count":  len(repos),
+		"repositories": append([]github.Repository{}, repos[start:end]...),
+	})
+}
+
+func (s *Server) handleRepositoryInstallation(w http.ResponseWriter, r *http.Request) {
+	// GitHub AppのJWTであることのみを確認し､署名の検証はテスト側で行う
+	token := bearerToken(r)
+	if strings.Count(token, ".") != 2 {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"message": "A JSON web token could not be decoded"})
+		return
+	}
+
+	s.mu.Lock()
+	s.appJWTs = append(s.appJWTs, token)
+	installation, ok := s.repoInstallations[r.PathValue("owner")+"/"+r.PathValue("repo")]
+	s.mu.Unlock()
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "Not Found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, installation)
+}
+
+func (s *Server) lookupUser(r *http.Request) (github.User, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	user, ok := s.users[bearerToken(r)]
+	return user, ok
+}
+
+func bearerToken(r *http.Request) string {
+	return strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,48 @@
+package k8sclient
+
+import (
+	"context"
+	"sync/atomic"
+
+	tacokumov1alpha1 "github.com/tacokumo/portal-controller-kubernetes/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+// Cache はポータルのnamespaceのApplicationをinformerで監視し､読み取りをAPIサーバーに問い合わせずに返すキャッシュ
+// Secretなどを意図せずキャッシュしないように､Application以外の読み取りはエラーとする
+type Cache struct {
+	cache.Cache
+	synced atomic.Bool
+}
+
+func NewCache(restConfig *rest.Config, scheme *runtime.Scheme, namespace string) (*Cache, error) {
+	c, err := cache.New(restConfig, cache.Options{
+		Scheme:                      scheme,
+		DefaultNamespaces:           map[string]cache.Config{namespace: {}},
+		ReaderFailOnMissingInformer: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Cache{Cache: c}, nil
+}
+
+// Start はApplicationのinformerを起動し､ctxが終了するまでキャッシュを更新し続ける
+func (c *Cache) Start(ctx context.Context) error {
+	if _, err := c.Cache.GetInformer(ctx, &tacokumov1alpha1.Application{}); err != nil {
+		return err
+	}
+	go func() {
+		if c.Cache.WaitForCacheSync(ctx) {
+			c.synced.Store(true)
+		}
+	}()
+	return c.Cache.Start(ctx)
+}
+
+// Synced は最初の一覧の取得が完了し､キャッシュから読み取れる状態かどうかを返す
+func (c *Cache) Synced() bool {
+	return c.synced.Load()
+}
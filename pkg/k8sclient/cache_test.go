@@ -0,0 +1,86 @@
+package k8sclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// fakeCache はinformerの起動と同期の結果を指定できるcache.Cache
+type fakeCache struct {
+	cache.Cache
+	informerErr error
+	// synced はWaitForCacheSyncの結果｡値を送るまでWaitForCacheSyncは戻らない
+	synced chan bool
+}
+
+func (c *fakeCache) GetInformer(context.Context, client.Object, ...cache.InformerGetOption) (cache.Informer, error) {
+	return nil, c.informerErr
+}
+
+func (c *fakeCache) WaitForCacheSync(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case synced := <-c.synced:
+		return synced
+	}
+}
+
+func (c *fakeCache) Start(ctx context.Context) error {
+	<-ctx.Done()
+	return nil
+}
+
+func TestCache_Synced(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		synced   bool
+		expected bool
+	}{
+		{name: "最初の一覧の取得が完了した場合はtrueとなること", synced: true, expected: true},
+		{name: "最初の一覧の取得に失敗した場合はfalseのままとなること", synced: false, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &fakeCache{synced: make(chan bool)}
+			c := &Cache{Cache: fake}
+			ctx, cancel := context.WithCancel(t.Context())
+			done := make(chan error, 1)
+			go func() { done <- c.Start(ctx) }()
+
+			assert.False(t, c.Synced(), "同期が完了するまではfalseとなること")
+			fake.synced <- tt.synced
+			if tt.expected {
+				assert.Eventually(t, c.Synced, time.Second, 10*time.Millisecond)
+			} else {
+				assert.Never(t, c.Synced, 100*time.Millisecond, 10*time.Millisecond)
+			}
+
+			cancel()
+			require.NoError(t, <-done)
+		})
+	}
+}
+
+func TestCache_Start_エラー(t *testing.T) {
+	t.Parallel()
+
+	informerErr := errors.New("no kind is registered")
+	c := &Cache{Cache: &fakeCache{informerErr: informerErr, synced: make(chan bool)}}
+
+	err := c.Start(t.Context())
+	assert.ErrorIs(t, err, informerErr)
+	assert.False(t, c.Synced())
+}
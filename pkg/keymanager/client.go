@@ -0,0 +1,94 @@
+package keymanager
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	keymanagerv1 "github.com/tacokumo/portal-api/pkg/apis/keymanager/v1"
+	"github.com/tacokumo/portal-api/pkg/apis/keymanager/v1/keymanagerv1connect"
+	"github.com/tacokumo/portal-api/pkg/secret"
+)
+
+// Client はRPCでserver keymanagerのKeyManagerを呼び出すKeyManager
+type Client struct {
+	client keymanagerv1connect.KeyManagerServiceClient
+}
+
+var _ secret.KeyManager = &Client{}
+
+// NewClient はbaseURLのKeyManagerをtokenで呼び出すClientを生成する
+func NewClient(httpClient connect.HTTPClient, baseURL, token string) *Client {
+	return &Client{
+		client: keymanagerv1connect.NewKeyManagerServiceClient(
+			httpClient,
+			baseURL,
+			connect.WithInterceptors(newTokenInterceptor(token)),
+		),
+	}
+}
+
+func (c *Client) GenerateDataKey(ctx context.Context) (*secret.DataKey, error) {
+	res, err := c.client.GenerateDataKey(ctx, connect.NewRequest(&keymanagerv1.GenerateDataKeyRequest{}))
+	if err != nil {
+		return nil, fromConnectError(err)
+	}
+	return fromProtoDataKey(res.Msg.GetKey()), nil
+}
+
+func (c *Client) Encrypt(ctx context.Context, keyID int64, plaintext, additionalData []byte) ([]byte, error) {
+	res, err := c.client.Encrypt(ctx, connect.NewRequest(&keymanagerv1.EncryptRequest{
+		KeyId:          keyID,
+		Plaintext:      plaintext,
+		AdditionalData: additionalData,
+	}))
+	if err != nil {
+		return nil, fromConnectError(err)
+	}
+	return res.Msg.GetCiphertext(), nil
+}
+
+func (c *Client) Decrypt(ctx context.Context, keyID int64, ciphertext, additionalData []byte) ([]byte, error) {
+	res, err := c.client.Decrypt(ctx, connect.NewRequest(&keymanagerv1.DecryptRequest{
+		KeyId:          keyID,
+		Ciphertext:     ciphertext,
+		AdditionalData: additionalData,
+	}))
+	if err != nil {
+		return nil, fromConnectError(err)
+	}
+	return res.Msg.GetPlaintext(), nil
+}
+
+func (c *Client) Rotate(ctx context.Context) (*secret.DataKey, error) {
+	res, err := c.client.Rotate(ctx, connect.NewRequest(&keymanagerv1.RotateRequest{}))
+	if err != nil {
+		return nil, fromConnectError(err)
+	}
+	return fromProtoDataKey(res.Msg.GetKey()), nil
+}
+
+// newTokenInterceptor は全ての呼び出しにtokenをBearerトークンとして付ける
+func newTokenInterceptor(token string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer "+token)
+			return next(ctx, req)
+		}
+	}
+}
+
+// fromConnectError はNotFoundをsecret.ErrKeyNotFoundに戻す
+func fromConnectError(err error) error {
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		return errors.WithStack(secret.ErrKeyNotFound)
+	}
+	return errors.Wrap(err, "failed to call key manager")
+}
+
+func fromProtoDataKey(key *keymanagerv1.DataKey) *secret.DataKey {
+	return &secret.DataKey{
+		ID:        key.GetId(),
+		CreatedAt: key.GetCreatedAt().AsTime(),
+	}
+}
@@ -0,0 +1,55 @@
+// Package keymanager はADR003のsecret_keysを管理するコンポーネントをRPCで呼び出せるようにする
+package keymanager
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"os"
+
+	"github.com/cockroachdb/errors"
+	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/secret"
+)
+
+// New は設定に応じてKeyManagerを生成する
+// KEY_MANAGER_URLを設定した場合はRPCで呼び出し､設定しない場合はマスター鍵を読み込んでプロセス内でstoreのsecret_keysを管理する
+func New(cfg config.SecretConfig, store secret.Store) (secret.KeyManager, error) {
+	if cfg.KeyManager.URL != "" {
+		httpClient, err := newHTTPClient(cfg.KeyManager)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(httpClient, cfg.KeyManager.URL, cfg.KeyManager.Token), nil
+	}
+	masterKey, err := secret.LoadMasterKey(cfg.MasterKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	return secret.NewLocalKeyManager(store, masterKey)
+}
+
+// newHTTPClient はKeyManagerを呼び出すためのhttp.Clientを生成する
+// KeyManagerが応答しない場合にSecretのAPIが止まり続けないように､1回のRPCにcfg.Timeoutのタイムアウトを設定する
+// cfg.CAPathを設定した場合はそのCA証明書のみでサーバー証明書を検証する
+func newHTTPClient(cfg config.KeyManagerConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.CAPath != "" {
+		data, err := os.ReadFile(cfg.CAPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read key manager CA certificate")
+		}
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(data) {
+			return nil, errors.Newf("no certificates found in %s", cfg.CAPath)
+		}
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    roots,
+		}
+	}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   cfg.Timeout,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package keymanager
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/secret"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	masterKeyPath := filepath.Join(t.TempDir(), "master.key")
+	require.NoError(t, os.WriteFile(masterKeyPath, []byte(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{0x42}, 32))), 0o600))
+
+	tests := []struct {
+		name     string
+		cfg      config.SecretConfig
+		expected secret.KeyManager
+		isError  bool
+	}{
+		{
+			name:     "KEY_MANAGER_URLが設定されている場合はRPCで呼び出すこと",
+			cfg:      config.SecretConfig{KeyManager: config.KeyManagerConfig{URL: "https://keymanager:8081", Token: "token"}},
+			expected: &Client{},
+		},
+		{
+			name: "CA証明書を読み込めない場合はエラーとなること",
+			cfg: config.SecretConfig{KeyManager: config.KeyManagerConfig{
+				URL:    "https://keymanager:8081",
+				Token:  "token",
+				CAPath: filepath.Join(t.TempDir(), "missing.crt"),
+			}},
+			isError: true,
+		},
+		{
+			name: "CA証明書に証明書が含まれない場合はエラーとなること",
+			cfg: config.SecretConfig{KeyManager: config.KeyManagerConfig{
+				URL:    "https://keymanager:8081",
+				Token:  "token",
+				CAPath: masterKeyPath,
+			}},
+			isError: true,
+		},
+		{
+			name:     "KEY_MANAGER_URLが設定されていない場合はプロセス内で管理すること",
+			cfg:      config.SecretConfig{MasterKeyPath: masterKeyPath},
+			expected: &secret.LocalKeyManager{},
+		},
+		{
+			name:    "マスター鍵を読み込めない場合はエラーとなること",
+			cfg:     config.SecretConfig{MasterKeyPath: filepath.Join(t.TempDir(), "missing.key")},
+			isError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			keys, err := New(tt.cfg, secret.NewMemoryStore())
+			if tt.isError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.IsType(t, tt.expected, keys)
+		})
+	}
+}
+
+// newTestTLSServer はプロセス内のKeyManagerをTLSで公開するサーバーを起動し､そのサーバー証明書を書き込んだファイルを返す
+func newTestTLSServer(t *testing.T, handler http.Handler) (*httptest.Server, string) {
+	t.Helper()
+
+	srv := httptest.NewTLSServer(handler)
+	t.Cleanup(srv.Close)
+	caPath := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(caPath, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: srv.Certificate().Raw,
+	}), 0o600))
+	return srv, caPath
+}
+
+func TestNew_RPC(t *testing.T) {
+	t.Parallel()
+
+	keys, err := secret.NewLocalKeyManager(secret.NewMemoryStore(), bytes.Repeat([]byte{0x42}, 32))
+	require.NoError(t, err)
+	mux := http.NewServeMux()
+	mux.Handle(NewServer(keys).Handler("token"))
+	srv, caPath := newTestTLSServer(t, mux)
+	// 応答しないKeyManager
+	slow, slowCAPath := newTestTLSServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+
+	tests := []struct {
+		name    string
+		cfg     config.KeyManagerConfig
+		isError bool
+	}{
+		{
+			name: "CA証明書で検証できるサーバー証明書の場合は呼び出せること",
+			cfg:  config.KeyManagerConfig{URL: srv.URL, Token: "token", CAPath: caPath, Timeout: 10 * time.Second},
+		},
+		{
+			name:    "CA証明書を設定せずシステムの証明書ストアで検証できない場合はエラーとなること",
+			cfg:     config.KeyManagerConfig{URL: srv.URL, Token: "token", Timeout: 10 * time.Second},
+			isError: true,
+		},
+		{
+			name:    "タイムアウトまでに応答しない場合はエラーとなること",
+			cfg:     config.KeyManagerConfig{URL: slow.URL, Token: "token", CAPath: slowCAPath, Timeout: 50 * time.Millisecond},
+			isError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			keys, err := New(config.SecretConfig{KeyManager: tt.cfg}, nil)
+			require.NoError(t, err)
+			_, err = keys.GenerateDataKey(t.Context())
+			if tt.isError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
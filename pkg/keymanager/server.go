@@ -0,0 +1,94 @@
+package keymanager
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	keymanagerv1 "github.com/tacokumo/portal-api/pkg/apis/keymanager/v1"
+	"github.com/tacokumo/portal-api/pkg/apis/keymanager/v1/keymanagerv1connect"
+	"github.com/tacokumo/portal-api/pkg/secret"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Server はKeyManagerをKeyManagerServiceとして公開する
+type Server struct {
+	keys secret.KeyManager
+}
+
+var _ keymanagerv1connect.KeyManagerServiceHandler = &Server{}
+
+func NewServer(keys secret.KeyManager) *Server {
+	return &Server{
+		keys: keys,
+	}
+}
+
+// Handler はKeyManagerServiceのパスとハンドラーを返す
+// KeyManagerは全てのSecretを復号できるため､tokenをBearerトークンとして持たない呼び出しは拒否する
+func (s *Server) Handler(token string) (string, http.Handler) {
+	return keymanagerv1connect.NewKeyManagerServiceHandler(s, connect.WithInterceptors(newAuthInterceptor(token)))
+}
+
+func (s *Server) GenerateDataKey(ctx context.Context, _ *connect.Request[keymanagerv1.GenerateDataKeyRequest]) (*connect.Response[keymanagerv1.GenerateDataKeyResponse], error) {
+	key, err := s.keys.GenerateDataKey(ctx)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&keymanagerv1.GenerateDataKeyResponse{Key: toProtoDataKey(key)}), nil
+}
+
+func (s *Server) Encrypt(ctx context.Context, req *connect.Request[keymanagerv1.EncryptRequest]) (*connect.Response[keymanagerv1.EncryptResponse], error) {
+	ciphertext, err := s.keys.Encrypt(ctx, req.Msg.GetKeyId(), req.Msg.GetPlaintext(), req.Msg.GetAdditionalData())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&keymanagerv1.EncryptResponse{Ciphertext: ciphertext}), nil
+}
+
+func (s *Server) Decrypt(ctx context.Context, req *connect.Request[keymanagerv1.DecryptRequest]) (*connect.Response[keymanagerv1.DecryptResponse], error) {
+	plaintext, err := s.keys.Decrypt(ctx, req.Msg.GetKeyId(), req.Msg.GetCiphertext(), req.Msg.GetAdditionalData())
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&keymanagerv1.DecryptResponse{Plaintext: plaintext}), nil
+}
+
+func (s *Server) Rotate(ctx context.Context, _ *connect.Request[keymanagerv1.RotateRequest]) (*connect.Response[keymanagerv1.RotateResponse], error) {
+	key, err := s.keys.Rotate(ctx)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&keymanagerv1.RotateResponse{Key: toProtoDataKey(key)}), nil
+}
+
+// newAuthInterceptor はAuthorizationヘッダーのBearerトークンがtokenと一致しない呼び出しを拒否する
+func newAuthInterceptor(token string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			given, ok := strings.CutPrefix(req.Header().Get("Authorization"), "Bearer ")
+			if !ok || token == "" || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid token"))
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// toConnectError はクライアントがsecret.ErrKeyNotFoundを判別できるようにNotFoundを返す
+func toConnectError(err error) error {
+	if errors.Is(err, secret.ErrKeyNotFound) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	return err
+}
+
+func toProtoDataKey(key *secret.DataKey) *keymanagerv1.DataKey {
+	return &keymanagerv1.DataKey{
+		Id:        key.ID,
+		CreatedAt: timestamppb.New(key.CreatedAt),
+	}
+}
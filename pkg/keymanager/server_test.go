@@ -0,0 +1,76 @@
+package keymanager
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tacokumo/portal-api/pkg/secret"
+)
+
+// newTestServer はプロセス内のKeyManagerをRPCで公開するサーバーを起動する
+func newTestServer(t *testing.T, token string) (*httptest.Server, *secret.MemoryStore) {
+	t.Helper()
+
+	store := secret.NewMemoryStore()
+	keys, err := secret.NewLocalKeyManager(store, bytes.Repeat([]byte{0x42}, 32))
+	require.NoError(t, err)
+	mux := http.NewServeMux()
+	mux.Handle(NewServer(keys).Handler(token))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, store
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("RPCで暗号化･復号･ローテーションできること", func(t *testing.T) {
+		t.Parallel()
+
+		srv, store := newTestServer(t, "token")
+		vault := secret.NewVault(store, NewClient(srv.Client(), srv.URL, "token"))
+
+		_, err := vault.Create(t.Context(), "example-app-secret", map[string]string{"DB_PASSWORD": "secret123"})
+		require.NoError(t, err)
+		got, err := vault.Get(t.Context(), "example-app-secret")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"DB_PASSWORD": "secret123"}, got.Data)
+
+		rotated, err := vault.Rotate(t.Context())
+		require.NoError(t, err)
+		require.NoError(t, vault.ReencryptAll(t.Context(), 100, nil))
+		got, err = vault.Get(t.Context(), "example-app-secret")
+		require.NoError(t, err)
+		assert.Equal(t, rotated.ID, got.KeyID)
+		assert.Equal(t, map[string]string{"DB_PASSWORD": "secret123"}, got.Data)
+	})
+
+	t.Run("存在しない鍵はsecret.ErrKeyNotFoundを返すこと", func(t *testing.T) {
+		t.Parallel()
+
+		srv, _ := newTestServer(t, "token")
+		_, err := NewClient(srv.Client(), srv.URL, "token").Decrypt(t.Context(), 1, []byte("ciphertext"), nil)
+		assert.ErrorIs(t, err, secret.ErrKeyNotFound)
+	})
+
+	t.Run("トークンが一致しない場合は拒否すること", func(t *testing.T) {
+		t.Parallel()
+
+		srv, _ := newTestServer(t, "token")
+		_, err := NewClient(srv.Client(), srv.URL, "wrong-token").GenerateDataKey(t.Context())
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("サーバーのトークンが空の場合は全て拒否すること", func(t *testing.T) {
+		t.Parallel()
+
+		srv, _ := newTestServer(t, "")
+		_, err := NewClient(srv.Client(), srv.URL, "").GenerateDataKey(t.Context())
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+}
@@ -0,0 +1,90 @@
+package platform
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v5"
+	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/keymanager"
+	"github.com/tacokumo/portal-api/pkg/secret"
+)
+
+// KeyManagerServer はADR003のsecret_keysを管理するコンポーネントをportal apiとは別のプロセスとして起動する
+// マスター鍵はこのプロセスのみが読み込み､portal apiはKEY_MANAGER_URLからRPCで呼び出す
+// KEY_MANAGER_TLS_CERT_PATHを設定しない場合は127.0.0.1のみで待ち受ける
+type KeyManagerServer struct {
+	logger *slog.Logger
+}
+
+func NewKeyManagerServer(logger *slog.Logger) *KeyManagerServer {
+	return &KeyManagerServer{
+		logger: logger,
+	}
+}
+
+func (s *KeyManagerServer) Start(ctx context.Context) error {
+	cfg := config.LoadFromEnv()
+	if err := cfg.ValidateKeyManager(); err != nil {
+		s.logger.ErrorContext(ctx, "invalid configuration", "error", err)
+		return err
+	}
+
+	masterKey, err := secret.LoadMasterKey(cfg.Secret.MasterKeyPath)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to load master key", "error", err)
+		return err
+	}
+	store, closeStore, err := secret.OpenPostgres(ctx, cfg.Database)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to open secret store", "error", err)
+		return err
+	}
+	defer closeStore()
+	keys, err := secret.NewLocalKeyManager(store, masterKey)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create key manager", "error", err)
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(keymanager.NewServer(keys).Handler(cfg.Secret.KeyManager.Token))
+	sc := echo.StartConfig{
+		Address:         fmt.Sprintf(":%d", cfg.Secret.KeyManager.Port),
+		HideBanner:      true,
+		GracefulTimeout: 5 * time.Second,
+		BeforeServeFunc: func(srv *http.Server) error {
+			srv.Protocols = new(http.Protocols)
+			srv.Protocols.SetHTTP1(true)
+			srv.Protocols.SetHTTP2(true)
+			// gRPCのクライアントからも呼び出せるように､TLSなしのHTTP/2も受け付ける
+			srv.Protocols.SetUnencryptedHTTP2(true)
+			return nil
+		},
+	}
+	if cfg.Secret.KeyManager.TLSCertPath == "" {
+		// TLSなしではトークンとデータ鍵が平文で流れるため､同じホストのportal apiからのみ呼び出せるようにする
+		s.logger.WarnContext(ctx, "TLS is not configured; key manager listens only on the loopback address")
+		sc.Address = fmt.Sprintf("127.0.0.1:%d", cfg.Secret.KeyManager.Port)
+	} else {
+		cert, err := tls.LoadX509KeyPair(cfg.Secret.KeyManager.TLSCertPath, cfg.Secret.KeyManager.TLSKeyPath)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to load TLS certificate", "error", err)
+			return err
+		}
+		sc.TLSConfig = &tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			Certificates: []tls.Certificate{cert},
+			NextProtos:   []string{"h2", "http/1.1"},
+		}
+	}
+	if err := sc.Start(ctx, mux); err != nil {
+		s.logger.ErrorContext(ctx, "failed to start key manager", "error", err)
+		return err
+	}
+	return nil
+}
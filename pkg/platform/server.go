@@ -7,10 +7,19 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v5"
+	"github.com/labstack/echo/v5/middleware"
 	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1"
 	"github.com/tacokumo/portal-api/pkg/apis/v1alpha1/api"
+	"github.com/tacokumo/portal-api/pkg/audit"
+	"github.com/tacokumo/portal-api/pkg/auth"
+	"github.com/tacokumo/portal-api/pkg/authz"
 	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/github"
 	"github.com/tacokumo/portal-api/pkg/k8sclient"
+	"github.com/tacokumo/portal-api/pkg/keymanager"
+	"github.com/tacokumo/portal-api/pkg/secret"
+	"github.com/tacokumo/portal-api/pkg/session"
+	"github.com/tacokumo/portal-api/pkg/valkeyclient"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -51,23 +60,182 @@ func (s *Server) Start(ctx context.Context) error {
 		s.logger.ErrorContext(ctx, "failed to create scheme", "error", err)
 		return err
 	}
-	k8sClient, err := client.New(restConfig, client.Options{
+	// WatchApplicationsのためにwatchをサポートするclientを使う
+	k8sClient, err := client.NewWithWatch(restConfig, client.Options{
 		Scheme: scheme,
 	})
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to create k8s client", "error", err)
 		return err
 	}
-	apiServer, err := api.NewServer(v1alpha1.NewHandler(cfg, k8sClient))
+	// Applicationの参照はinformerのキャッシュから返し､APIサーバーへの負荷と応答時間を抑える
+	// 書き込みや更新のための読み取りは引き続きk8sClientでAPIサーバーに直接行う
+	appCache, err := k8sclient.NewCache(restConfig, scheme, cfg.PortalName)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create k8s cache", "error", err)
+		return err
+	}
+	// キャッシュが停止すると参照系のAPIが古い状態を返し続けるため､サーバーも停止してエラーを返す
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	cacheErr := make(chan error, 1)
+	go func() {
+		if err := appCache.Start(ctx); err != nil {
+			cacheErr <- err
+			cancel()
+		}
+	}()
+	secrets, err := s.newSecretVault(ctx, cfg)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create secret vault", "error", err)
+		return err
+	}
+	var rotator *secret.Rotator
+	if secrets != nil {
+		go s.syncSecrets(ctx, secret.NewSyncer(secrets, k8sClient, cfg.PortalName), cfg.Secret.SyncInterval)
+		// 再暗号化はReplaceSecretで変更されていない場合のみ置き換えるため､複数のレプリカで同時に実行してもよい
+		rotator = secret.NewRotator(secrets, cfg.Secret.RotationBatchSize)
+		go rotator.Run(ctx)
+	}
+	tokens, err := s.newTokenService(cfg)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create token service", "error", err)
+		return err
+	}
+	audits := s.newAuditLogger(cfg, k8sClient)
+	authService, adminService, revocations, err := s.newAuthService(cfg, tokens, audits)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create auth service", "error", err)
+		return err
+	}
+	handler := v1alpha1.NewHandler(cfg, k8sClient, appCache, secrets, rotator, authService, adminService, audits, s.logger)
+	apiServer, err := api.NewServer(
+		handler,
+		v1alpha1.NewSecurityHandler(tokens, revocations, audits),
+		api.WithErrorHandler(handler.HandleError),
+	)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to create API server", "error", err)
 		return err
 	}
 
-	e.Any("*", echo.WrapHandler(apiServer))
+	// 監査ログとリクエストを突き合わせられるように､リクエストIDをcontextに紐付ける
+	e.Use(middleware.RequestIDWithConfig(middleware.RequestIDConfig{
+		RequestIDHandler: func(c *echo.Context, requestID string) {
+			c.SetRequest(c.Request().WithContext(audit.WithRequestID(c.Request().Context(), requestID)))
+		},
+	}))
+	e.Any("*", echo.WrapHandler(v1alpha1.StreamingMiddleware(apiServer)))
 	if err := sc.Start(ctx, e); err != nil {
 		s.logger.ErrorContext(ctx, "failed to start server", "error", err)
 		return err
 	}
-	return nil
+	select {
+	case err := <-cacheErr:
+		s.logger.ErrorContext(ctx, "failed to start k8s cache", "error", err)
+		return err
+	default:
+		return nil
+	}
+}
+
+// newTokenService はJWTの署名鍵が設定されている場合にTokenServiceを生成する
+// 署名鍵が設定されていない場合､認証が必要なAPIは全て401を返す
+func (s *Server) newTokenService(cfg *config.Config) (*auth.TokenService, error) {
+	if cfg.Auth.JWT.PrivateKeyPath == "" {
+		s.logger.Warn("JWT signing keys are not configured; authenticated APIs will reject all requests")
+		return nil, nil
+	}
+	return auth.NewTokenService(cfg.Auth.JWT)
+}
+
+// newSecretVault はPostgreSQLが設定されている場合にSecretを暗号化して保存するVaultを生成する
+// PostgreSQLが設定されていない場合､SecretのAPIは全て503を返す
+func (s *Server) newSecretVault(ctx context.Context, cfg *config.Config) (*secret.Vault, error) {
+	if cfg.Database.URL == "" {
+		s.logger.Warn("PostgreSQL is not configured; application secret APIs are disabled")
+		return nil, nil
+	}
+	// PostgreSQLへの接続はプロセスの終了まで使い続けるため閉じない
+	store, _, err := secret.OpenPostgres(ctx, cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+	// KEY_MANAGER_URLを設定した場合はserver keymanagerを呼び出し､portal apiはマスター鍵を持たない
+	keys, err := keymanager.New(cfg.Secret, store)
+	if err != nil {
+		return nil, err
+	}
+	return secret.NewVault(store, keys), nil
+}
+
+// syncSecrets は起動時とintervalごとにPostgreSQLの全てのSecretをKubernetes Secretに反映する
+// クラスタを作り直した場合や､Kubernetes Secretが手動で変更された場合もPostgreSQLの値に戻す
+func (s *Server) syncSecrets(ctx context.Context, syncer *secret.Syncer, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := syncer.SyncAll(ctx); err != nil {
+			s.logger.ErrorContext(ctx, "failed to sync secrets", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// newAuditLogger は設定に応じた出力先を持つ監査ログのLoggerを生成する
+func (s *Server) newAuditLogger(cfg *config.Config, k8sClient client.Client) *audit.Logger {
+	sinks := []audit.Sink{audit.NewSlogSink(s.logger)}
+	if cfg.Security.Audit.KubernetesEvents {
+		sinks = append(sinks, audit.NewKubernetesEventSink(k8sClient))
+	}
+	return audit.NewLogger(s.logger, sinks...)
+}
+
+// newAuthService は設定に応じてAuthServiceと､権限キャッシュ･セッション･失効リストを共有するAdminServiceを生成する
+// GitHub OAuthが設定されていない場合はOAuthによるログインを無効にし､
+// GitHub Appが設定されていない場合はInstallation Access Tokenの交換を無効にする
+func (s *Server) newAuthService(cfg *config.Config, tokens *auth.TokenService, audits *audit.Logger) (*v1alpha1.AuthService, *v1alpha1.AdminService, auth.RevocationList, error) {
+	if tokens == nil {
+		return v1alpha1.NewAuthService(nil, nil, nil, nil, nil, nil, nil, audits), v1alpha1.NewAdminService(nil, nil, nil, nil, audits), nil, nil
+	}
+
+	githubClient := github.NewClient(cfg.Auth.GitHub.APIBaseURL, nil)
+	pats := auth.NewPATVerifier(githubClient)
+
+	var installations *auth.InstallationVerifier
+	if cfg.Auth.GitHub.App.AppID == "" || cfg.Auth.GitHub.App.PrivateKeyPath == "" {
+		s.logger.Warn("GitHub App is not configured; installation token exchange is disabled")
+	} else {
+		v, err := auth.NewInstallationVerifier(cfg.Auth.GitHub.App, githubClient)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		installations = v
+	}
+
+	// ValkeyはOAuthのセッションのために必要であり､OAuthを利用しない場合は権限キャッシュと失効リストをプロセス内に持つ
+	if cfg.Auth.GitHub.OAuth.ClientID == "" {
+		s.logger.Warn("GitHub OAuth is not configured; OAuth login endpoints are disabled")
+		roles := authz.NewRoleResolver(cfg.Auth.Organization, githubClient, authz.NewMemoryPermissionCache())
+		revocations := auth.NewMemoryRevocationList()
+		return v1alpha1.NewAuthService(nil, pats, installations, roles, tokens, nil, revocations, audits),
+			v1alpha1.NewAdminService(roles, tokens, nil, revocations, audits),
+			revocations,
+			nil
+	}
+
+	valkey := valkeyclient.NewClient(cfg.Auth.Valkey)
+	roles := authz.NewRoleResolver(cfg.Auth.Organization, githubClient, authz.NewValkeyPermissionCache(valkey))
+	oauth := auth.NewOAuth(cfg.Auth.GitHub, auth.NewValkeyStateStore(valkey), githubClient)
+	sessions := session.NewManager(session.NewValkeyStore(valkey), cfg.Auth.JWT.RefreshTokenDuration)
+	revocations := auth.NewValkeyRevocationList(valkey)
+	return v1alpha1.NewAuthService(oauth, pats, installations, roles, tokens, sessions, revocations, audits),
+		v1alpha1.NewAdminService(roles, tokens, sessions, revocations, audits),
+		revocations,
+		nil
 }
@@ -0,0 +1,23 @@
+package postgresclient
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tacokumo/portal-api/pkg/config"
+)
+
+// NewPool はDatabaseConfigからADR002のPostgreSQLへのコネクションプールを生成する
+// 設定の誤りに起動時に気付けるように､生成後に一度接続を確認する
+func NewPool(ctx context.Context, cfg config.DatabaseConfig) (*pgxpool.Pool, error) {
+	pool, err := pgxpool.New(ctx, cfg.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create postgres pool")
+	}
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, errors.Wrap(err, "failed to connect to postgres")
+	}
+	return pool, nil
+}
@@ -0,0 +1,186 @@
+package secret
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// keySize はマスター鍵とデータ鍵の長さであり､AES-256を使う
+const keySize = 32
+
+// keyAdditionalData はデータ鍵を暗号化する際の追加データ
+// secretsの暗号文をデータ鍵として復号できないように､Secretの名前とは異なる値を使う
+var keyAdditionalData = []byte("secret_keys")
+
+// DataKey はsecret_keysのデータ鍵の識別子｡鍵の値は含まない
+type DataKey struct {
+	ID        int64
+	CreatedAt time.Time
+}
+
+// KeyManager はADR003のsecret_keysを管理するコンポーネント
+//
+// データ鍵はマスター鍵で暗号化してsecret_keysに保存し､平文のデータ鍵はKeyManagerの外に出さない
+// LocalKeyManagerはプロセス内で､keymanager.ClientはRPCで別のプロセスのKeyManagerを呼び出す
+type KeyManager interface {
+	// GenerateDataKey は新しい暗号化に使う最新のデータ鍵を返す｡データ鍵が1つも存在しない場合は生成する
+	GenerateDataKey(ctx context.Context) (*DataKey, error)
+	// Encrypt はkeyIDのデータ鍵でplaintextを暗号化する
+	// additionalDataはAES-GCMの追加データであり､復号時にも同じ値を指定する
+	Encrypt(ctx context.Context, keyID int64, plaintext, additionalData []byte) ([]byte, error)
+	// Decrypt はkeyIDのデータ鍵でciphertextを復号する
+	Decrypt(ctx context.Context, keyID int64, ciphertext, additionalData []byte) ([]byte, error)
+	// Rotate は新しいデータ鍵を生成し､以降のGenerateDataKeyで返す
+	Rotate(ctx context.Context) (*DataKey, error)
+}
+
+// LocalKeyManager はマスター鍵を持ち､Storeのsecret_keysを直接読み書きするKeyManager
+type LocalKeyManager struct {
+	store     Store
+	masterKey cipher.AEAD
+	// mu はデータ鍵が存在しない場合に同じプロセスから重複して作成しないためのロック
+	mu sync.Mutex
+}
+
+var _ KeyManager = &LocalKeyManager{}
+
+func NewLocalKeyManager(store Store, masterKey []byte) (*LocalKeyManager, error) {
+	if len(masterKey) != keySize {
+		return nil, errors.Errorf("master key must be %d bytes: got %d bytes", keySize, len(masterKey))
+	}
+	aead, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	return &LocalKeyManager{
+		store:     store,
+		masterKey: aead,
+	}, nil
+}
+
+// LoadMasterKey はBase64でエンコードされたマスター鍵をファイルから読み込む
+func LoadMasterKey(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read master key")
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode master key")
+	}
+	if len(key) != keySize {
+		return nil, errors.Errorf("master key must be %d bytes: got %d bytes", keySize, len(key))
+	}
+	return key, nil
+}
+
+func (m *LocalKeyManager) GenerateDataKey(ctx context.Context) (*DataKey, error) {
+	key, err := m.store.LatestKey(ctx)
+	if !errors.Is(err, ErrKeyNotFound) {
+		return newDataKey(key), err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// ロックを待つ間に他のgoroutineが作成している場合はそれを使う
+	key, err = m.store.LatestKey(ctx)
+	if !errors.Is(err, ErrKeyNotFound) {
+		return newDataKey(key), err
+	}
+	return m.createKey(ctx)
+}
+
+func (m *LocalKeyManager) Encrypt(ctx context.Context, keyID int64, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := m.dataKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return seal(aead, plaintext, additionalData), nil
+}
+
+func (m *LocalKeyManager) Decrypt(ctx context.Context, keyID int64, ciphertext, additionalData []byte) ([]byte, error) {
+	aead, err := m.dataKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return open(aead, ciphertext, additionalData)
+}
+
+func (m *LocalKeyManager) Rotate(ctx context.Context) (*DataKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.createKey(ctx)
+}
+
+// createKey はランダムなデータ鍵を生成し､マスター鍵で暗号化して保存する
+func (m *LocalKeyManager) createKey(ctx context.Context) (*DataKey, error) {
+	dataKey := make([]byte, keySize)
+	_, _ = rand.Read(dataKey)
+	key, err := m.store.CreateKey(ctx, seal(m.masterKey, dataKey, keyAdditionalData))
+	if err != nil {
+		return nil, err
+	}
+	return newDataKey(key), nil
+}
+
+// dataKey はkeyIDのデータ鍵をマスター鍵で復号する
+func (m *LocalKeyManager) dataKey(ctx context.Context, keyID int64) (cipher.AEAD, error) {
+	key, err := m.store.GetKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := open(m.masterKey, key.EncryptedKey, keyAdditionalData)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decrypt secret key %d", key.ID)
+	}
+	return newAEAD(dataKey)
+}
+
+func newDataKey(key *Key) *DataKey {
+	if key == nil {
+		return nil
+	}
+	return &DataKey{
+		ID:        key.ID,
+		CreatedAt: key.CreatedAt,
+	}
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create GCM")
+	}
+	return aead, nil
+}
+
+// seal はランダムなnonceでplaintextを暗号化し､nonceを先頭に付けた暗号文を返す
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) []byte {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	_, _ = rand.Read(nonce)
+	return aead.Seal(nonce, nonce, plaintext, additionalData)
+}
+
+// open はsealで暗号化した暗号文を復号する
+func open(aead cipher.AEAD, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, sealed, additionalData)
+}
@@ -0,0 +1,118 @@
+package secret
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLocalKeyManager(t *testing.T) (*LocalKeyManager, *MemoryStore) {
+	t.Helper()
+
+	store := NewMemoryStore()
+	keys, err := NewLocalKeyManager(store, newTestMasterKey())
+	require.NoError(t, err)
+	return keys, store
+}
+
+func TestLocalKeyManager(t *testing.T) {
+	t.Parallel()
+
+	t.Run("データ鍵が存在しない場合のみ生成すること", func(t *testing.T) {
+		t.Parallel()
+
+		keys, store := newTestLocalKeyManager(t)
+		first, err := keys.GenerateDataKey(t.Context())
+		require.NoError(t, err)
+		second, err := keys.GenerateDataKey(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, second.ID)
+
+		// データベースには平文のデータ鍵を保存しないこと
+		key, err := store.GetKey(t.Context(), first.ID)
+		require.NoError(t, err)
+		assert.Len(t, key.EncryptedKey, 12+keySize+16)
+	})
+
+	t.Run("ローテーション後は新しいデータ鍵を返し､古いデータ鍵でも復号できること", func(t *testing.T) {
+		t.Parallel()
+
+		keys, _ := newTestLocalKeyManager(t)
+		old, err := keys.GenerateDataKey(t.Context())
+		require.NoError(t, err)
+		ciphertext, err := keys.Encrypt(t.Context(), old.ID, []byte("plaintext"), []byte("name"))
+		require.NoError(t, err)
+
+		rotated, err := keys.Rotate(t.Context())
+		require.NoError(t, err)
+		latest, err := keys.GenerateDataKey(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, rotated.ID, latest.ID)
+		assert.NotEqual(t, old.ID, latest.ID)
+
+		plaintext, err := keys.Decrypt(t.Context(), old.ID, ciphertext, []byte("name"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("plaintext"), plaintext)
+	})
+
+	t.Run("追加データや鍵が異なる場合は復号できないこと", func(t *testing.T) {
+		t.Parallel()
+
+		keys, _ := newTestLocalKeyManager(t)
+		old, err := keys.GenerateDataKey(t.Context())
+		require.NoError(t, err)
+		ciphertext, err := keys.Encrypt(t.Context(), old.ID, []byte("plaintext"), []byte("name"))
+		require.NoError(t, err)
+		rotated, err := keys.Rotate(t.Context())
+		require.NoError(t, err)
+
+		_, err = keys.Decrypt(t.Context(), old.ID, ciphertext, []byte("other"))
+		assert.Error(t, err)
+		_, err = keys.Decrypt(t.Context(), rotated.ID, ciphertext, []byte("name"))
+		assert.Error(t, err)
+		_, err = keys.Decrypt(t.Context(), rotated.ID+1, ciphertext, []byte("name"))
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+}
+
+func TestNewLocalKeyManager(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewLocalKeyManager(NewMemoryStore(), []byte("too-short"))
+	assert.Error(t, err)
+}
+
+func TestLoadMasterKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		isError bool
+	}{
+		{name: "Base64でエンコードされた32バイトの鍵を読み込めること", content: base64.StdEncoding.EncodeToString(newTestMasterKey()) + "\n"},
+		{name: "Base64でない場合はエラーとなること", content: "not base64!", isError: true},
+		{name: "32バイトでない場合はエラーとなること", content: base64.StdEncoding.EncodeToString([]byte("short")), isError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "master.key")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			key, err := LoadMasterKey(path)
+			if tt.isError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, newTestMasterKey(), key)
+		})
+	}
+}
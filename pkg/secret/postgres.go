@@ -0,0 +1,237 @@
+package secret
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tacokumo/portal-api/pkg/config"
+	"github.com/tacokumo/portal-api/pkg/postgresclient"
+)
+
+//go:embed schema.sql
+var schema string
+
+// uniqueViolation はPostgreSQLの一意制約違反のエラーコード
+const uniqueViolation = "23505"
+
+// PostgresStore はADR003のsecrets･secret_keysテーブルに保存するStore
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+var _ Store = &PostgresStore{}
+
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{
+		pool: pool,
+	}
+}
+
+// OpenPostgres はPostgreSQLに接続してテーブルを作成し､PostgresStoreを生成する
+// 返り値のcloseでPostgreSQLへの接続を閉じる
+func OpenPostgres(ctx context.Context, database config.DatabaseConfig) (_ *PostgresStore, closeFn func(), err error) {
+	pool, err := postgresclient.NewPool(ctx, database)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if err != nil {
+			pool.Close()
+		}
+	}()
+
+	store := NewPostgresStore(pool)
+	if err := store.Migrate(ctx); err != nil {
+		return nil, nil, err
+	}
+	return store, pool.Close, nil
+}
+
+// Migrate はsecrets･secret_keysテーブルが存在しない場合に作成する
+func (s *PostgresStore) Migrate(ctx context.Context) error {
+	if _, err := s.pool.Exec(ctx, schema); err != nil {
+		return errors.Wrap(err, "failed to migrate secret tables")
+	}
+	return nil
+}
+
+func (s *PostgresStore) LatestKey(ctx context.Context) (*Key, error) {
+	key := Key{}
+	err := s.pool.QueryRow(ctx,
+		`SELECT id, key, created_at FROM secret_keys ORDER BY created_at DESC, id DESC LIMIT 1`,
+	).Scan(&key.ID, &key.EncryptedKey, &key.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrKeyNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get latest secret key")
+	}
+	return &key, nil
+}
+
+func (s *PostgresStore) GetKey(ctx context.Context, id int64) (*Key, error) {
+	key := Key{}
+	err := s.pool.QueryRow(ctx,
+		`SELECT id, key, created_at FROM secret_keys WHERE id = $1`, id,
+	).Scan(&key.ID, &key.EncryptedKey, &key.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrKeyNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to get secret key %d", id)
+	}
+	return &key, nil
+}
+
+func (s *PostgresStore) CreateKey(ctx context.Context, encryptedKey []byte) (*Key, error) {
+	key := Key{EncryptedKey: encryptedKey}
+	err := s.pool.QueryRow(ctx,
+		`INSERT INTO secret_keys (key) VALUES ($1) RETURNING id, created_at`, encryptedKey,
+	).Scan(&key.ID, &key.CreatedAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create secret key")
+	}
+	return &key, nil
+}
+
+func (s *PostgresStore) GetSecret(ctx context.Context, name string) (*EncryptedSecret, error) {
+	secret := EncryptedSecret{}
+	err := s.pool.QueryRow(ctx,
+		`SELECT id, name, encrypted_data, key_id, created_at, updated_at FROM secrets WHERE name = $1`, name,
+	).Scan(&secret.ID, &secret.Name, &secret.EncryptedData, &secret.KeyID, &secret.CreatedAt, &secret.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSecretNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to get secret %s", name)
+	}
+	return &secret, nil
+}
+
+func (s *PostgresStore) CreateSecret(ctx context.Context, secret *EncryptedSecret) (*EncryptedSecret, error) {
+	created := *secret
+	err := s.pool.QueryRow(ctx,
+		`INSERT INTO secrets (name, encrypted_data, key_id) VALUES ($1, $2, $3)
+		RETURNING id, created_at, updated_at`,
+		secret.Name, secret.EncryptedData, secret.KeyID,
+	).Scan(&created.ID, &created.CreatedAt, &created.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return nil, ErrSecretAlreadyExists
+		}
+		return nil, errors.Wrapf(err, "failed to create secret %s", secret.Name)
+	}
+	return &created, nil
+}
+
+func (s *PostgresStore) PutSecret(ctx context.Context, secret *EncryptedSecret) (*EncryptedSecret, error) {
+	stored := *secret
+	err := s.pool.QueryRow(ctx,
+		`INSERT INTO secrets (name, encrypted_data, key_id) VALUES ($1, $2, $3)
+		ON CONFLICT (name) DO UPDATE
+		SET encrypted_data = EXCLUDED.encrypted_data, key_id = EXCLUDED.key_id, updated_at = NOW()
+		RETURNING id, created_at, updated_at`,
+		secret.Name, secret.EncryptedData, secret.KeyID,
+	).Scan(&stored.ID, &stored.CreatedAt, &stored.UpdatedAt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to put secret %s", secret.Name)
+	}
+	return &stored, nil
+}
+
+func (s *PostgresStore) DeleteSecret(ctx context.Context, name string) error {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM secrets WHERE name = $1`, name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete secret %s", name)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrSecretNotFound
+	}
+	return nil
+}
+
+func (s *PostgresStore) ListSecretNames(ctx context.Context) ([]string, error) {
+	rows, err := s.pool.Query(ctx, `SELECT name FROM secrets ORDER BY name`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list secrets")
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list secrets")
+	}
+	return names, nil
+}
+
+func (s *PostgresStore) ListKeys(ctx context.Context) ([]Key, error) {
+	rows, err := s.pool.Query(ctx, `SELECT id, key, created_at FROM secret_keys ORDER BY id`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list secret keys")
+	}
+	keys, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Key, error) {
+		key := Key{}
+		err := row.Scan(&key.ID, &key.EncryptedKey, &key.CreatedAt)
+		return key, err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list secret keys")
+	}
+	return keys, nil
+}
+
+func (s *PostgresStore) CountSecretsByKey(ctx context.Context) (map[int64]int64, error) {
+	rows, err := s.pool.Query(ctx, `SELECT key_id, COUNT(*) FROM secrets GROUP BY key_id`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count secrets")
+	}
+	defer rows.Close()
+
+	counts := make(map[int64]int64)
+	for rows.Next() {
+		var keyID, count int64
+		if err := rows.Scan(&keyID, &count); err != nil {
+			return nil, errors.Wrap(err, "failed to count secrets")
+		}
+		counts[keyID] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to count secrets")
+	}
+	return counts, nil
+}
+
+func (s *PostgresStore) ListSecretsNotEncryptedWith(ctx context.Context, keyID int64, limit int) ([]EncryptedSecret, error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT id, name, encrypted_data, key_id, created_at, updated_at FROM secrets
+		WHERE key_id <> $1 ORDER BY id LIMIT $2`,
+		keyID, limit,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list secrets to re-encrypt")
+	}
+	secrets, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (EncryptedSecret, error) {
+		secret := EncryptedSecret{}
+		err := row.Scan(&secret.ID, &secret.Name, &secret.EncryptedData, &secret.KeyID, &secret.CreatedAt, &secret.UpdatedAt)
+		return secret, err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list secrets to re-encrypt")
+	}
+	return secrets, nil
+}
+
+func (s *PostgresStore) ReplaceSecret(ctx context.Context, current, next *EncryptedSecret) (bool, error) {
+	tag, err := s.pool.Exec(ctx,
+		`UPDATE secrets SET encrypted_data = $1, key_id = $2, updated_at = NOW()
+		WHERE id = $3 AND encrypted_data = $4`,
+		next.EncryptedData, next.KeyID, current.ID, current.EncryptedData,
+	)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to replace secret %s", current.Name)
+	}
+	return tag.RowsAffected() == 1, nil
+}
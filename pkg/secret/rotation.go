@@ -0,0 +1,236 @@
+package secret
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Progress は最新の鍵への再暗号化の進捗
+type Progress struct {
+	// KeyID は最新の鍵のID｡鍵が存在しない場合は0
+	KeyID int64
+	// Total は全てのSecretの数
+	Total int64
+	// Remaining は最新の鍵で暗号化されていないSecretの数
+	Remaining int64
+}
+
+// Done は全てのSecretが最新の鍵で暗号化されているかどうかを返す
+func (p Progress) Done() bool {
+	return p.Remaining == 0
+}
+
+// KeyStatus は鍵とその鍵で暗号化されているSecretの数
+type KeyStatus struct {
+	Key
+	Secrets int64
+	// Current は新しい暗号化に使われる最新の鍵かどうか
+	Current bool
+}
+
+// Stale はADR003の定期的なローテーションの対象となる､maxAgeより前に作成された鍵かどうかを返す
+func (k KeyStatus) Stale(now time.Time, maxAge time.Duration) bool {
+	return now.Sub(k.CreatedAt) > maxAge
+}
+
+// Rotate は新しいデータ鍵を生成し､以降の暗号化に使う
+// 既存のSecretは古い鍵のまま復号できるため､Reencryptで新しい鍵に再暗号化する
+func (v *Vault) Rotate(ctx context.Context) (*DataKey, error) {
+	return v.keys.Rotate(ctx)
+}
+
+// Keys は全ての鍵を作成した順に返す
+func (v *Vault) Keys(ctx context.Context) ([]KeyStatus, error) {
+	keys, err := v.store.ListKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	latest, err := v.store.LatestKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := v.store.CountSecretsByKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]KeyStatus, 0, len(keys))
+	for _, key := range keys {
+		statuses = append(statuses, KeyStatus{
+			Key:     key,
+			Secrets: counts[key.ID],
+			Current: key.ID == latest.ID,
+		})
+	}
+	return statuses, nil
+}
+
+// Progress は最新の鍵への再暗号化の進捗を返す
+func (v *Vault) Progress(ctx context.Context) (Progress, error) {
+	latest, err := v.store.LatestKey(ctx)
+	if err != nil {
+		if errors.Is(err, ErrKeyNotFound) {
+			return Progress{}, nil
+		}
+		return Progress{}, err
+	}
+	counts, err := v.store.CountSecretsByKey(ctx)
+	if err != nil {
+		return Progress{}, err
+	}
+
+	p := Progress{KeyID: latest.ID}
+	for keyID, n := range counts {
+		p.Total += n
+		if keyID != latest.ID {
+			p.Remaining += n
+		}
+	}
+	return p, nil
+}
+
+// Reencrypt は最新の鍵で暗号化されていないSecretをbatchSize件まで再暗号化し､進捗を返す
+// 再暗号化の状態はsecrets.key_idにのみ保存するため､中断した場合も繰り返し呼び出せば続きから再開できる
+func (v *Vault) Reencrypt(ctx context.Context, batchSize int) (Progress, error) {
+	latest, err := v.store.LatestKey(ctx)
+	if err != nil {
+		if errors.Is(err, ErrKeyNotFound) {
+			return Progress{}, nil
+		}
+		return Progress{}, err
+	}
+	secrets, err := v.store.ListSecretsNotEncryptedWith(ctx, latest.ID, batchSize)
+	if err != nil {
+		return Progress{}, err
+	}
+
+	for i := range secrets {
+		current := &secrets[i]
+		plaintext, err := v.keys.Decrypt(ctx, current.KeyID, current.EncryptedData, []byte(current.Name))
+		if err != nil {
+			return Progress{}, errors.Wrapf(err, "failed to decrypt secret %s", current.Name)
+		}
+		ciphertext, err := v.keys.Encrypt(ctx, latest.ID, plaintext, []byte(current.Name))
+		if err != nil {
+			return Progress{}, errors.Wrapf(err, "failed to encrypt secret %s", current.Name)
+		}
+
+		// 置き換えられなかった場合は再暗号化の間に更新されている
+		// 更新後の値が古い鍵で暗号化されている場合は次のバッチで再暗号化する
+		if _, err := v.store.ReplaceSecret(ctx, current, &EncryptedSecret{
+			Name:          current.Name,
+			EncryptedData: ciphertext,
+			KeyID:         latest.ID,
+		}); err != nil {
+			return Progress{}, err
+		}
+	}
+	return v.Progress(ctx)
+}
+
+// ReencryptAll は全てのSecretが最新の鍵で暗号化されるまでReencryptを繰り返す
+// reportを指定した場合はバッチごとに進捗を渡す
+func (v *Vault) ReencryptAll(ctx context.Context, batchSize int, report func(Progress)) error {
+	for {
+		p, err := v.Reencrypt(ctx, batchSize)
+		if err != nil {
+			return err
+		}
+		if report != nil {
+			report(p)
+		}
+		if p.Done() {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// RotationStatus は鍵のローテーションによる再暗号化の状態
+type RotationStatus struct {
+	Progress
+	// Running はこのプロセスで再暗号化を実行中か､実行を待っているかどうか
+	Running bool
+	// LastError は直前の再暗号化が失敗した場合のエラー
+	LastError error
+}
+
+// Rotator は鍵のローテーション後に既存のSecretをバックグラウンドで再暗号化する
+type Rotator struct {
+	vault     *Vault
+	batchSize int
+	trigger   chan struct{}
+	running   atomic.Bool
+
+	mu      sync.Mutex
+	lastErr error
+}
+
+func NewRotator(vault *Vault, batchSize int) *Rotator {
+	return &Rotator{
+		vault:     vault,
+		batchSize: batchSize,
+		trigger:   make(chan struct{}, 1),
+	}
+}
+
+// Run は起動時と鍵をローテーションするたびに､最新の鍵で暗号化されていないSecretを再暗号化する
+// 起動時にも再暗号化するため､途中で停止したローテーションは再起動後に続きから再開する
+func (r *Rotator) Run(ctx context.Context) {
+	for {
+		r.reencrypt(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.trigger:
+		}
+	}
+}
+
+// Rotate は新しい鍵を生成し､バックグラウンドでの再暗号化を開始する
+func (r *Rotator) Rotate(ctx context.Context) (*RotationStatus, error) {
+	if _, err := r.vault.Rotate(ctx); err != nil {
+		return nil, err
+	}
+	select {
+	case r.trigger <- struct{}{}:
+	default:
+		// 既に再暗号化の実行を待っている場合､その実行で新しい鍵に再暗号化する
+	}
+	return r.Status(ctx)
+}
+
+// Status は再暗号化の進捗を返す
+func (r *Rotator) Status(ctx context.Context) (*RotationStatus, error) {
+	p, err := r.vault.Progress(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return &RotationStatus{
+		Progress:  p,
+		Running:   r.running.Load() || len(r.trigger) > 0,
+		LastError: r.lastErr,
+	}, nil
+}
+
+func (r *Rotator) reencrypt(ctx context.Context) {
+	r.running.Store(true)
+	defer r.running.Store(false)
+
+	err := r.vault.ReencryptAll(ctx, r.batchSize, nil)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastErr = err
+}
@@ -0,0 +1,116 @@
+package secret
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVault_Reencrypt(t *testing.T) {
+	t.Parallel()
+
+	vault, _ := newTestVault(t)
+	for _, name := range []string{"app-1-secret", "app-2-secret", "app-3-secret"} {
+		_, err := vault.Create(t.Context(), name, map[string]string{"NAME": name})
+		require.NoError(t, err)
+	}
+
+	key, err := vault.Rotate(t.Context())
+	require.NoError(t, err)
+	p, err := vault.Progress(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, Progress{KeyID: key.ID, Total: 3, Remaining: 3}, p)
+
+	// バッチごとに再暗号化し､繰り返し呼び出すと続きから再開すること
+	p, err = vault.Reencrypt(t.Context(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, Progress{KeyID: key.ID, Total: 3, Remaining: 1}, p)
+	p, err = vault.Reencrypt(t.Context(), 2)
+	require.NoError(t, err)
+	assert.True(t, p.Done())
+
+	for _, name := range []string{"app-1-secret", "app-2-secret", "app-3-secret"} {
+		got, err := vault.Get(t.Context(), name)
+		require.NoError(t, err)
+		assert.Equal(t, key.ID, got.KeyID)
+		assert.Equal(t, map[string]string{"NAME": name}, got.Data)
+	}
+
+	keys, err := vault.Keys(t.Context())
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, int64(0), keys[0].Secrets)
+	assert.False(t, keys[0].Current)
+	assert.Equal(t, int64(3), keys[1].Secrets)
+	assert.True(t, keys[1].Current)
+}
+
+func TestVault_ReencryptAll(t *testing.T) {
+	t.Parallel()
+
+	vault, _ := newTestVault(t)
+	for _, name := range []string{"app-1-secret", "app-2-secret", "app-3-secret"} {
+		_, err := vault.Create(t.Context(), name, map[string]string{"NAME": name})
+		require.NoError(t, err)
+	}
+	_, err := vault.Rotate(t.Context())
+	require.NoError(t, err)
+
+	var reported []int64
+	require.NoError(t, vault.ReencryptAll(t.Context(), 1, func(p Progress) {
+		reported = append(reported, p.Remaining)
+	}))
+	assert.Equal(t, []int64{2, 1, 0}, reported)
+}
+
+func TestKeyStatus_Stale(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		createdAt time.Time
+		expected  bool
+	}{
+		{name: "最大の経過時間を超えた鍵は古いこと", createdAt: now.Add(-91 * 24 * time.Hour), expected: true},
+		{name: "最大の経過時間以内の鍵は古くないこと", createdAt: now.Add(-89 * 24 * time.Hour), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			status := KeyStatus{Key: Key{CreatedAt: tt.createdAt}}
+			assert.Equal(t, tt.expected, status.Stale(now, 90*24*time.Hour))
+		})
+	}
+}
+
+func TestRotator(t *testing.T) {
+	t.Parallel()
+
+	vault, _ := newTestVault(t)
+	_, err := vault.Create(t.Context(), "example-app-secret", map[string]string{"A": "1"})
+	require.NoError(t, err)
+	rotator := NewRotator(vault, 10)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	go rotator.Run(ctx)
+
+	status, err := rotator.Rotate(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), status.KeyID)
+
+	assert.Eventually(t, func() bool {
+		status, err := rotator.Status(t.Context())
+		return err == nil && status.Done() && !status.Running
+	}, 5*time.Second, 10*time.Millisecond)
+
+	got, err := vault.Get(t.Context(), "example-app-secret")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), got.KeyID)
+}
@@ -0,0 +1,16 @@
+-- ADR003のsecret_keys･secretsテーブル
+-- secret_keys.keyはマスター鍵で暗号化したデータ鍵であり､平文の鍵はデータベースに保存しない
+CREATE TABLE IF NOT EXISTS secret_keys (
+    id SERIAL PRIMARY KEY,
+    key BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS secrets (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    encrypted_data BYTEA NOT NULL,
+    key_id INTEGER NOT NULL REFERENCES secret_keys(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
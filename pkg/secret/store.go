@@ -0,0 +1,249 @@
+package secret
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+var (
+	ErrKeyNotFound         = errors.New("secret key not found")
+	ErrSecretNotFound      = errors.New("secret not found")
+	ErrSecretAlreadyExists = errors.New("secret already exists")
+	// ErrSecretConflict はSecretを読み出してから保存するまでの間に他の更新があったことを表す
+	ErrSecretConflict = errors.New("secret was modified concurrently")
+)
+
+// Key はADR003のsecret_keysの1行
+type Key struct {
+	ID int64
+	// EncryptedKey はマスター鍵で暗号化したデータ鍵
+	EncryptedKey []byte
+	CreatedAt    time.Time
+}
+
+// EncryptedSecret はADR003のsecretsの1行
+type EncryptedSecret struct {
+	ID   int64
+	Name string
+	// EncryptedData はKeyIDの鍵で暗号化した値
+	EncryptedData []byte
+	KeyID         int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Store は暗号化済みのSecretと鍵を保存する
+// Storeは暗号化済みの値だけを扱い､暗号化と復号はVaultが行う
+type Store interface {
+	// LatestKey は最も新しく作成された鍵を返す｡鍵が存在しない場合はErrKeyNotFoundを返す
+	LatestKey(ctx context.Context) (*Key, error)
+	// GetKey はidの鍵を返す｡存在しない場合はErrKeyNotFoundを返す
+	GetKey(ctx context.Context, id int64) (*Key, error)
+	// CreateKey はマスター鍵で暗号化したデータ鍵を保存する
+	CreateKey(ctx context.Context, encryptedKey []byte) (*Key, error)
+	// GetSecret はnameのSecretを返す｡存在しない場合はErrSecretNotFoundを返す
+	GetSecret(ctx context.Context, name string) (*EncryptedSecret, error)
+	// CreateSecret はSecretを作成して保存した行を返す｡既に存在する場合はErrSecretAlreadyExistsを返す
+	CreateSecret(ctx context.Context, secret *EncryptedSecret) (*EncryptedSecret, error)
+	// PutSecret はSecretを作成し､既に存在する場合は上書きして保存した行を返す
+	PutSecret(ctx context.Context, secret *EncryptedSecret) (*EncryptedSecret, error)
+	// DeleteSecret はSecretを削除する｡存在しない場合はErrSecretNotFoundを返す
+	DeleteSecret(ctx context.Context, name string) error
+	// ListSecretNames は全てのSecretの名前を名前順に返す
+	ListSecretNames(ctx context.Context) ([]string, error)
+	// ListKeys は全ての鍵を作成した順に返す
+	ListKeys(ctx context.Context) ([]Key, error)
+	// CountSecretsByKey は鍵ごとにその鍵で暗号化されているSecretの数を返す
+	CountSecretsByKey(ctx context.Context) (map[int64]int64, error)
+	// ListSecretsNotEncryptedWith はkeyID以外の鍵で暗号化されているSecretをid順にlimit件まで返す
+	ListSecretsNotEncryptedWith(ctx context.Context, keyID int64, limit int) ([]EncryptedSecret, error)
+	// ReplaceSecret はSecretがcurrentから変更されていない場合のみnextで置き換え､置き換えたかどうかを返す
+	// 再暗号化の間に更新された値を古い値で上書きしないために使う
+	ReplaceSecret(ctx context.Context, current, next *EncryptedSecret) (bool, error)
+}
+
+// MemoryStore はプロセス内にSecretと鍵を保持するStore
+// プロセスの終了とともに失われるため､テストでの利用を想定する
+type MemoryStore struct {
+	mu      sync.Mutex
+	keys    []Key
+	secrets map[string]EncryptedSecret
+	// nextSecretID はsecrets.idのSERIALに相当する連番
+	nextSecretID int64
+	now          func() time.Time
+}
+
+var _ Store = &MemoryStore{}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		secrets: make(map[string]EncryptedSecret),
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStore) LatestKey(ctx context.Context) (*Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.keys) == 0 {
+		return nil, ErrKeyNotFound
+	}
+	key := s.keys[len(s.keys)-1]
+	return &key, nil
+}
+
+func (s *MemoryStore) GetKey(ctx context.Context, id int64) (*Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range s.keys {
+		if key.ID == id {
+			return &key, nil
+		}
+	}
+	return nil, ErrKeyNotFound
+}
+
+func (s *MemoryStore) CreateKey(ctx context.Context, encryptedKey []byte) (*Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := Key{
+		ID:           int64(len(s.keys) + 1),
+		EncryptedKey: slices.Clone(encryptedKey),
+		CreatedAt:    s.now(),
+	}
+	s.keys = append(s.keys, key)
+	return &key, nil
+}
+
+func (s *MemoryStore) GetSecret(ctx context.Context, name string) (*EncryptedSecret, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	secret, ok := s.secrets[name]
+	if !ok {
+		return nil, ErrSecretNotFound
+	}
+	return &secret, nil
+}
+
+func (s *MemoryStore) CreateSecret(ctx context.Context, secret *EncryptedSecret) (*EncryptedSecret, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.secrets[secret.Name]; ok {
+		return nil, ErrSecretAlreadyExists
+	}
+	return s.putLocked(secret), nil
+}
+
+func (s *MemoryStore) PutSecret(ctx context.Context, secret *EncryptedSecret) (*EncryptedSecret, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.putLocked(secret), nil
+}
+
+func (s *MemoryStore) DeleteSecret(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.secrets[name]; !ok {
+		return ErrSecretNotFound
+	}
+	delete(s.secrets, name)
+	return nil
+}
+
+func (s *MemoryStore) ListSecretNames(ctx context.Context) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names := make([]string, 0, len(s.secrets))
+	for name := range s.secrets {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func (s *MemoryStore) ListKeys(ctx context.Context) ([]Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.keys), nil
+}
+
+func (s *MemoryStore) CountSecretsByKey(ctx context.Context) (map[int64]int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counts := make(map[int64]int64)
+	for _, secret := range s.secrets {
+		counts[secret.KeyID]++
+	}
+	return counts, nil
+}
+
+func (s *MemoryStore) ListSecretsNotEncryptedWith(ctx context.Context, keyID int64, limit int) ([]EncryptedSecret, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	secrets := make([]EncryptedSecret, 0)
+	for _, secret := range s.secrets {
+		if secret.KeyID != keyID {
+			secrets = append(secrets, secret)
+		}
+	}
+	slices.SortFunc(secrets, func(a, b EncryptedSecret) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	if len(secrets) > limit {
+		secrets = secrets[:limit]
+	}
+	return secrets, nil
+}
+
+func (s *MemoryStore) ReplaceSecret(ctx context.Context, current, next *EncryptedSecret) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.secrets[current.Name]
+	if !ok || existing.ID != current.ID || !bytes.Equal(existing.EncryptedData, current.EncryptedData) {
+		return false, nil
+	}
+	existing.EncryptedData = slices.Clone(next.EncryptedData)
+	existing.KeyID = next.KeyID
+	existing.UpdatedAt = s.now()
+	s.secrets[current.Name] = existing
+	return true, nil
+}
+
+// putLocked はidとcreated_atを引き継いでsecretを保存する
+func (s *MemoryStore) putLocked(secret *EncryptedSecret) *EncryptedSecret {
+	now := s.now()
+	stored := EncryptedSecret{
+		Name:          secret.Name,
+		EncryptedData: slices.Clone(secret.EncryptedData),
+		KeyID:         secret.KeyID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if existing, ok := s.secrets[secret.Name]; ok {
+		stored.ID = existing.ID
+		stored.CreatedAt = existing.CreatedAt
+	} else {
+		s.nextSecretID++
+		stored.ID = s.nextSecretID
+	}
+	s.secrets[secret.Name] = stored
+	return &stored
+}
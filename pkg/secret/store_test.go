@@ -0,0 +1,185 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// storeFactories はStoreの各実装に対して同じテストを実行するためのもの
+// PostgreSQLの実装はTEST_DATABASE_URLが設定されている場合のみテストする
+var storeFactories = map[string]func(t *testing.T) Store{
+	"memory": func(t *testing.T) Store {
+		return NewMemoryStore()
+	},
+	"postgres": func(t *testing.T) Store {
+		url := os.Getenv("TEST_DATABASE_URL")
+		if url == "" {
+			t.Skip("TEST_DATABASE_URL is not set")
+		}
+		return newTestPostgresStore(t, url)
+	},
+}
+
+// newTestPostgresStore はテストごとに専用のスキーマを作成し､そのスキーマを使うPostgresStoreを生成する
+func newTestPostgresStore(t *testing.T, url string) *PostgresStore {
+	t.Helper()
+
+	schemaName := pgx.Identifier{fmt.Sprintf("secret_test_%d", time.Now().UnixNano())}.Sanitize()
+	admin, err := pgxpool.New(t.Context(), url)
+	require.NoError(t, err)
+	t.Cleanup(admin.Close)
+	_, err = admin.Exec(t.Context(), "CREATE SCHEMA "+schemaName)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = admin.Exec(context.Background(), "DROP SCHEMA "+schemaName+" CASCADE")
+	})
+
+	cfg, err := pgxpool.ParseConfig(url)
+	require.NoError(t, err)
+	cfg.ConnConfig.RuntimeParams["search_path"] = schemaName
+	pool, err := pgxpool.NewWithConfig(t.Context(), cfg)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	store := NewPostgresStore(pool)
+	require.NoError(t, store.Migrate(t.Context()))
+	return store
+}
+
+func TestStore(t *testing.T) {
+	for name, newStore := range storeFactories {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("鍵が存在しない場合はErrKeyNotFoundを返すこと", func(t *testing.T) {
+				t.Parallel()
+
+				s := newStore(t)
+				_, err := s.LatestKey(t.Context())
+				assert.ErrorIs(t, err, ErrKeyNotFound)
+				_, err = s.GetKey(t.Context(), 1)
+				assert.ErrorIs(t, err, ErrKeyNotFound)
+			})
+
+			t.Run("最も新しく作成した鍵を返すこと", func(t *testing.T) {
+				t.Parallel()
+
+				s := newStore(t)
+				first, err := s.CreateKey(t.Context(), []byte("first"))
+				require.NoError(t, err)
+				second, err := s.CreateKey(t.Context(), []byte("second"))
+				require.NoError(t, err)
+
+				latest, err := s.LatestKey(t.Context())
+				require.NoError(t, err)
+				assert.Equal(t, second.ID, latest.ID)
+				assert.Equal(t, []byte("second"), latest.EncryptedKey)
+
+				key, err := s.GetKey(t.Context(), first.ID)
+				require.NoError(t, err)
+				assert.Equal(t, []byte("first"), key.EncryptedKey)
+			})
+
+			t.Run("Secretを作成･上書きできること", func(t *testing.T) {
+				t.Parallel()
+
+				s := newStore(t)
+				key, err := s.CreateKey(t.Context(), []byte("key"))
+				require.NoError(t, err)
+
+				_, err = s.GetSecret(t.Context(), "example-app-secret")
+				assert.ErrorIs(t, err, ErrSecretNotFound)
+
+				created, err := s.CreateSecret(t.Context(), &EncryptedSecret{Name: "example-app-secret", EncryptedData: []byte("v1"), KeyID: key.ID})
+				require.NoError(t, err)
+				_, err = s.CreateSecret(t.Context(), &EncryptedSecret{Name: "example-app-secret", EncryptedData: []byte("v2"), KeyID: key.ID})
+				assert.ErrorIs(t, err, ErrSecretAlreadyExists)
+				got, err := s.GetSecret(t.Context(), "example-app-secret")
+				require.NoError(t, err)
+				assert.Equal(t, created.ID, got.ID)
+				assert.Equal(t, []byte("v1"), got.EncryptedData)
+
+				updated, err := s.PutSecret(t.Context(), &EncryptedSecret{Name: "example-app-secret", EncryptedData: []byte("v3"), KeyID: key.ID})
+				require.NoError(t, err)
+				assert.Equal(t, created.ID, updated.ID)
+				got, err = s.GetSecret(t.Context(), "example-app-secret")
+				require.NoError(t, err)
+				assert.Equal(t, []byte("v3"), got.EncryptedData)
+				assert.Equal(t, key.ID, got.KeyID)
+
+				_, err = s.PutSecret(t.Context(), &EncryptedSecret{Name: "another-app-secret", EncryptedData: []byte("v1"), KeyID: key.ID})
+				require.NoError(t, err)
+				names, err := s.ListSecretNames(t.Context())
+				require.NoError(t, err)
+				assert.Equal(t, []string{"another-app-secret", "example-app-secret"}, names)
+			})
+
+			t.Run("Secretを削除できること", func(t *testing.T) {
+				t.Parallel()
+
+				s := newStore(t)
+				key, err := s.CreateKey(t.Context(), []byte("key"))
+				require.NoError(t, err)
+				_, err = s.CreateSecret(t.Context(), &EncryptedSecret{Name: "example-app-secret", EncryptedData: []byte("v1"), KeyID: key.ID})
+				require.NoError(t, err)
+
+				require.NoError(t, s.DeleteSecret(t.Context(), "example-app-secret"))
+				_, err = s.GetSecret(t.Context(), "example-app-secret")
+				assert.ErrorIs(t, err, ErrSecretNotFound)
+				assert.ErrorIs(t, s.DeleteSecret(t.Context(), "example-app-secret"), ErrSecretNotFound)
+			})
+
+			t.Run("他の鍵で暗号化されたSecretを変更されていない場合のみ置き換えられること", func(t *testing.T) {
+				t.Parallel()
+
+				s := newStore(t)
+				oldKey, err := s.CreateKey(t.Context(), []byte("old"))
+				require.NoError(t, err)
+				newKey, err := s.CreateKey(t.Context(), []byte("new"))
+				require.NoError(t, err)
+				stale, err := s.CreateSecret(t.Context(), &EncryptedSecret{Name: "app-1-secret", EncryptedData: []byte("v1"), KeyID: oldKey.ID})
+				require.NoError(t, err)
+				_, err = s.CreateSecret(t.Context(), &EncryptedSecret{Name: "app-2-secret", EncryptedData: []byte("v1"), KeyID: oldKey.ID})
+				require.NoError(t, err)
+				_, err = s.CreateSecret(t.Context(), &EncryptedSecret{Name: "app-3-secret", EncryptedData: []byte("v1"), KeyID: newKey.ID})
+				require.NoError(t, err)
+
+				counts, err := s.CountSecretsByKey(t.Context())
+				require.NoError(t, err)
+				assert.Equal(t, map[int64]int64{oldKey.ID: 2, newKey.ID: 1}, counts)
+				keys, err := s.ListKeys(t.Context())
+				require.NoError(t, err)
+				require.Len(t, keys, 2)
+				assert.Equal(t, oldKey.ID, keys[0].ID)
+
+				secrets, err := s.ListSecretsNotEncryptedWith(t.Context(), newKey.ID, 1)
+				require.NoError(t, err)
+				require.Len(t, secrets, 1)
+				assert.Equal(t, "app-1-secret", secrets[0].Name)
+
+				replaced, err := s.ReplaceSecret(t.Context(), stale, &EncryptedSecret{EncryptedData: []byte("v2"), KeyID: newKey.ID})
+				require.NoError(t, err)
+				assert.True(t, replaced)
+				got, err := s.GetSecret(t.Context(), "app-1-secret")
+				require.NoError(t, err)
+				assert.Equal(t, newKey.ID, got.KeyID)
+
+				// 読み出した後に更新された場合は置き換えないこと
+				replaced, err = s.ReplaceSecret(t.Context(), stale, &EncryptedSecret{EncryptedData: []byte("v3"), KeyID: newKey.ID})
+				require.NoError(t, err)
+				assert.False(t, replaced)
+				got, err = s.GetSecret(t.Context(), "app-1-secret")
+				require.NoError(t, err)
+				assert.Equal(t, []byte("v2"), got.EncryptedData)
+			})
+		})
+	}
+}
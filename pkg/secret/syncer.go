@@ -0,0 +1,99 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	managedByLabel = "app.kubernetes.io/managed-by"
+	managedByValue = "portal-api"
+)
+
+// Syncer はVaultのSecretを同名のKubernetes Secretに反映する
+//
+// Kubernetes SecretはPostgreSQLから導出されるものとして扱い､
+// 手動で変更された場合やクラスタを作り直した場合もSyncAllで元に戻す
+type Syncer struct {
+	vault     *Vault
+	client    client.Client
+	namespace string
+}
+
+func NewSyncer(vault *Vault, client client.Client, namespace string) *Syncer {
+	return &Syncer{
+		vault:     vault,
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+// Sync はnameのSecretを復号し､Kubernetes Secretを作成または上書きする
+// 他のレプリカの同期などとresourceVersionが競合した場合は､Kubernetes Secretを読み出し直して再試行する
+func (s *Syncer) Sync(ctx context.Context, name string) error {
+	stored, err := s.vault.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: s.namespace,
+				Name:      name,
+			},
+		}
+		_, err := controllerutil.CreateOrUpdate(ctx, s.client, secret, func() error {
+			if secret.Labels == nil {
+				secret.Labels = make(map[string]string)
+			}
+			secret.Labels[managedByLabel] = managedByValue
+			secret.StringData = nil
+			secret.Data = make(map[string][]byte, len(stored.Data))
+			for k, v := range stored.Data {
+				secret.Data[k] = []byte(v)
+			}
+			return nil
+		})
+		return err
+	}); err != nil {
+		return errors.Wrapf(err, "failed to sync secret %s", name)
+	}
+	return nil
+}
+
+// Delete はnameのKubernetes Secretを削除する｡存在しない場合は何もしない
+func (s *Syncer) Delete(ctx context.Context, name string) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: s.namespace,
+			Name:      name,
+		},
+	}
+	if err := s.client.Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+		return errors.Wrapf(err, "failed to delete secret %s", name)
+	}
+	return nil
+}
+
+// SyncAll はVaultの全てのSecretをKubernetes Secretに反映する
+// 一部のSecretの反映に失敗した場合も残りのSecretの反映を続ける
+func (s *Syncer) SyncAll(ctx context.Context) error {
+	names, err := s.vault.Names(ctx)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, name := range names {
+		if err := s.Sync(ctx, name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
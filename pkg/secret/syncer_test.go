@@ -0,0 +1,115 @@
+package secret
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestSyncer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Kubernetes Secretが存在しない場合は作成すること", func(t *testing.T) {
+		t.Parallel()
+
+		vault, _ := newTestVault(t)
+		_, err := vault.Create(t.Context(), "example-app-secret", map[string]string{"DB_PASSWORD": "secret123"})
+		require.NoError(t, err)
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+
+		require.NoError(t, NewSyncer(vault, c, "portal-namespace").Sync(t.Context(), "example-app-secret"))
+
+		secret := corev1.Secret{}
+		require.NoError(t, c.Get(t.Context(), types.NamespacedName{Namespace: "portal-namespace", Name: "example-app-secret"}, &secret))
+		assert.Equal(t, map[string][]byte{"DB_PASSWORD": []byte("secret123")}, secret.Data)
+		assert.Equal(t, managedByValue, secret.Labels[managedByLabel])
+	})
+
+	t.Run("手動で変更されたKubernetes Secretを元に戻すこと", func(t *testing.T) {
+		t.Parallel()
+
+		vault, _ := newTestVault(t)
+		_, err := vault.Create(t.Context(), "example-app-secret", map[string]string{"DB_PASSWORD": "secret123"})
+		require.NoError(t, err)
+		_, err = vault.Create(t.Context(), "other-app-secret", map[string]string{"API_KEY": "apikey456"})
+		require.NoError(t, err)
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "portal-namespace", Name: "example-app-secret"},
+				Data: map[string][]byte{
+					"DB_PASSWORD": []byte("tampered"),
+					"EXTRA":       []byte("extra"),
+				},
+			}).
+			Build()
+
+		require.NoError(t, NewSyncer(vault, c, "portal-namespace").SyncAll(t.Context()))
+
+		secret := corev1.Secret{}
+		require.NoError(t, c.Get(t.Context(), types.NamespacedName{Namespace: "portal-namespace", Name: "example-app-secret"}, &secret))
+		assert.Equal(t, map[string][]byte{"DB_PASSWORD": []byte("secret123")}, secret.Data)
+		require.NoError(t, c.Get(t.Context(), types.NamespacedName{Namespace: "portal-namespace", Name: "other-app-secret"}, &secret))
+		assert.Equal(t, map[string][]byte{"API_KEY": []byte("apikey456")}, secret.Data)
+	})
+
+	t.Run("resourceVersionが競合した場合は再試行すること", func(t *testing.T) {
+		t.Parallel()
+
+		vault, _ := newTestVault(t)
+		_, err := vault.Create(t.Context(), "example-app-secret", map[string]string{"DB_PASSWORD": "secret123"})
+		require.NoError(t, err)
+		conflicts := 0
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "portal-namespace", Name: "example-app-secret"},
+			}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					if conflicts < 2 {
+						conflicts++
+						return apierrors.NewConflict(corev1.Resource("secrets"), obj.GetName(), errors.New("the object has been modified"))
+					}
+					return c.Update(ctx, obj, opts...)
+				},
+			}).
+			Build()
+
+		require.NoError(t, NewSyncer(vault, c, "portal-namespace").Sync(t.Context(), "example-app-secret"))
+		assert.Equal(t, 2, conflicts)
+
+		secret := corev1.Secret{}
+		require.NoError(t, c.Get(t.Context(), types.NamespacedName{Namespace: "portal-namespace", Name: "example-app-secret"}, &secret))
+		assert.Equal(t, map[string][]byte{"DB_PASSWORD": []byte("secret123")}, secret.Data)
+	})
+
+	t.Run("Kubernetes Secretを削除し､存在しない場合も成功すること", func(t *testing.T) {
+		t.Parallel()
+
+		vault, _ := newTestVault(t)
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "portal-namespace", Name: "example-app-secret"},
+			}).
+			Build()
+		syncer := NewSyncer(vault, c, "portal-namespace")
+
+		require.NoError(t, syncer.Delete(t.Context(), "example-app-secret"))
+		err := c.Get(t.Context(), types.NamespacedName{Namespace: "portal-namespace", Name: "example-app-secret"}, &corev1.Secret{})
+		assert.True(t, apierrors.IsNotFound(err))
+		require.NoError(t, syncer.Delete(t.Context(), "example-app-secret"))
+	})
+}
@@ -0,0 +1,149 @@
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Secret は復号したSecret
+type Secret struct {
+	ID        int64
+	Name      string
+	Data      map[string]string
+	KeyID     int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// encryptedData は読み出した時点の暗号文であり､Replaceで変更されていないことを確認するために使う
+	encryptedData []byte
+}
+
+// Vault はADR003に従いSecretをエンベロープ暗号化してStoreに保存する
+//
+// Secretの値はKeyManagerの最新のデータ鍵でAES-GCMにより暗号化し､暗号化に使ったデータ鍵をkey_idとして紐付ける
+// Secretの暗号文は名前を追加データとして暗号化するため､他のSecretの行に付け替えても復号できない
+type Vault struct {
+	store Store
+	keys  KeyManager
+}
+
+func NewVault(store Store, keys KeyManager) *Vault {
+	return &Vault{
+		store: store,
+		keys:  keys,
+	}
+}
+
+// Get はnameのSecretを復号して返す｡存在しない場合はErrSecretNotFoundを返す
+func (v *Vault) Get(ctx context.Context, name string) (*Secret, error) {
+	encrypted, err := v.store.GetSecret(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := v.keys.Decrypt(ctx, encrypted.KeyID, encrypted.EncryptedData, []byte(name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decrypt secret %s", name)
+	}
+	data := map[string]string{}
+	if err := json.Unmarshal(plaintext, &data); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode secret %s", name)
+	}
+	return newSecret(encrypted, data), nil
+}
+
+// Create はdataを暗号化してnameのSecretを作成する｡既に存在する場合はErrSecretAlreadyExistsを返す
+func (v *Vault) Create(ctx context.Context, name string, data map[string]string) (*Secret, error) {
+	encrypted, err := v.encrypt(ctx, name, data)
+	if err != nil {
+		return nil, err
+	}
+	created, err := v.store.CreateSecret(ctx, encrypted)
+	if err != nil {
+		return nil, err
+	}
+	return newSecret(created, data), nil
+}
+
+// Put はdataを暗号化してnameのSecretを作成し､既に存在する場合は上書きする
+func (v *Vault) Put(ctx context.Context, name string, data map[string]string) (*Secret, error) {
+	encrypted, err := v.encrypt(ctx, name, data)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := v.store.PutSecret(ctx, encrypted)
+	if err != nil {
+		return nil, err
+	}
+	return newSecret(stored, data), nil
+}
+
+// Replace はcurrentを読み出してから変更されていない場合のみdataで置き換える
+// 他の更新や削除があった場合はErrSecretConflictを返すため､呼び出し元で読み出しからやり直す
+func (v *Vault) Replace(ctx context.Context, current *Secret, data map[string]string) (*Secret, error) {
+	next, err := v.encrypt(ctx, current.Name, data)
+	if err != nil {
+		return nil, err
+	}
+	replaced, err := v.store.ReplaceSecret(ctx, &EncryptedSecret{
+		ID:            current.ID,
+		Name:          current.Name,
+		EncryptedData: current.encryptedData,
+	}, next)
+	if err != nil {
+		return nil, err
+	}
+	if !replaced {
+		return nil, errors.WithStack(ErrSecretConflict)
+	}
+	// ReplaceSecretは行を返さないため､UpdatedAtは設定しない
+	next.ID = current.ID
+	next.CreatedAt = current.CreatedAt
+	return newSecret(next, data), nil
+}
+
+// Delete はnameのSecretを削除する｡存在しない場合はErrSecretNotFoundを返す
+func (v *Vault) Delete(ctx context.Context, name string) error {
+	return v.store.DeleteSecret(ctx, name)
+}
+
+// Names は保存されている全てのSecretの名前を返す
+func (v *Vault) Names(ctx context.Context) ([]string, error) {
+	return v.store.ListSecretNames(ctx)
+}
+
+func newSecret(encrypted *EncryptedSecret, data map[string]string) *Secret {
+	return &Secret{
+		ID:        encrypted.ID,
+		Name:      encrypted.Name,
+		Data:      data,
+		KeyID:     encrypted.KeyID,
+		CreatedAt: encrypted.CreatedAt,
+		UpdatedAt: encrypted.UpdatedAt,
+
+		encryptedData: encrypted.EncryptedData,
+	}
+}
+
+// encrypt はdataを最新のデータ鍵で暗号化する
+func (v *Vault) encrypt(ctx context.Context, name string, data map[string]string) (*EncryptedSecret, error) {
+	key, err := v.keys.GenerateDataKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encode secret %s", name)
+	}
+	ciphertext, err := v.keys.Encrypt(ctx, key.ID, plaintext, []byte(name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encrypt secret %s", name)
+	}
+	return &EncryptedSecret{
+		Name:          name,
+		EncryptedData: ciphertext,
+		KeyID:         key.ID,
+	}, nil
+}
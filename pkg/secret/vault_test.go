@@ -0,0 +1,145 @@
+package secret
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMasterKey() []byte {
+	return bytes.Repeat([]byte{0x42}, keySize)
+}
+
+func newTestVault(t *testing.T) (*Vault, *MemoryStore) {
+	t.Helper()
+
+	store := NewMemoryStore()
+	keys, err := NewLocalKeyManager(store, newTestMasterKey())
+	require.NoError(t, err)
+	return NewVault(store, keys), store
+}
+
+func TestVault(t *testing.T) {
+	t.Parallel()
+
+	t.Run("暗号化して保存し､復号して取得できること", func(t *testing.T) {
+		t.Parallel()
+
+		vault, store := newTestVault(t)
+		_, err := vault.Create(t.Context(), "example-app-secret", map[string]string{"DB_PASSWORD": "secret123"})
+		require.NoError(t, err)
+
+		got, err := vault.Get(t.Context(), "example-app-secret")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"DB_PASSWORD": "secret123"}, got.Data)
+
+		// データベースには平文の値もデータ鍵も保存しないこと
+		encrypted, err := store.GetSecret(t.Context(), "example-app-secret")
+		require.NoError(t, err)
+		assert.NotContains(t, string(encrypted.EncryptedData), "secret123")
+		key, err := store.GetKey(t.Context(), encrypted.KeyID)
+		require.NoError(t, err)
+		assert.Equal(t, got.KeyID, key.ID)
+		assert.Len(t, key.EncryptedKey, 12+keySize+16)
+	})
+
+	t.Run("既に存在するSecretは作成できず､Putで上書きできること", func(t *testing.T) {
+		t.Parallel()
+
+		vault, store := newTestVault(t)
+		_, err := vault.Create(t.Context(), "example-app-secret", map[string]string{"A": "1"})
+		require.NoError(t, err)
+		_, err = vault.Create(t.Context(), "example-app-secret", map[string]string{"A": "2"})
+		assert.ErrorIs(t, err, ErrSecretAlreadyExists)
+
+		_, err = vault.Put(t.Context(), "example-app-secret", map[string]string{"B": "2"})
+		require.NoError(t, err)
+		got, err := vault.Get(t.Context(), "example-app-secret")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"B": "2"}, got.Data)
+
+		// 2回目以降は既存のデータ鍵を使うこと
+		_, err = store.GetKey(t.Context(), 2)
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("読み出してから変更されていない場合のみReplaceで置き換えられること", func(t *testing.T) {
+		t.Parallel()
+
+		vault, _ := newTestVault(t)
+		_, err := vault.Create(t.Context(), "example-app-secret", map[string]string{"A": "1"})
+		require.NoError(t, err)
+		current, err := vault.Get(t.Context(), "example-app-secret")
+		require.NoError(t, err)
+
+		replaced, err := vault.Replace(t.Context(), current, map[string]string{"A": "2"})
+		require.NoError(t, err)
+		assert.Equal(t, current.ID, replaced.ID)
+		assert.Equal(t, map[string]string{"A": "2"}, replaced.Data)
+
+		// 置き換えた後はcurrentが古くなっているため競合すること
+		_, err = vault.Replace(t.Context(), current, map[string]string{"A": "3"})
+		require.ErrorIs(t, err, ErrSecretConflict)
+
+		// 置き換えたSecretから続けて置き換えられること
+		_, err = vault.Replace(t.Context(), replaced, map[string]string{"A": "3"})
+		require.NoError(t, err)
+		got, err := vault.Get(t.Context(), "example-app-secret")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"A": "3"}, got.Data)
+	})
+
+	t.Run("削除されたSecretはReplaceで置き換えられないこと", func(t *testing.T) {
+		t.Parallel()
+
+		vault, _ := newTestVault(t)
+		current, err := vault.Create(t.Context(), "example-app-secret", map[string]string{"A": "1"})
+		require.NoError(t, err)
+		require.NoError(t, vault.Delete(t.Context(), "example-app-secret"))
+
+		_, err = vault.Replace(t.Context(), current, map[string]string{"A": "2"})
+		assert.ErrorIs(t, err, ErrSecretConflict)
+	})
+
+	t.Run("存在しないSecretはErrSecretNotFoundを返すこと", func(t *testing.T) {
+		t.Parallel()
+
+		vault, _ := newTestVault(t)
+		_, err := vault.Get(t.Context(), "example-app-secret")
+		assert.ErrorIs(t, err, ErrSecretNotFound)
+	})
+
+	t.Run("他のSecretの行に付け替えた暗号文は復号できないこと", func(t *testing.T) {
+		t.Parallel()
+
+		vault, store := newTestVault(t)
+		_, err := vault.Create(t.Context(), "example-app-secret", map[string]string{"A": "1"})
+		require.NoError(t, err)
+		encrypted, err := store.GetSecret(t.Context(), "example-app-secret")
+		require.NoError(t, err)
+		_, err = store.PutSecret(t.Context(), &EncryptedSecret{
+			Name:          "other-app-secret",
+			EncryptedData: encrypted.EncryptedData,
+			KeyID:         encrypted.KeyID,
+		})
+		require.NoError(t, err)
+
+		_, err = vault.Get(t.Context(), "other-app-secret")
+		assert.Error(t, err)
+	})
+
+	t.Run("異なるマスター鍵では復号できないこと", func(t *testing.T) {
+		t.Parallel()
+
+		vault, store := newTestVault(t)
+		_, err := vault.Create(t.Context(), "example-app-secret", map[string]string{"A": "1"})
+		require.NoError(t, err)
+
+		keys, err := NewLocalKeyManager(store, bytes.Repeat([]byte{0x43}, keySize))
+		require.NoError(t, err)
+		_, err = NewVault(store, keys).Get(t.Context(), "example-app-secret")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,135 @@
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"slices"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// refreshTokenBytes はリフレッシュトークンのエントロピー
+	refreshTokenBytes = 32
+	// sessionIDBytes はセッションIDのエントロピー
+	sessionIDBytes = 16
+)
+
+// Manager はリフレッシュトークンの発行･ローテーション･失効を行う
+type Manager struct {
+	store                Store
+	refreshTokenDuration time.Duration
+	now                  func() time.Time
+}
+
+func NewManager(store Store, refreshTokenDuration time.Duration) *Manager {
+	return &Manager{
+		store:                store,
+		refreshTokenDuration: refreshTokenDuration,
+		now:                  time.Now,
+	}
+}
+
+// Create は新しいセッションを作成し､平文のリフレッシュトークンを返す
+// セッションの有効期限はrefreshTokenDurationで固定され､ローテーションしても延長されない
+func (m *Manager) Create(ctx context.Context, userID, role, authMethod string) (*Session, string, error) {
+	id, err := randomString(sessionIDBytes)
+	if err != nil {
+		return nil, "", err
+	}
+	token, err := randomString(refreshTokenBytes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := m.now()
+	sess := &Session{
+		ID:           id,
+		UserID:       userID,
+		Role:         role,
+		AuthMethod:   authMethod,
+		CreatedAt:    now,
+		LastAccessed: now,
+		ExpiresAt:    now.Add(m.refreshTokenDuration),
+	}
+	if err := m.store.Create(ctx, sess, hashToken(token)); err != nil {
+		return nil, "", errors.Wrap(err, "failed to create session")
+	}
+	return sess, token, nil
+}
+
+// Refresh はリフレッシュトークンを一度だけ使用可能なものとして消費し､新しいトークンを返す
+func (m *Manager) Refresh(ctx context.Context, refreshToken string) (*Session, string, error) {
+	newToken, err := randomString(refreshTokenBytes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sess, err := m.store.Rotate(ctx, hashToken(refreshToken), hashToken(newToken), m.now())
+	if err != nil {
+		return nil, "", err
+	}
+	return sess, newToken, nil
+}
+
+// Revoke はリフレッシュトークンが属するセッションを削除する
+// 既に存在しないトークンの場合は何もしない
+func (m *Manager) Revoke(ctx context.Context, refreshToken string) (*Session, error) {
+	sess, err := m.store.Lookup(ctx, hashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if err := m.store.Delete(ctx, sess.ID); err != nil {
+		return nil, errors.Wrap(err, "failed to delete session")
+	}
+	return sess, nil
+}
+
+// List はuserIDの有効なセッションを作成日時の古い順に返す
+func (m *Manager) List(ctx context.Context, userID string) ([]*Session, error) {
+	sessions, err := m.store.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := m.now()
+	sessions = slices.DeleteFunc(sessions, func(sess *Session) bool {
+		return !now.Before(sess.ExpiresAt)
+	})
+	slices.SortFunc(sessions, func(a, b *Session) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return sessions, nil
+}
+
+// Delete はsessionIDのセッションを削除して返す
+// セッションが存在しない場合はErrNotFoundを返す
+func (m *Manager) Delete(ctx context.Context, sessionID string) (*Session, error) {
+	sess, err := m.store.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.store.Delete(ctx, sessionID); err != nil {
+		return nil, errors.Wrap(err, "failed to delete session")
+	}
+	return sess, nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate random bytes")
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,211 @@
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/cockroachdb/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// storeFactories はStoreの各実装に対して同じテストを実行するためのもの
+var storeFactories = map[string]func(t *testing.T) Store{
+	"memory": func(t *testing.T) Store {
+		return NewMemoryStore()
+	},
+	"valkey": func(t *testing.T) Store {
+		mr := miniredis.RunT(t)
+		client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+		t.Cleanup(func() { _ = client.Close() })
+		return NewValkeyStore(client)
+	},
+}
+
+func TestManager_Refresh(t *testing.T) {
+	for storeName, newStore := range storeFactories {
+		t.Run(storeName, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("リフレッシュトークンをローテーションできること", func(t *testing.T) {
+				t.Parallel()
+
+				m := NewManager(newStore(t), 8*time.Hour)
+				created, token, err := m.Create(t.Context(), "42", "viewer", "oauth")
+				require.NoError(t, err)
+
+				sess, newToken, err := m.Refresh(t.Context(), token)
+				assert.NoError(t, err)
+				assert.Equal(t, created.ID, sess.ID)
+				assert.Equal(t, "42", sess.UserID)
+				assert.Equal(t, "viewer", sess.Role)
+				assert.NotEqual(t, token, newToken)
+
+				_, _, err = m.Refresh(t.Context(), newToken)
+				assert.NoError(t, err, "新しいトークンは使用できること")
+			})
+
+			t.Run("使用済みのトークンを再利用するとセッション全体が失効すること", func(t *testing.T) {
+				t.Parallel()
+
+				m := NewManager(newStore(t), 8*time.Hour)
+				_, token, err := m.Create(t.Context(), "42", "viewer", "oauth")
+				require.NoError(t, err)
+				_, newToken, err := m.Refresh(t.Context(), token)
+				require.NoError(t, err)
+
+				_, _, err = m.Refresh(t.Context(), token)
+				assert.True(t, errors.Is(err, ErrTokenReused), "expected ErrTokenReused, got %v", err)
+
+				_, _, err = m.Refresh(t.Context(), newToken)
+				assert.True(t, errors.Is(err, ErrNotFound), "同じファミリーのトークンも無効になること: %v", err)
+			})
+
+			t.Run("未知のトークンはErrNotFoundとなること", func(t *testing.T) {
+				t.Parallel()
+
+				m := NewManager(newStore(t), 8*time.Hour)
+				_, _, err := m.Refresh(t.Context(), "unknown")
+				assert.True(t, errors.Is(err, ErrNotFound))
+			})
+
+			t.Run("ログアウトしたセッションのトークンは使えないこと", func(t *testing.T) {
+				t.Parallel()
+
+				m := NewManager(newStore(t), 8*time.Hour)
+				created, token, err := m.Create(t.Context(), "42", "viewer", "oauth")
+				require.NoError(t, err)
+
+				revoked, err := m.Revoke(t.Context(), token)
+				assert.NoError(t, err)
+				assert.Equal(t, created.ID, revoked.ID)
+
+				_, _, err = m.Refresh(t.Context(), token)
+				assert.True(t, errors.Is(err, ErrNotFound))
+
+				revoked, err = m.Revoke(t.Context(), token)
+				assert.NoError(t, err, "ログアウトは冪等であること")
+				assert.Nil(t, revoked)
+			})
+		})
+	}
+}
+
+func TestManager_Refresh_有効期限切れ(t *testing.T) {
+	t.Parallel()
+
+	t.Run("memory", func(t *testing.T) {
+		t.Parallel()
+
+		m := NewManager(NewMemoryStore(), time.Hour)
+		_, token, err := m.Create(t.Context(), "42", "viewer", "oauth")
+		require.NoError(t, err)
+
+		m.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+		_, _, err = m.Refresh(t.Context(), token)
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+
+	t.Run("valkey", func(t *testing.T) {
+		t.Parallel()
+
+		mr := miniredis.RunT(t)
+		client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+		t.Cleanup(func() { _ = client.Close() })
+		m := NewManager(NewValkeyStore(client), time.Hour)
+		_, token, err := m.Create(t.Context(), "42", "viewer", "oauth")
+		require.NoError(t, err)
+		assert.True(t, mr.Exists("user:42:sessions"))
+
+		mr.FastForward(2 * time.Hour)
+		_, _, err = m.Refresh(t.Context(), token)
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+}
+
+func TestManager_List(t *testing.T) {
+	for storeName, newStore := range storeFactories {
+		t.Run(storeName, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("ユーザーのセッションを作成日時の古い順に取得できること", func(t *testing.T) {
+				t.Parallel()
+
+				m := NewManager(newStore(t), 8*time.Hour)
+				now := time.Now()
+				m.now = func() time.Time { return now }
+				first, _, err := m.Create(t.Context(), "42", "viewer", "oauth")
+				require.NoError(t, err)
+				m.now = func() time.Time { return now.Add(time.Minute) }
+				second, _, err := m.Create(t.Context(), "42", "viewer", "oauth")
+				require.NoError(t, err)
+				_, _, err = m.Create(t.Context(), "43", "viewer", "oauth")
+				require.NoError(t, err)
+
+				sessions, err := m.List(t.Context(), "42")
+				assert.NoError(t, err)
+				require.Len(t, sessions, 2)
+				assert.Equal(t, first.ID, sessions[0].ID)
+				assert.Equal(t, second.ID, sessions[1].ID)
+			})
+
+			t.Run("セッションが無い場合は空となること", func(t *testing.T) {
+				t.Parallel()
+
+				m := NewManager(newStore(t), 8*time.Hour)
+				sessions, err := m.List(t.Context(), "42")
+				assert.NoError(t, err)
+				assert.Empty(t, sessions)
+			})
+
+			t.Run("削除したセッションは含まれないこと", func(t *testing.T) {
+				t.Parallel()
+
+				m := NewManager(newStore(t), 8*time.Hour)
+				created, token, err := m.Create(t.Context(), "42", "viewer", "oauth")
+				require.NoError(t, err)
+
+				deleted, err := m.Delete(t.Context(), created.ID)
+				assert.NoError(t, err)
+				assert.Equal(t, "42", deleted.UserID)
+
+				sessions, err := m.List(t.Context(), "42")
+				assert.NoError(t, err)
+				assert.Empty(t, sessions)
+
+				_, _, err = m.Refresh(t.Context(), token)
+				assert.True(t, errors.Is(err, ErrNotFound), "削除したセッションのトークンは使えないこと")
+			})
+		})
+	}
+}
+
+func TestManager_Delete_未知のセッションはErrNotFoundとなること(t *testing.T) {
+	for storeName, newStore := range storeFactories {
+		t.Run(storeName, func(t *testing.T) {
+			t.Parallel()
+
+			m := NewManager(newStore(t), 8*time.Hour)
+			_, err := m.Delete(t.Context(), "unknown")
+			assert.True(t, errors.Is(err, ErrNotFound))
+		})
+	}
+}
+
+func TestManager_List_有効期限切れのセッションは含まれないこと(t *testing.T) {
+	t.Parallel()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+	m := NewManager(NewValkeyStore(client), time.Hour)
+	_, _, err := m.Create(t.Context(), "42", "viewer", "oauth")
+	require.NoError(t, err)
+
+	mr.FastForward(2 * time.Hour)
+	sessions, err := m.List(t.Context(), "42")
+	assert.NoError(t, err)
+	assert.Empty(t, sessions)
+}
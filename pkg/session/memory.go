@@ -0,0 +1,129 @@
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type memoryToken struct {
+	sessionID string
+	used      bool
+}
+
+// MemoryStore はプロセス内でセッションを保持するStore
+// 主にテストとValkeyを利用しない開発環境向け
+type MemoryStore struct {
+	mu       sync.Mutex
+	sessions map[string]*Session
+	tokens   map[string]*memoryToken
+}
+
+var _ Store = &MemoryStore{}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		sessions: make(map[string]*Session),
+		tokens:   make(map[string]*memoryToken),
+	}
+}
+
+func (s *MemoryStore) Create(ctx context.Context, sess *Session, tokenHash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 期限切れのセッションが溜まり続けないように作成時に掃除する
+	for id, existing := range s.sessions {
+		if !sess.CreatedAt.Before(existing.ExpiresAt) {
+			s.deleteLocked(id)
+		}
+	}
+
+	copied := *sess
+	s.sessions[sess.ID] = &copied
+	s.tokens[tokenHash] = &memoryToken{sessionID: sess.ID}
+	return nil
+}
+
+func (s *MemoryStore) Rotate(ctx context.Context, oldHash, newHash string, now time.Time) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, ok := s.tokens[oldHash]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	sess, ok := s.sessions[token.sessionID]
+	if !ok || !now.Before(sess.ExpiresAt) {
+		return nil, ErrNotFound
+	}
+	if token.used {
+		s.deleteLocked(sess.ID)
+		return nil, ErrTokenReused
+	}
+
+	token.used = true
+	s.tokens[newHash] = &memoryToken{sessionID: sess.ID}
+	sess.LastAccessed = now
+	copied := *sess
+	return &copied, nil
+}
+
+func (s *MemoryStore) Lookup(ctx context.Context, tokenHash string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, ok := s.tokens[tokenHash]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	sess, ok := s.sessions[token.sessionID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	copied := *sess
+	return &copied, nil
+}
+
+func (s *MemoryStore) Get(ctx context.Context, sessionID string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.sessions[sessionID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	copied := *sess
+	return &copied, nil
+}
+
+func (s *MemoryStore) ListByUser(ctx context.Context, userID string) ([]*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var sessions []*Session
+	for _, sess := range s.sessions {
+		if sess.UserID == userID {
+			copied := *sess
+			sessions = append(sessions, &copied)
+		}
+	}
+	return sessions, nil
+}
+
+func (s *MemoryStore) Delete(ctx context.Context, sessionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deleteLocked(sessionID)
+	return nil
+}
+
+func (s *MemoryStore) deleteLocked(sessionID string) {
+	delete(s.sessions, sessionID)
+	for hash, token := range s.tokens {
+		if token.sessionID == sessionID {
+			delete(s.tokens, hash)
+		}
+	}
+}
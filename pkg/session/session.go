@@ -0,0 +1,45 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+var (
+	// ErrNotFound はセッションまたはリフレッシュトークンが存在しないことを表す
+	ErrNotFound = errors.New("session not found")
+	// ErrTokenReused は使用済みのリフレッシュトークンが再度提示されたことを表す
+	// トークンの漏洩が疑われるため､該当セッション（トークンファミリー）は失効する
+	ErrTokenReused = errors.New("refresh token reused")
+)
+
+// Session はリフレッシュトークンのファミリーを束ねるログインセッション
+type Session struct {
+	ID           string    `json:"session_id"`
+	UserID       string    `json:"user_id"`
+	Role         string    `json:"role"`
+	AuthMethod   string    `json:"auth_method"`
+	CreatedAt    time.Time `json:"created_at"`
+	LastAccessed time.Time `json:"last_accessed"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// Store はセッションとリフレッシュトークンを永続化する
+// リフレッシュトークンは平文ではなくハッシュ値で保存される
+type Store interface {
+	// Create はセッションを作成し､最初のリフレッシュトークンを登録する
+	Create(ctx context.Context, sess *Session, tokenHash string) error
+	// Rotate はoldHashを使用済みにしてnewHashを同じセッションに登録する
+	// oldHashが既に使用済みの場合はセッションを削除してErrTokenReusedを返す
+	Rotate(ctx context.Context, oldHash, newHash string, now time.Time) (*Session, error)
+	// Lookup はtokenHashが属するセッションを返す
+	Lookup(ctx context.Context, tokenHash string) (*Session, error)
+	// Get はsessionIDのセッションを返す
+	Get(ctx context.Context, sessionID string) (*Session, error)
+	// ListByUser はuserIDのセッションを返す｡期限切れのセッションを含む場合がある
+	ListByUser(ctx context.Context, userID string) ([]*Session, error)
+	// Delete はセッションを削除する｡セッションに属する全てのリフレッシュトークンは無効になる
+	Delete(ctx context.Context, sessionID string) error
+}
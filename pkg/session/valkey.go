@@ -0,0 +1,220 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// ValkeyStore はADR004のキー設計に従ってValkeyにセッションを保存するStore
+//
+//   - セッション: session:{session_id}
+//   - ユーザーセッション一覧: user:{user_id}:sessions
+//   - リフレッシュトークン: refresh:{refresh_token_hash}
+type ValkeyStore struct {
+	client redis.UniversalClient
+}
+
+var _ Store = &ValkeyStore{}
+
+func NewValkeyStore(client redis.UniversalClient) *ValkeyStore {
+	return &ValkeyStore{
+		client: client,
+	}
+}
+
+type valkeyToken struct {
+	SessionID string `json:"session_id"`
+	Used      bool   `json:"used"`
+}
+
+// rotateScript はリフレッシュトークンの使用済み判定と新しいトークンの登録を原子的に行う
+// 新しいトークンは古いトークンと同じ残り有効期間を引き継ぐ
+var rotateScript = redis.NewScript(`
+local v = redis.call('GET', KEYS[1])
+if not v then
+  return false
+end
+local token = cjson.decode(v)
+if token.used then
+  return {'reused', token.session_id}
+end
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl <= 0 then
+  return false
+end
+token.used = true
+redis.call('SET', KEYS[1], cjson.encode(token), 'PX', ttl)
+redis.call('SET', KEYS[2], cjson.encode({session_id = token.session_id, used = false}), 'PX', ttl)
+return {'ok', token.session_id}
+`)
+
+func sessionKey(sessionID string) string {
+	return "session:" + sessionID
+}
+
+func userSessionsKey(userID string) string {
+	return "user:" + userID + ":sessions"
+}
+
+func refreshKey(tokenHash string) string {
+	return "refresh:" + tokenHash
+}
+
+func (s *ValkeyStore) Create(ctx context.Context, sess *Session, tokenHash string) error {
+	sessData, err := json.Marshal(sess)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal session")
+	}
+	tokenData, err := json.Marshal(valkeyToken{SessionID: sess.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal refresh token")
+	}
+
+	ttl := time.Until(sess.ExpiresAt)
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, sessionKey(sess.ID), sessData, ttl)
+		pipe.Set(ctx, refreshKey(tokenHash), tokenData, ttl)
+		pipe.SAdd(ctx, userSessionsKey(sess.UserID), sess.ID)
+		pipe.Expire(ctx, userSessionsKey(sess.UserID), ttl)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to store session")
+	}
+	return nil
+}
+
+func (s *ValkeyStore) Rotate(ctx context.Context, oldHash, newHash string, now time.Time) (*Session, error) {
+	ret, err := rotateScript.Run(ctx, s.client, []string{refreshKey(oldHash), refreshKey(newHash)}).StringSlice()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrap(err, "failed to rotate refresh token")
+	}
+
+	result, sessionID := ret[0], ret[1]
+	if result == "reused" {
+		if err := s.Delete(ctx, sessionID); err != nil {
+			return nil, err
+		}
+		return nil, ErrTokenReused
+	}
+
+	sess, err := s.get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	sess.LastAccessed = now
+	data, err := json.Marshal(sess)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal session")
+	}
+	if err := s.client.SetArgs(ctx, sessionKey(sess.ID), data, redis.SetArgs{KeepTTL: true, Mode: "XX"}).Err(); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errors.Wrap(err, "failed to update session")
+	}
+	return sess, nil
+}
+
+func (s *ValkeyStore) Lookup(ctx context.Context, tokenHash string) (*Session, error) {
+	data, err := s.client.Get(ctx, refreshKey(tokenHash)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get refresh token")
+	}
+	token := valkeyToken{}
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal refresh token")
+	}
+	return s.get(ctx, token.SessionID)
+}
+
+func (s *ValkeyStore) Get(ctx context.Context, sessionID string) (*Session, error) {
+	return s.get(ctx, sessionID)
+}
+
+func (s *ValkeyStore) ListByUser(ctx context.Context, userID string) ([]*Session, error) {
+	ids, err := s.client.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user sessions")
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, sessionKey(id))
+	}
+	values, err := s.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user sessions")
+	}
+
+	var (
+		sessions []*Session
+		stale    []any
+	)
+	for i, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			// 有効期限切れで消えたセッションは一覧からも取り除く
+			stale = append(stale, ids[i])
+			continue
+		}
+		sess := Session{}
+		if err := json.Unmarshal([]byte(data), &sess); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal session")
+		}
+		sessions = append(sessions, &sess)
+	}
+	if len(stale) > 0 {
+		if err := s.client.SRem(ctx, userSessionsKey(userID), stale...).Err(); err != nil {
+			return nil, errors.Wrap(err, "failed to remove stale sessions")
+		}
+	}
+	return sessions, nil
+}
+
+func (s *ValkeyStore) Delete(ctx context.Context, sessionID string) error {
+	sess, err := s.get(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	// リフレッシュトークンはセッションの存在を前提に検証されるため､
+	// セッションを削除すればファミリー全体が無効になる
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, sessionKey(sessionID))
+		pipe.SRem(ctx, userSessionsKey(sess.UserID), sessionID)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete session")
+	}
+	return nil
+}
+
+func (s *ValkeyStore) get(ctx context.Context, sessionID string) (*Session, error) {
+	data, err := s.client.Get(ctx, sessionKey(sessionID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get session")
+	}
+	sess := Session{}
+	if err := json.Unmarshal(data, &sess); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal session")
+	}
+	return &sess, nil
+}
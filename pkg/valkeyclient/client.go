@@ -0,0 +1,16 @@
+package valkeyclient
+
+import (
+	"github.com/redis/go-redis/v9"
+	"github.com/tacokumo/portal-api/pkg/config"
+)
+
+// NewClient はValkeyConfigからValkeyクライアントを生成する
+// ValkeyはRedisプロトコル互換のため､go-redisをそのまま利用する
+func NewClient(cfg config.ValkeyConfig) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:     cfg.Address,
+		Password: cfg.Password,
+		DB:       cfg.DB,
+	})
+}